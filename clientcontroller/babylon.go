@@ -2,7 +2,9 @@ package clientcontroller
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	sdkErr "cosmossdk.io/errors"
@@ -18,6 +20,8 @@ import (
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
 	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 	sttypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -28,15 +32,31 @@ import (
 	"github.com/babylonchain/finality-provider/types"
 )
 
-var _ ClientController = &BabylonController{}
+var (
+	_ ClientController       = &BabylonController{}
+	_ BlockSubscriber        = &BabylonController{}
+	_ EndpointHealthReporter = &BabylonController{}
+)
+
+const (
+	// newBlockSubscriber identifies the subscription to the NewBlock events
+	newBlockSubscriber = "finality-provider"
+	// newBlockEventBuffer is the number of NewBlock events buffered by the
+	// websocket client before they are handled
+	newBlockEventBuffer = 100
+)
 
 var emptyErrs = []*sdkErr.Error{}
 
 type BabylonController struct {
-	bbnClient *bbnclient.Client
+	endpoints *endpointPool
 	cfg       *fpcfg.BBNConfig
 	btcParams *chaincfg.Params
 	logger    *zap.Logger
+
+	wg        sync.WaitGroup
+	quit      chan struct{}
+	closeOnce sync.Once
 }
 
 func NewBabylonController(
@@ -45,26 +65,116 @@ func NewBabylonController(
 	logger *zap.Logger,
 ) (*BabylonController, error) {
 
-	bbnConfig := fpcfg.BBNConfigToBabylonConfig(cfg)
+	rpcAddrs := cfg.RPCAddrs()
+	if int(cfg.BlockQuorum) > len(rpcAddrs) {
+		return nil, fmt.Errorf("the block quorum %d is larger than the number of rpc servers %d",
+			cfg.BlockQuorum, len(rpcAddrs))
+	}
+	if cfg.HealthCheckInterval <= 0 {
+		return nil, fmt.Errorf("the health check interval of the rpc servers should be positive")
+	}
+	if cfg.BlockQuorum > 1 && (cfg.BlockConfirmAttempts == 0 || cfg.BlockConfirmDelay < 0) {
+		return nil, fmt.Errorf("the block confirm attempts should be positive and the delay not negative")
+	}
+
+	var endpoints []*bbnEndpoint
+	for _, addr := range rpcAddrs {
+		bbnConfig := fpcfg.BBNConfigToBabylonConfig(cfg)
+		bbnConfig.RPCAddr = addr
+
+		if err := bbnConfig.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid config for Babylon client of %s: %w", addr, err)
+		}
+
+		client, err := bbnclient.New(
+			&bbnConfig,
+			logger,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Babylon client of %s: %w", addr, err)
+		}
 
-	if err := bbnConfig.Validate(); err != nil {
-		return nil, fmt.Errorf("invalid config for Babylon client: %w", err)
+		endpoints = append(endpoints, &bbnEndpoint{addr: addr, client: client})
 	}
 
-	bc, err := bbnclient.New(
-		&bbnConfig,
-		logger,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create Babylon client: %w", err)
+	bc := &BabylonController{
+		endpoints: newEndpointPool(endpoints, logger),
+		cfg:       cfg,
+		btcParams: btcParams,
+		logger:    logger,
+		quit:      make(chan struct{}),
 	}
 
-	return &BabylonController{
-		bc,
-		cfg,
-		btcParams,
-		logger,
-	}, nil
+	bc.wg.Add(1)
+	go bc.endpointHealthCheckLoop()
+
+	return bc, nil
+}
+
+// query runs the query with the client of the active endpoint, and of the
+// other endpoints in turn while the endpoint fails. The errors answered by
+// the chain are returned right away
+func (bc *BabylonController) query(q func(client *bbnclient.Client) error) error {
+	var err error
+	for _, e := range bc.endpoints.ranked() {
+		start := time.Now()
+		err = q(e.client)
+		if err != nil && !isEndpointError(err) {
+			// the chain answered with an error, e.g., the queried item does
+			// not exist, which is not a failure of the endpoint
+			return err
+		}
+		bc.endpoints.observe(e, time.Since(start), err)
+		if err == nil {
+			return nil
+		}
+
+		bc.logger.Debug("failed to query the Babylon endpoint",
+			zap.String("endpoint", e.addr), zap.Error(err))
+	}
+
+	return err
+}
+
+// endpointHealthCheckLoop queries the status of the endpoints periodically
+// so that the health of the endpoints which are not active is known
+func (bc *BabylonController) endpointHealthCheckLoop() {
+	defer bc.wg.Done()
+
+	ticker := time.NewTicker(bc.cfg.HealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		bc.checkEndpoints()
+
+		select {
+		case <-ticker.C:
+		case <-bc.quit:
+			return
+		}
+	}
+}
+
+func (bc *BabylonController) checkEndpoints() {
+	for _, e := range bc.endpoints.endpoints {
+		ctx, cancel := getContextWithCancel(bc.cfg.Timeout)
+		start := time.Now()
+		status, err := e.client.RPCClient.Status(ctx)
+		cancel()
+		bc.endpoints.observe(e, time.Since(start), err)
+		if err != nil {
+			bc.logger.Debug("failed to query the status of the Babylon endpoint",
+				zap.String("endpoint", e.addr), zap.Error(err))
+			continue
+		}
+
+		bc.endpoints.observeTip(e, uint64(status.SyncInfo.LatestBlockHeight))
+	}
+}
+
+// EndpointHealth returns the health of the Babylon endpoints
+func (bc *BabylonController) EndpointHealth() []*EndpointHealth {
+	return bc.endpoints.health()
 }
 
 func (bc *BabylonController) mustGetTxSigner() string {
@@ -79,7 +189,8 @@ func (bc *BabylonController) GetKeyAddress() sdk.AccAddress {
 	// and we should panic.
 	// This is checked at the start of BabylonController, so if it fails something is really wrong
 
-	keyRec, err := bc.bbnClient.GetKeyring().Key(bc.cfg.Key)
+	// all the endpoints share the keyring in the key directory
+	keyRec, err := bc.endpoints.endpoints[0].client.GetKeyring().Key(bc.cfg.Key)
 	if err != nil {
 		panic(fmt.Sprintf("Failed to get key address: %s", err))
 	}
@@ -96,13 +207,20 @@ func (bc *BabylonController) reliablySendMsg(msg sdk.Msg, expectedErrs []*sdkErr
 	return bc.reliablySendMsgs([]sdk.Msg{msg}, expectedErrs, unrecoverableErrs)
 }
 
+// reliablySendMsgs sends the msgs through the active endpoint only, as a
+// transaction that failed to be confirmed may still be included, and sending
+// it again through another endpoint could spend the fees twice
 func (bc *BabylonController) reliablySendMsgs(msgs []sdk.Msg, expectedErrs []*sdkErr.Error, unrecoverableErrs []*sdkErr.Error) (*provider.RelayerTxResponse, error) {
-	return bc.bbnClient.ReliablySendMsgs(
+	e := bc.endpoints.best()
+	res, err := e.client.ReliablySendMsgs(
 		context.Background(),
 		msgs,
 		expectedErrs,
 		unrecoverableErrs,
 	)
+	bc.endpoints.observeBroadcast(e, err)
+
+	return res, err
 }
 
 // RegisterFinalityProvider registers a finality provider via a MsgCreateFinalityProvider to Babylon
@@ -257,7 +375,11 @@ func (bc *BabylonController) SubmitBatchFinalitySigs(
 
 func (bc *BabylonController) QueryFinalityProviderSlashed(fpPk *btcec.PublicKey) (bool, error) {
 	fpPubKey := bbntypes.NewBIP340PubKeyFromBTCPK(fpPk)
-	res, err := bc.bbnClient.QueryClient.FinalityProvider(fpPubKey.MarshalHex())
+	var res *btcstakingtypes.QueryFinalityProviderResponse
+	err := bc.query(func(client *bbnclient.Client) (err error) {
+		res, err = client.QueryClient.FinalityProvider(fpPubKey.MarshalHex())
+		return err
+	})
 	if err != nil {
 		return false, fmt.Errorf("failed to query the finality provider %s: %v", fpPubKey.MarshalHex(), err)
 	}
@@ -269,10 +391,14 @@ func (bc *BabylonController) QueryFinalityProviderSlashed(fpPk *btcec.PublicKey)
 
 // QueryFinalityProviderVotingPower queries the voting power of the finality provider at a given height
 func (bc *BabylonController) QueryFinalityProviderVotingPower(fpPk *btcec.PublicKey, blockHeight uint64) (uint64, error) {
-	res, err := bc.bbnClient.QueryClient.FinalityProviderPowerAtHeight(
-		bbntypes.NewBIP340PubKeyFromBTCPK(fpPk).MarshalHex(),
-		blockHeight,
-	)
+	var res *btcstakingtypes.QueryFinalityProviderPowerAtHeightResponse
+	err := bc.query(func(client *bbnclient.Client) (err error) {
+		res, err = client.QueryClient.FinalityProviderPowerAtHeight(
+			bbntypes.NewBIP340PubKeyFromBTCPK(fpPk).MarshalHex(),
+			blockHeight,
+		)
+		return err
+	})
 	if err != nil {
 		return 0, fmt.Errorf("failed to query BTC delegations: %w", err)
 	}
@@ -294,7 +420,11 @@ func (bc *BabylonController) QueryLastCommittedPublicRand(fpPk *btcec.PublicKey,
 		Reverse: true,
 	}
 
-	res, err := bc.bbnClient.QueryClient.ListPubRandCommit(fpBtcPk.MarshalHex(), pagination)
+	var res *finalitytypes.QueryListPubRandCommitResponse
+	err := bc.query(func(client *bbnclient.Client) (err error) {
+		res, err = client.QueryClient.ListPubRandCommit(fpBtcPk.MarshalHex(), pagination)
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to query committed public randomness: %w", err)
 	}
@@ -310,7 +440,19 @@ func (bc *BabylonController) QueryBlocks(startHeight, endHeight, limit uint64) (
 	if count > limit {
 		count = limit
 	}
-	return bc.queryLatestBlocks(sdk.Uint64ToBigEndian(startHeight), count, finalitytypes.QueriedBlockStatus_ANY, false)
+	pagination := &sdkquery.PageRequest{
+		Limit: count,
+		Key:   sdk.Uint64ToBigEndian(startHeight),
+	}
+
+	blocks, err := bc.queryBlocksWithQuorum(func(client *bbnclient.Client) ([]*types.BlockInfo, error) {
+		return listBlocks(client, finalitytypes.QueriedBlockStatus_ANY, pagination)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query blocks from height %v: %w", startHeight, err)
+	}
+
+	return blocks, nil
 }
 
 func (bc *BabylonController) queryLatestBlocks(startKey []byte, count uint64, status finalitytypes.QueriedBlockStatus, reverse bool) ([]*types.BlockInfo, error) {
@@ -321,17 +463,45 @@ func (bc *BabylonController) queryLatestBlocks(startKey []byte, count uint64, st
 		Key:     startKey,
 	}
 
-	res, err := bc.bbnClient.QueryClient.ListBlocks(status, pagination)
+	err := bc.query(func(client *bbnclient.Client) (err error) {
+		blocks, err = listBlocks(client, status, pagination)
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to query finalized blocks: %v", err)
 	}
 
+	return blocks, nil
+}
+
+// queryBlocksWithQuorum runs the query of blocks on the active endpoint, or
+// has the blocks confirmed by the quorum of endpoints if it is set
+func (bc *BabylonController) queryBlocksWithQuorum(q func(client *bbnclient.Client) ([]*types.BlockInfo, error)) ([]*types.BlockInfo, error) {
+	if bc.cfg.BlockQuorum > 1 {
+		return bc.quorumQueryBlocks(q)
+	}
+
+	var blocks []*types.BlockInfo
+	err := bc.query(func(client *bbnclient.Client) (err error) {
+		blocks, err = q(client)
+		return err
+	})
+
+	return blocks, err
+}
+
+func listBlocks(client *bbnclient.Client, status finalitytypes.QueriedBlockStatus, pagination *sdkquery.PageRequest) ([]*types.BlockInfo, error) {
+	res, err := client.QueryClient.ListBlocks(status, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make([]*types.BlockInfo, 0, len(res.Blocks))
 	for _, b := range res.Blocks {
-		ib := &types.BlockInfo{
+		blocks = append(blocks, &types.BlockInfo{
 			Height: b.Height,
 			Hash:   b.AppHash,
-		}
-		blocks = append(blocks, ib)
+		})
 	}
 
 	return blocks, nil
@@ -343,20 +513,31 @@ func getContextWithCancel(timeout time.Duration) (context.Context, context.Cance
 }
 
 func (bc *BabylonController) QueryBlock(height uint64) (*types.BlockInfo, error) {
-	res, err := bc.bbnClient.QueryClient.Block(height)
+	blocks, err := bc.queryBlocksWithQuorum(func(client *bbnclient.Client) ([]*types.BlockInfo, error) {
+		res, err := client.QueryClient.Block(height)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*types.BlockInfo{{
+			Height:    height,
+			Hash:      res.Block.AppHash,
+			Finalized: res.Block.Finalized,
+		}}, nil
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to query indexed block at height %v: %w", height, err)
 	}
 
-	return &types.BlockInfo{
-		Height:    height,
-		Hash:      res.Block.AppHash,
-		Finalized: res.Block.Finalized,
-	}, nil
+	return blocks[0], nil
 }
 
 func (bc *BabylonController) QueryActivatedHeight() (uint64, error) {
-	res, err := bc.bbnClient.QueryClient.ActivatedHeight()
+	var res *finalitytypes.QueryActivatedHeightResponse
+	err := bc.query(func(client *bbnclient.Client) (err error) {
+		res, err = client.QueryClient.ActivatedHeight()
+		return err
+	})
 	if err != nil {
 		return 0, fmt.Errorf("failed to query activated height: %w", err)
 	}
@@ -364,22 +545,38 @@ func (bc *BabylonController) QueryActivatedHeight() (uint64, error) {
 	return res.Height, nil
 }
 
+// QueryBestBlock returns the tip block of the active endpoint. If the block
+// quorum is set, the block at the height of the tip is confirmed by the
+// quorum, so that the hash of the tip can be trusted as any other block
 func (bc *BabylonController) QueryBestBlock() (*types.BlockInfo, error) {
+	var tip *types.BlockInfo
 	blocks, err := bc.queryLatestBlocks(nil, 1, finalitytypes.QueriedBlockStatus_ANY, true)
 	if err != nil || len(blocks) != 1 {
 		// try query comet block if the index block query is not available
-		return bc.queryCometBestBlock()
+		tip, err = bc.queryCometBestBlock()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		tip = blocks[0]
 	}
 
-	return blocks[0], nil
+	if bc.cfg.BlockQuorum > 1 {
+		return bc.QueryBlock(tip.Height)
+	}
+
+	return tip, nil
 }
 
 func (bc *BabylonController) queryCometBestBlock() (*types.BlockInfo, error) {
-	ctx, cancel := getContextWithCancel(bc.cfg.Timeout)
-	// this will return 20 items at max in the descending order (highest first)
-	chainInfo, err := bc.bbnClient.RPCClient.BlockchainInfo(ctx, 0, 0)
-	defer cancel()
-
+	var chainInfo *coretypes.ResultBlockchainInfo
+	err := bc.query(func(client *bbnclient.Client) (err error) {
+		ctx, cancel := getContextWithCancel(bc.cfg.Timeout)
+		defer cancel()
+		// this will return 20 items at max in the descending order (highest first)
+		chainInfo, err = client.RPCClient.BlockchainInfo(ctx, 0, 0)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -392,12 +589,86 @@ func (bc *BabylonController) queryCometBestBlock() (*types.BlockInfo, error) {
 	}, nil
 }
 
+// SubscribeNewBlocks subscribes to the NewBlock events of CometBFT over the
+// websocket of the active RPC endpoint
+func (bc *BabylonController) SubscribeNewBlocks(ctx context.Context) (<-chan *types.BlockInfo, error) {
+	query := cmttypes.EventQueryNewBlock.String()
+	e := bc.endpoints.best()
+	start := time.Now()
+	events, err := e.client.RPCClient.Subscribe(ctx, newBlockSubscriber, query, newBlockEventBuffer)
+	bc.endpoints.observe(e, time.Since(start), err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to new blocks of %s: %w", e.addr, err)
+	}
+
+	blocks := make(chan *types.BlockInfo)
+	go func() {
+		defer close(blocks)
+		defer func() {
+			unsubCtx, cancel := getContextWithCancel(bc.cfg.Timeout)
+			defer cancel()
+			if err := e.client.RPCClient.Unsubscribe(unsubCtx, newBlockSubscriber, query); err != nil {
+				bc.logger.Debug("failed to unsubscribe from new blocks", zap.Error(err))
+			}
+		}()
+
+		for {
+			select {
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				data, ok := event.Data.(cmttypes.EventDataNewBlock)
+				if !ok || data.Block == nil {
+					bc.logger.Debug("ignoring an unexpected event of the new block subscription",
+						zap.String("query", event.Query))
+					continue
+				}
+				block := &types.BlockInfo{
+					Height: uint64(data.Block.Height),
+					Hash:   data.Block.AppHash,
+				}
+				bc.endpoints.observeTip(e, block.Height)
+				if bc.cfg.BlockQuorum > 1 {
+					// the poller falls back to polling the blocks, which
+					// are confirmed by the quorum
+					if err := bc.confirmSubscribedBlock(ctx, e.addr, block); err != nil {
+						bc.logger.Warn("failed to confirm the block of the new block subscription, dropping the subscription",
+							zap.Uint64("height", block.Height), zap.Error(err))
+						return
+					}
+				}
+				select {
+				case blocks <- block:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return blocks, nil
+}
+
 func (bc *BabylonController) Close() error {
-	if !bc.bbnClient.IsRunning() {
-		return nil
+	bc.closeOnce.Do(func() {
+		close(bc.quit)
+	})
+	bc.wg.Wait()
+
+	var errs []error
+	for _, e := range bc.endpoints.endpoints {
+		if !e.client.IsRunning() {
+			continue
+		}
+		if err := e.client.Stop(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to stop the Babylon client of %s: %w", e.addr, err))
+		}
 	}
 
-	return bc.bbnClient.Stop()
+	return errors.Join(errs...)
 }
 
 /*
@@ -469,7 +740,11 @@ func (bc *BabylonController) QueryFinalityProviders() ([]*btcstakingtypes.Finali
 	}
 
 	for {
-		res, err := bc.bbnClient.QueryClient.FinalityProviders(pagination)
+		var res *btcstakingtypes.QueryFinalityProvidersResponse
+		err := bc.query(func(client *bbnclient.Client) (err error) {
+			res, err = client.QueryClient.FinalityProviders(pagination)
+			return err
+		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to query finality providers: %v", err)
 		}
@@ -485,7 +760,11 @@ func (bc *BabylonController) QueryFinalityProviders() ([]*btcstakingtypes.Finali
 }
 
 func (bc *BabylonController) QueryBtcLightClientTip() (*btclctypes.BTCHeaderInfoResponse, error) {
-	res, err := bc.bbnClient.QueryClient.BTCHeaderChainTip()
+	var res *btclctypes.QueryTipResponse
+	err := bc.query(func(client *bbnclient.Client) (err error) {
+		res, err = client.QueryClient.BTCHeaderChainTip()
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to query BTC tip: %v", err)
 	}
@@ -494,7 +773,11 @@ func (bc *BabylonController) QueryBtcLightClientTip() (*btclctypes.BTCHeaderInfo
 }
 
 func (bc *BabylonController) QueryVotesAtHeight(height uint64) ([]bbntypes.BIP340PubKey, error) {
-	res, err := bc.bbnClient.QueryClient.VotesAtHeight(height)
+	var res *finalitytypes.QueryVotesAtHeightResponse
+	err := bc.query(func(client *bbnclient.Client) (err error) {
+		res, err = client.QueryClient.VotesAtHeight(height)
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to query BTC delegations: %w", err)
 	}
@@ -518,7 +801,11 @@ func (bc *BabylonController) queryDelegationsWithStatus(status btcstakingtypes.B
 		Limit: limit,
 	}
 
-	res, err := bc.bbnClient.QueryClient.BTCDelegations(status, pagination)
+	var res *btcstakingtypes.QueryBTCDelegationsResponse
+	err := bc.query(func(client *bbnclient.Client) (err error) {
+		res, err = client.QueryClient.BTCDelegations(status, pagination)
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to query BTC delegations: %v", err)
 	}
@@ -528,13 +815,21 @@ func (bc *BabylonController) queryDelegationsWithStatus(status btcstakingtypes.B
 
 func (bc *BabylonController) QueryStakingParams() (*types.StakingParams, error) {
 	// query btc checkpoint params
-	ckptParamRes, err := bc.bbnClient.QueryClient.BTCCheckpointParams()
+	var ckptParamRes *btcctypes.QueryParamsResponse
+	err := bc.query(func(client *bbnclient.Client) (err error) {
+		ckptParamRes, err = client.QueryClient.BTCCheckpointParams()
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to query params of the btccheckpoint module: %v", err)
 	}
 
 	// query btc staking params
-	stakingParamRes, err := bc.bbnClient.QueryClient.BTCStakingParams()
+	var stakingParamRes *btcstakingtypes.QueryParamsResponse
+	err = bc.query(func(client *bbnclient.Client) (err error) {
+		stakingParamRes, err = client.QueryClient.BTCStakingParams()
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to query staking params: %v", err)
 	}
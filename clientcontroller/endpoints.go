@@ -0,0 +1,234 @@
+package clientcontroller
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	sdkErr "cosmossdk.io/errors"
+	bbnclient "github.com/babylonchain/babylon/client/client"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// endpointEWMAWeight is the weight of the latest request in the moving
+	// averages of the latency and the error rate of an endpoint
+	endpointEWMAWeight = 0.2
+
+	// the penalties added to the latency of an endpoint, in seconds, to score
+	// it, so that a node erring or lagging behind is not preferred because it
+	// answers fast
+	errorRatePenalty = 10.0
+	tipLagPenalty    = 1.0
+
+	// endpointSwitchMargin is how much healthier, in score, an endpoint has to
+	// be to take over the active one, so that the requests do not flap between
+	// endpoints of a similar health
+	endpointSwitchMargin = 0.5
+)
+
+// bbnEndpoint is a Babylon node the controller is connected to, with its
+// health. The health is guarded by the lock of the pool
+type bbnEndpoint struct {
+	addr   string
+	client *bbnclient.Client
+
+	latency   time.Duration
+	errorRate float64
+	tipHeight uint64
+	lastErr   string
+}
+
+// endpointPool routes the requests to the healthiest of the endpoints, which
+// are scored by their latency, error rate, and how many blocks they lag behind
+// the others
+type endpointPool struct {
+	mu        sync.Mutex
+	endpoints []*bbnEndpoint
+	// active is the index of the endpoint the requests are routed to
+	active int
+
+	logger *zap.Logger
+}
+
+func newEndpointPool(endpoints []*bbnEndpoint, logger *zap.Logger) *endpointPool {
+	return &endpointPool{
+		endpoints: endpoints,
+		logger:    logger,
+	}
+}
+
+// ranked returns the endpoints in the order they should be tried, the active
+// one first. The active endpoint is switched beforehand if another one is
+// healthier by the margin
+func (p *endpointPool) ranked() []*bbnEndpoint {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	maxTip := p.maxTipHeight()
+	scores := make([]float64, len(p.endpoints))
+	for i, e := range p.endpoints {
+		scores[i] = e.score(maxTip)
+	}
+
+	indexes := make([]int, len(p.endpoints))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return scores[indexes[i]] < scores[indexes[j]]
+	})
+
+	if best := indexes[0]; best != p.active && scores[best]+endpointSwitchMargin < scores[p.active] {
+		p.logger.Warn("switching to a healthier Babylon endpoint",
+			zap.String("from", p.endpoints[p.active].addr),
+			zap.Float64("from_score", scores[p.active]),
+			zap.String("to", p.endpoints[best].addr),
+			zap.Float64("to_score", scores[best]),
+		)
+		p.active = best
+	}
+
+	ranked := []*bbnEndpoint{p.endpoints[p.active]}
+	for _, i := range indexes {
+		if i != p.active {
+			ranked = append(ranked, p.endpoints[i])
+		}
+	}
+
+	return ranked
+}
+
+// best returns the endpoint the requests are routed to
+func (p *endpointPool) best() *bbnEndpoint {
+	return p.ranked()[0]
+}
+
+// observe records the result of a query to the endpoint and how long it took
+func (p *endpointPool) observe(e *bbnEndpoint, elapsed time.Duration, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err == nil {
+		e.latency = ewmaDuration(e.latency, elapsed)
+	}
+	p.observeResult(e, err)
+}
+
+// observeBroadcast records the result of a transaction sent to the endpoint,
+// whose latency depends on the inclusion of the transaction and is ignored
+func (p *endpointPool) observeBroadcast(e *bbnEndpoint, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.observeResult(e, err)
+}
+
+// observeTip records the height of the latest block of the endpoint
+func (p *endpointPool) observeTip(e *bbnEndpoint, height uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	e.tipHeight = height
+}
+
+// observeResult should be called with the lock held
+func (p *endpointPool) observeResult(e *bbnEndpoint, err error) {
+	failed := 0.0
+	if err != nil {
+		failed = 1
+		e.lastErr = err.Error()
+	}
+	e.errorRate = e.errorRate*(1-endpointEWMAWeight) + failed*endpointEWMAWeight
+}
+
+// health returns the health of the endpoints in their configured order
+func (p *endpointPool) health() []*EndpointHealth {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	maxTip := p.maxTipHeight()
+	health := make([]*EndpointHealth, 0, len(p.endpoints))
+	for i, e := range p.endpoints {
+		health = append(health, &EndpointHealth{
+			Address:   e.addr,
+			Active:    i == p.active,
+			Latency:   e.latency,
+			ErrorRate: e.errorRate,
+			TipHeight: e.tipHeight,
+			TipLag:    e.tipLag(maxTip),
+			LastError: e.lastErr,
+			Score:     e.score(maxTip),
+		})
+	}
+
+	return health
+}
+
+// maxTipHeight should be called with the lock held
+func (p *endpointPool) maxTipHeight() uint64 {
+	var maxTip uint64
+	for _, e := range p.endpoints {
+		if e.tipHeight > maxTip {
+			maxTip = e.tipHeight
+		}
+	}
+
+	return maxTip
+}
+
+// tipLag returns how many blocks the endpoint is behind the given tip. An
+// endpoint whose tip is unknown yet is not considered lagging
+func (e *bbnEndpoint) tipLag(maxTip uint64) uint64 {
+	if e.tipHeight == 0 {
+		return 0
+	}
+
+	return maxTip - e.tipHeight
+}
+
+func (e *bbnEndpoint) score(maxTip uint64) float64 {
+	return e.latency.Seconds() +
+		e.errorRate*errorRatePenalty +
+		float64(e.tipLag(maxTip))*tipLagPenalty
+}
+
+func ewmaDuration(avg, sample time.Duration) time.Duration {
+	if avg == 0 {
+		return sample
+	}
+
+	return time.Duration(float64(avg)*(1-endpointEWMAWeight) + float64(sample)*endpointEWMAWeight)
+}
+
+// isEndpointError tells whether the error of a query is a failure of the
+// endpoint, e.g., it is unreachable, timed out, or overloaded, rather than the
+// answer of the chain, e.g., that the queried item does not exist, which any
+// other endpoint would give as well. The errors of the chain are returned by
+// the endpoints as gRPC statuses, or as the errors registered by its modules
+func isEndpointError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var chainErr *sdkErr.Error
+	if errors.As(err, &chainErr) {
+		return false
+	}
+
+	s, ok := status.FromError(err)
+	if !ok {
+		// transport errors, e.g., the connection is refused
+		return true
+	}
+	switch s.Code() {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled,
+		codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,123 @@
+package clientcontroller
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	bbnclient "github.com/babylonchain/babylon/client/client"
+	finalitytypes "github.com/babylonchain/babylon/x/finality/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newTestEndpointPool(addrs ...string) *endpointPool {
+	endpoints := make([]*bbnEndpoint, 0, len(addrs))
+	for _, addr := range addrs {
+		endpoints = append(endpoints, &bbnEndpoint{addr: addr})
+	}
+
+	return newEndpointPool(endpoints, zap.NewNop())
+}
+
+func rankedAddrs(p *endpointPool) []string {
+	var addrs []string
+	for _, e := range p.ranked() {
+		addrs = append(addrs, e.addr)
+	}
+
+	return addrs
+}
+
+func TestEndpointPoolPrefersConfiguredOrder(t *testing.T) {
+	p := newTestEndpointPool("a", "b", "c")
+	require.Equal(t, []string{"a", "b", "c"}, rankedAddrs(p))
+
+	// a slightly faster endpoint does not take over the active one
+	p.observe(p.endpoints[0], 200*time.Millisecond, nil)
+	p.observe(p.endpoints[1], 100*time.Millisecond, nil)
+	require.Equal(t, "a", p.best().addr)
+}
+
+func TestEndpointPoolFailsOver(t *testing.T) {
+	p := newTestEndpointPool("a", "b", "c")
+	a, b, c := p.endpoints[0], p.endpoints[1], p.endpoints[2]
+	p.observe(b, 100*time.Millisecond, nil)
+	p.observe(c, 300*time.Millisecond, nil)
+
+	// the erring endpoint is tried last
+	p.observe(a, time.Second, fmt.Errorf("connection refused"))
+	require.Equal(t, []string{"b", "c", "a"}, rankedAddrs(p))
+
+	health := p.health()
+	require.Len(t, health, 3)
+	require.Equal(t, "a", health[0].Address)
+	require.False(t, health[0].Active)
+	require.Equal(t, "connection refused", health[0].LastError)
+	require.InDelta(t, endpointEWMAWeight, health[0].ErrorRate, 1e-9)
+	require.True(t, health[1].Active)
+
+	// the endpoint recovers once its errors are averaged out
+	for i := 0; i < 50; i++ {
+		p.observe(a, 100*time.Millisecond, nil)
+		p.observe(b, 2*time.Second, nil)
+	}
+	require.Equal(t, "a", p.best().addr)
+}
+
+func TestEndpointPoolAvoidsLaggingEndpoints(t *testing.T) {
+	p := newTestEndpointPool("a", "b")
+	a, b := p.endpoints[0], p.endpoints[1]
+	p.observeTip(a, 100)
+	p.observeTip(b, 110)
+	require.Equal(t, "b", p.best().addr)
+
+	health := p.health()
+	require.Equal(t, uint64(10), health[0].TipLag)
+	require.Equal(t, uint64(0), health[1].TipLag)
+	require.Greater(t, health[0].Score, health[1].Score)
+}
+
+func TestQueryDoesNotCountChainErrors(t *testing.T) {
+	p := newTestEndpointPool("a", "b")
+	bc := &BabylonController{endpoints: p, logger: zap.NewNop()}
+
+	// the answers of the chain are returned without trying another endpoint
+	for _, chainErr := range []error{
+		status.Error(codes.NotFound, "finality provider not found"),
+		status.Error(codes.Unknown, "the voting power table is not updated"),
+		finalitytypes.ErrPubRandNotFound,
+	} {
+		tried := 0
+		err := bc.query(func(*bbnclient.Client) error {
+			tried++
+			return chainErr
+		})
+		require.Equal(t, chainErr, err)
+		require.Equal(t, 1, tried)
+	}
+	for _, h := range p.health() {
+		require.Zero(t, h.ErrorRate)
+		require.Empty(t, h.LastError)
+	}
+
+	// an unreachable endpoint is counted and failed over
+	tried := 0
+	err := bc.query(func(*bbnclient.Client) error {
+		tried++
+		if tried == 1 {
+			return fmt.Errorf("connection refused")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, tried)
+	health := p.health()
+	require.InDelta(t, endpointEWMAWeight, health[0].ErrorRate, 1e-9)
+	require.Zero(t, health[1].ErrorRate)
+
+	// so is an endpoint timing out
+	require.True(t, isEndpointError(status.Error(codes.DeadlineExceeded, "timeout")))
+}
@@ -1,7 +1,9 @@
 package clientcontroller
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -69,6 +71,41 @@ type ClientController interface {
 	Close() error
 }
 
+// BlockSubscriber is implemented by the client controllers which can push the
+// new blocks of the consumer chain instead of having them polled
+type BlockSubscriber interface {
+	// SubscribeNewBlocks returns a channel receiving the blocks of the consumer
+	// chain as they are produced. The channel is closed when the subscription
+	// drops or the context is done
+	SubscribeNewBlocks(ctx context.Context) (<-chan *types.BlockInfo, error)
+}
+
+// EndpointHealthReporter is implemented by the client controllers which track
+// the health of the endpoints of the consumer chain they are connected to
+type EndpointHealthReporter interface {
+	// EndpointHealth returns the health of every endpoint, in the order they
+	// are configured
+	EndpointHealth() []*EndpointHealth
+}
+
+// EndpointHealth is the health of an endpoint of the consumer chain
+type EndpointHealth struct {
+	Address string
+	// Active is whether the requests are currently routed to the endpoint
+	Active bool
+	// Latency is the moving average of the latency of the queries
+	Latency time.Duration
+	// ErrorRate is the moving average of the ratio of failed requests
+	ErrorRate float64
+	// TipHeight is the height of the latest block of the endpoint, and TipLag
+	// the number of blocks it is behind the most advanced endpoint
+	TipHeight uint64
+	TipLag    uint64
+	LastError string
+	// Score ranks the endpoints, the lower the healthier
+	Score float64
+}
+
 func NewClientController(chainName string, bbnConfig *fpcfg.BBNConfig, netParams *chaincfg.Params, logger *zap.Logger) (ClientController, error) {
 	var (
 		cc  ClientController
@@ -0,0 +1,173 @@
+package clientcontroller
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	bbnclient "github.com/babylonchain/babylon/client/client"
+	"go.uber.org/zap"
+
+	"github.com/babylonchain/finality-provider/types"
+)
+
+// ErrNoBlockQuorum is returned when not enough endpoints return a block to
+// confirm it
+var ErrNoBlockQuorum = errors.New("not enough endpoints confirmed the block")
+
+// BlockDisagreementError is returned when the endpoints of the consumer chain
+// return different blocks at the same height
+type BlockDisagreementError struct {
+	Height uint64
+	// Hashes are the hashes of the block returned by the endpoints, keyed by
+	// the addresses of the endpoints
+	Hashes map[string][]byte
+}
+
+func (e *BlockDisagreementError) Error() string {
+	endpoints := make([]string, 0, len(e.Hashes))
+	for endpoint := range e.Hashes {
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Strings(endpoints)
+
+	hashes := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		hashes = append(hashes, fmt.Sprintf("%s: %s", endpoint, hex.EncodeToString(e.Hashes[endpoint])))
+	}
+
+	return fmt.Sprintf("the endpoints disagree on the block at height %d (%s)",
+		e.Height, strings.Join(hashes, ", "))
+}
+
+// quorumQueryBlocks runs the query of blocks on the endpoints until each
+// block is returned by the quorum of endpoints, and returns the longest run
+// of blocks confirmed from the first one. Two endpoints returning different
+// hashes at a height fail the query, whichever the quorum is. A block is
+// reported finalized only if all the endpoints returning it report it so
+func (bc *BabylonController) quorumQueryBlocks(q func(client *bbnclient.Client) ([]*types.BlockInfo, error)) ([]*types.BlockInfo, error) {
+	var (
+		blocks []*types.BlockInfo
+		// the hashes returned by the endpoints, by height
+		hashes = make(map[uint64]map[string][]byte)
+		// the heights of the blocks reported not finalized by any endpoint
+		notFinalized = make(map[uint64]bool)
+		lastErr      error
+	)
+	for _, e := range bc.endpoints.ranked() {
+		start := time.Now()
+		res, err := q(e.client)
+		if err == nil || isEndpointError(err) {
+			bc.endpoints.observe(e, time.Since(start), err)
+		}
+		if err != nil {
+			lastErr = err
+			bc.logger.Debug("failed to query the blocks from the Babylon endpoint",
+				zap.String("endpoint", e.addr), zap.Error(err))
+			continue
+		}
+
+		for _, b := range res {
+			if err := addBlockHash(hashes, e.addr, b); err != nil {
+				return nil, err
+			}
+			if !b.Finalized {
+				notFinalized[b.Height] = true
+			}
+		}
+		if len(blocks) == 0 {
+			blocks = res
+		}
+
+		if len(blocks) > 0 && bc.numConfirmed(blocks, hashes) == len(blocks) {
+			break
+		}
+	}
+
+	confirmed := blocks[:bc.numConfirmed(blocks, hashes)]
+	if len(confirmed) == 0 {
+		if lastErr != nil {
+			return nil, fmt.Errorf("%w: %v", ErrNoBlockQuorum, lastErr)
+		}
+		return nil, ErrNoBlockQuorum
+	}
+	for _, b := range confirmed {
+		b.Finalized = !notFinalized[b.Height]
+	}
+
+	return confirmed, nil
+}
+
+// numConfirmed returns the number of blocks confirmed by the quorum from the
+// first one
+func (bc *BabylonController) numConfirmed(blocks []*types.BlockInfo, hashes map[uint64]map[string][]byte) int {
+	for i, b := range blocks {
+		if len(hashes[b.Height]) < int(bc.cfg.BlockQuorum) {
+			return i
+		}
+	}
+
+	return len(blocks)
+}
+
+// addBlockHash records the hash of the block returned by the endpoint, and
+// fails if another endpoint returned another hash at the height
+func addBlockHash(hashes map[uint64]map[string][]byte, endpoint string, b *types.BlockInfo) error {
+	byEndpoint, ok := hashes[b.Height]
+	if !ok {
+		byEndpoint = make(map[string][]byte)
+		hashes[b.Height] = byEndpoint
+	}
+	byEndpoint[endpoint] = b.Hash
+
+	for _, h := range byEndpoint {
+		if !bytes.Equal(h, b.Hash) {
+			return &BlockDisagreementError{Height: b.Height, Hashes: byEndpoint}
+		}
+	}
+
+	return nil
+}
+
+// confirmSubscribedBlock checks the block received from the new block
+// subscription against the block confirmed by the quorum, retrying while the
+// other endpoints do not have the block yet
+func (bc *BabylonController) confirmSubscribedBlock(ctx context.Context, endpoint string, block *types.BlockInfo) error {
+	var (
+		confirmed *types.BlockInfo
+		err       error
+	)
+	for i := uint32(0); i < bc.cfg.BlockConfirmAttempts; i++ {
+		confirmed, err = bc.QueryBlock(block.Height)
+		var disagreement *BlockDisagreementError
+		if err == nil || errors.As(err, &disagreement) {
+			break
+		}
+
+		select {
+		case <-time.After(bc.cfg.BlockConfirmDelay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(confirmed.Hash, block.Hash) {
+		return &BlockDisagreementError{
+			Height: block.Height,
+			Hashes: map[string][]byte{
+				endpoint: block.Hash,
+				"quorum": confirmed.Hash,
+			},
+		}
+	}
+
+	return nil
+}
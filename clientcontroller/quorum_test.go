@@ -0,0 +1,101 @@
+package clientcontroller
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	bbnclient "github.com/babylonchain/babylon/client/client"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	fpcfg "github.com/babylonchain/finality-provider/finality-provider/config"
+	"github.com/babylonchain/finality-provider/types"
+)
+
+// quorumTestController returns a controller with an endpoint per list of
+// blocks, or per error, returned by the query of the test
+func quorumTestController(quorum uint32, results ...interface{}) (*BabylonController, func(*bbnclient.Client) ([]*types.BlockInfo, error)) {
+	endpoints := make([]*bbnEndpoint, 0, len(results))
+	byClient := make(map[*bbnclient.Client]interface{})
+	for i, res := range results {
+		client := &bbnclient.Client{}
+		byClient[client] = res
+		endpoints = append(endpoints, &bbnEndpoint{addr: fmt.Sprintf("node%d", i), client: client})
+	}
+
+	bc := &BabylonController{
+		endpoints: newEndpointPool(endpoints, zap.NewNop()),
+		cfg:       &fpcfg.BBNConfig{BlockQuorum: quorum},
+		logger:    zap.NewNop(),
+	}
+	q := func(client *bbnclient.Client) ([]*types.BlockInfo, error) {
+		switch res := byClient[client].(type) {
+		case error:
+			return nil, res
+		default:
+			return res.([]*types.BlockInfo), nil
+		}
+	}
+
+	return bc, q
+}
+
+func testBlocks(fromHeight uint64, hashes ...string) []*types.BlockInfo {
+	blocks := make([]*types.BlockInfo, 0, len(hashes))
+	for i, h := range hashes {
+		blocks = append(blocks, &types.BlockInfo{Height: fromHeight + uint64(i), Hash: []byte(h)})
+	}
+
+	return blocks
+}
+
+func TestQuorumQueryBlocks(t *testing.T) {
+	// the blocks are confirmed by the two first endpoints
+	bc, q := quorumTestController(2, testBlocks(1, "a", "b"), testBlocks(1, "a", "b"), errors.New("unreachable"))
+	blocks, err := bc.quorumQueryBlocks(q)
+	require.NoError(t, err)
+	require.Equal(t, testBlocks(1, "a", "b"), blocks)
+
+	// only the blocks known by the quorum are confirmed
+	bc, q = quorumTestController(2, testBlocks(1, "a", "b"), testBlocks(1, "a"))
+	blocks, err = bc.quorumQueryBlocks(q)
+	require.NoError(t, err)
+	require.Equal(t, testBlocks(1, "a"), blocks)
+
+	// a failing endpoint is replaced by the next one
+	bc, q = quorumTestController(2, testBlocks(1, "a"), errors.New("unreachable"), testBlocks(1, "a"))
+	blocks, err = bc.quorumQueryBlocks(q)
+	require.NoError(t, err)
+	require.Equal(t, testBlocks(1, "a"), blocks)
+
+	// not enough endpoints return the blocks
+	bc, q = quorumTestController(2, testBlocks(1, "a"), errors.New("unreachable"))
+	_, err = bc.quorumQueryBlocks(q)
+	require.ErrorIs(t, err, ErrNoBlockQuorum)
+
+	// the endpoints return different blocks
+	bc, q = quorumTestController(2, testBlocks(1, "a", "b"), testBlocks(1, "a", "c"))
+	_, err = bc.quorumQueryBlocks(q)
+	var disagreement *BlockDisagreementError
+	require.ErrorAs(t, err, &disagreement)
+	require.Equal(t, uint64(2), disagreement.Height)
+	require.Equal(t, map[string][]byte{"node0": []byte("b"), "node1": []byte("c")}, disagreement.Hashes)
+
+	// a block is finalized only if all the endpoints returning it agree
+	finalized := func(fromHeight uint64, hashes ...string) []*types.BlockInfo {
+		blocks := testBlocks(fromHeight, hashes...)
+		for _, b := range blocks {
+			b.Finalized = true
+		}
+		return blocks
+	}
+	bc, q = quorumTestController(2, finalized(1, "a", "b"), append(finalized(1, "a"), testBlocks(2, "b")...))
+	blocks, err = bc.quorumQueryBlocks(q)
+	require.NoError(t, err)
+	require.Equal(t, append(finalized(1, "a"), testBlocks(2, "b")...), blocks)
+	bc, q = quorumTestController(2, testBlocks(1, "a"), finalized(1, "a"))
+	blocks, err = bc.quorumQueryBlocks(q)
+	require.NoError(t, err)
+	require.Equal(t, testBlocks(1, "a"), blocks)
+}
@@ -0,0 +1,91 @@
+package dbbackup
+
+import (
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"go.uber.org/zap"
+)
+
+// Backuper takes snapshots of the database of a running daemon on demand
+// and, if an interval is configured, periodically
+type Backuper struct {
+	db     kvdb.Backend
+	cfg    *Config
+	prefix string
+	logger *zap.Logger
+
+	// mu serializes the snapshots so that pruning never races
+	mu sync.Mutex
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewBackuper creates a backuper writing the snapshots of db named after
+// the prefix to the configured directory
+func NewBackuper(db kvdb.Backend, cfg *Config, prefix string, logger *zap.Logger) *Backuper {
+	return &Backuper{
+		db:     db,
+		cfg:    cfg,
+		prefix: prefix,
+		logger: logger,
+		quit:   make(chan struct{}),
+	}
+}
+
+// Backup takes a snapshot, prunes the old ones, and returns the path of the
+// new snapshot
+func (b *Backuper) Backup() (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	path, err := Snapshot(b.db, b.cfg.Dir, b.prefix)
+	if err != nil {
+		return "", err
+	}
+
+	if err := Prune(b.cfg.Dir, b.prefix, b.cfg.Keep); err != nil {
+		// the new snapshot is still usable
+		b.logger.Warn("failed to prune the old database snapshots", zap.Error(err))
+	}
+
+	b.logger.Info("database snapshot taken", zap.String("path", path))
+
+	return path, nil
+}
+
+// Start starts the periodic backups if an interval is configured
+func (b *Backuper) Start() {
+	if b.cfg.Interval == 0 {
+		return
+	}
+
+	b.wg.Add(1)
+	go b.backupLoop()
+}
+
+// Stop stops the periodic backups and waits for the ongoing one
+func (b *Backuper) Stop() {
+	close(b.quit)
+	b.wg.Wait()
+}
+
+func (b *Backuper) backupLoop() {
+	defer b.wg.Done()
+
+	ticker := time.NewTicker(b.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if _, err := b.Backup(); err != nil {
+				b.logger.Error("failed to take the periodic database snapshot", zap.Error(err))
+			}
+		case <-b.quit:
+			return
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package dbbackup
+
+import (
+	"fmt"
+	"time"
+)
+
+const defaultKeep = 7
+
+// Config defines where the snapshots of the database of a daemon are written
+// and how often they are taken
+type Config struct {
+	Dir      string        `long:"dir" description:"The directory where the snapshots of the database are written"`
+	Interval time.Duration `long:"interval" description:"The interval between periodic snapshots of the database, which are disabled if the value is 0"`
+	Keep     uint32        `long:"keep" description:"The number of the most recent snapshots to keep in the directory, or 0 to keep all of them"`
+}
+
+func DefaultConfig(dir string) *Config {
+	return &Config{
+		Dir:  dir,
+		Keep: defaultKeep,
+	}
+}
+
+func (cfg *Config) Validate() error {
+	if cfg.Interval < 0 {
+		return fmt.Errorf("the backup interval should not be negative")
+	}
+
+	if cfg.Interval > 0 && cfg.Dir == "" {
+		return fmt.Errorf("the backup directory should be set for periodic backups")
+	}
+
+	return nil
+}
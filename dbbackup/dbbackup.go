@@ -0,0 +1,272 @@
+// Package dbbackup takes consistent snapshots of the bolt database of a
+// daemon while it is running, and restores them once they are validated.
+package dbbackup
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	snapshotExt        = ".db"
+	snapshotTimeLayout = "20060102T150405.000000000Z"
+
+	// lockTimeout is how long to wait for the lock of a database file
+	// before concluding that it is in use by a daemon
+	lockTimeout = time.Second
+)
+
+// ErrDBInUse The database file is locked by a running daemon
+var ErrDBInUse = errors.New("the database is in use by a running daemon")
+
+// Validator checks that a database holds the buckets of a daemon and that
+// their entries are well-formed
+type Validator func(db kvdb.Backend) error
+
+// Merger carries over to the restored database the data of the live database
+// which must not be rolled back, e.g., the records of what was signed
+type Merger func(live, restored kvdb.Backend) error
+
+// Snapshot writes a copy of db to a new file in dir, named after the prefix
+// and the current time, and returns its path. The copy is taken in a single
+// read transaction, so it is consistent while writers proceed
+func Snapshot(db kvdb.Backend, dir, prefix string) (string, error) {
+	if dir == "" {
+		return "", fmt.Errorf("the backup directory is not set")
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", fmt.Errorf("failed to create the backup directory %s: %w", dir, err)
+	}
+
+	name := prefix + "-" + time.Now().UTC().Format(snapshotTimeLayout) + snapshotExt
+	path := filepath.Join(dir, name)
+
+	// the snapshot is only visible under its name once it is complete
+	if err := writeFileAtomic(path, db.Copy); err != nil {
+		return "", fmt.Errorf("failed to write the snapshot %s: %w", path, err)
+	}
+
+	return path, nil
+}
+
+// Prune removes the oldest snapshots with the given prefix in dir so that
+// only the keep most recent ones are left. Nothing is removed if keep is 0
+func Prune(dir, prefix string, keep uint32) error {
+	if keep == 0 {
+		return nil
+	}
+
+	snapshots, err := ListSnapshots(dir, prefix)
+	if err != nil {
+		return err
+	}
+	if len(snapshots) <= int(keep) {
+		return nil
+	}
+
+	for _, path := range snapshots[:len(snapshots)-int(keep)] {
+		if err := os.Remove(path); err != nil {
+			return fmt.Errorf("failed to remove the old snapshot %s: %w", path, err)
+		}
+	}
+
+	return nil
+}
+
+// ListSnapshots returns the paths of the snapshots with the given prefix in
+// dir from the oldest to the most recent
+func ListSnapshots(dir, prefix string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the backup directory %s: %w", dir, err)
+	}
+
+	var snapshots []string
+	for _, e := range entries {
+		name := e.Name()
+		if e.Type().IsRegular() && strings.HasPrefix(name, prefix+"-") && strings.HasSuffix(name, snapshotExt) {
+			snapshots = append(snapshots, filepath.Join(dir, name))
+		}
+	}
+	// the names sort by the time they were taken
+	sort.Strings(snapshots)
+
+	return snapshots, nil
+}
+
+// Validate opens the snapshot at the given path and checks it
+func Validate(path string, validate Validator) error {
+	if _, err := os.Stat(path); err != nil {
+		return err
+	}
+
+	db, err := open(filepath.Dir(path), filepath.Base(path))
+	if err != nil {
+		return fmt.Errorf("failed to open the snapshot %s: %w", path, err)
+	}
+	defer db.Close()
+
+	if err := validate(db); err != nil {
+		return fmt.Errorf("invalid snapshot %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// Restore validates the snapshot and swaps it in place of the database file
+// dbFileName in dbPath. It fails with ErrDBInUse if a daemon is running on
+// the database. The replaced database is kept next to it, and its path is
+// returned, or an empty path if there was no database. If merge is not nil,
+// it is applied to the live database and the validated snapshot before the
+// swap, and the restore is aborted if it fails
+func Restore(snapshotPath, dbPath, dbFileName string, validate Validator, merge Merger) (string, error) {
+	if err := CheckNotInUse(dbPath, dbFileName); err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dbPath, 0700); err != nil {
+		return "", err
+	}
+
+	src, err := os.Open(snapshotPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open the snapshot: %w", err)
+	}
+	defer src.Close()
+
+	// the snapshot is validated once copied, so that the validated
+	// file is the one swapped in
+	restoring := filepath.Join(dbPath, dbFileName+".restoring")
+	err = writeFileAtomic(restoring, func(w io.Writer) error {
+		_, err := io.Copy(w, src)
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to copy the snapshot: %w", err)
+	}
+	if err := Validate(restoring, validate); err != nil {
+		os.Remove(restoring)
+		return "", err
+	}
+
+	dbFile := filepath.Join(dbPath, dbFileName)
+	if merge != nil {
+		if err := mergeLive(dbPath, dbFileName, restoring, merge); err != nil {
+			os.Remove(restoring)
+			return "", fmt.Errorf("failed to carry over the data of the current database: %w", err)
+		}
+		// the merged entries are checked as well
+		if err := Validate(restoring, validate); err != nil {
+			os.Remove(restoring)
+			return "", err
+		}
+	}
+
+	var previous string
+	if _, err := os.Stat(dbFile); err == nil {
+		previous = fmt.Sprintf("%s.pre-restore-%s", dbFile, time.Now().UTC().Format(snapshotTimeLayout))
+		if err := os.Rename(dbFile, previous); err != nil {
+			os.Remove(restoring)
+			return "", fmt.Errorf("failed to move the current database aside: %w", err)
+		}
+	}
+	if err := os.Rename(restoring, dbFile); err != nil {
+		return "", fmt.Errorf("failed to move the snapshot in place: %w", err)
+	}
+
+	return previous, nil
+}
+
+// mergeLive applies merge to the database file dbFileName in dbPath and the
+// snapshot at the path restoring. Nothing is merged if there is no database
+func mergeLive(dbPath, dbFileName, restoring string, merge Merger) error {
+	live, err := OpenExisting(dbPath, dbFileName)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer live.Close()
+
+	restored, err := open(filepath.Dir(restoring), filepath.Base(restoring))
+	if err != nil {
+		return err
+	}
+	defer restored.Close()
+
+	return merge(live, restored)
+}
+
+// CheckNotInUse fails with ErrDBInUse if the database file dbFileName in
+// dbPath is locked by a running daemon. It succeeds if the file does not exist
+func CheckNotInUse(dbPath, dbFileName string) error {
+	db, err := OpenExisting(dbPath, dbFileName)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return db.Close()
+}
+
+// OpenExisting opens the existing database file dbFileName in dbPath. It
+// fails with ErrDBInUse if a daemon is running on it, or with an error
+// wrapping os.ErrNotExist if the file does not exist
+func OpenExisting(dbPath, dbFileName string) (kvdb.Backend, error) {
+	if _, err := os.Stat(filepath.Join(dbPath, dbFileName)); err != nil {
+		return nil, err
+	}
+
+	return open(dbPath, dbFileName)
+}
+
+func open(dbPath, dbFileName string) (kvdb.Backend, error) {
+	db, err := kvdb.GetBoltBackend(&kvdb.BoltBackendConfig{
+		DBPath:     dbPath,
+		DBFileName: dbFileName,
+		DBTimeout:  lockTimeout,
+	})
+	if errors.Is(err, bbolt.ErrTimeout) {
+		return nil, ErrDBInUse
+	}
+
+	return db, err
+}
+
+// writeFileAtomic writes the file at path through a temporary file which is
+// synced and renamed once write succeeds
+func writeFileAtomic(path string, write func(w io.Writer) error) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := write(tmp); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,195 @@
+package dbbackup_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/finality-provider/dbbackup"
+)
+
+const (
+	testDBFileName = "test.db"
+	testPrefix     = "test"
+)
+
+var testBucketName = []byte("test")
+
+// validateTestDB checks that every value of the test bucket is the 8-byte
+// encoding of its key
+func validateTestDB(db kvdb.Backend) error {
+	return db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(testBucketName)
+		if bucket == nil {
+			return fmt.Errorf("missing bucket")
+		}
+		return bucket.ForEach(func(k, v []byte) error {
+			if len(k) != 8 || string(k) != string(v) {
+				return fmt.Errorf("invalid entry %x", k)
+			}
+			return nil
+		})
+	}, func() {})
+}
+
+// copyTestEntries copies the entries of the test bucket of live into restored
+func copyTestEntries(live, restored kvdb.Backend) error {
+	var keys [][]byte
+	err := live.View(func(tx kvdb.RTx) error {
+		return tx.ReadBucket(testBucketName).ForEach(func(k, _ []byte) error {
+			keys = append(keys, append([]byte(nil), k...))
+			return nil
+		})
+	}, func() {
+		keys = nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return kvdb.Update(restored, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(testBucketName)
+		for _, k := range keys {
+			if err := bucket.Put(k, k); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, func() {})
+}
+
+func openTestDB(t *testing.T, dbPath string) kvdb.Backend {
+	db, err := kvdb.GetBoltBackend(&kvdb.BoltBackendConfig{
+		DBPath:     dbPath,
+		DBFileName: testDBFileName,
+		DBTimeout:  kvdb.DefaultDBTimeout,
+	})
+	require.NoError(t, err)
+
+	err = kvdb.Batch(db, func(tx kvdb.RwTx) error {
+		_, err := tx.CreateTopLevelBucket(testBucketName)
+		return err
+	})
+	require.NoError(t, err)
+
+	return db
+}
+
+func putEntry(db kvdb.Backend, i uint64) error {
+	k := make([]byte, 8)
+	binary.BigEndian.PutUint64(k, i)
+	return kvdb.Batch(db, func(tx kvdb.RwTx) error {
+		return tx.ReadWriteBucket(testBucketName).Put(k, k)
+	})
+}
+
+func countEntries(t *testing.T, db kvdb.Backend) int {
+	n := 0
+	err := db.View(func(tx kvdb.RTx) error {
+		return tx.ReadBucket(testBucketName).ForEach(func(_, _ []byte) error {
+			n++
+			return nil
+		})
+	}, func() { n = 0 })
+	require.NoError(t, err)
+
+	return n
+}
+
+func TestSnapshotWhileWriting(t *testing.T) {
+	dbPath := t.TempDir()
+	backupDir := filepath.Join(t.TempDir(), "backups")
+	db := openTestDB(t, dbPath)
+	defer db.Close()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := uint64(0); i < 200; i++ {
+			require.NoError(t, putEntry(db, i))
+		}
+	}()
+
+	var snapshots []string
+	for i := 0; i < 5; i++ {
+		path, err := dbbackup.Snapshot(db, backupDir, testPrefix)
+		require.NoError(t, err)
+		require.NoError(t, dbbackup.Validate(path, validateTestDB))
+		snapshots = append(snapshots, path)
+	}
+	wg.Wait()
+
+	listed, err := dbbackup.ListSnapshots(backupDir, testPrefix)
+	require.NoError(t, err)
+	require.Equal(t, snapshots, listed)
+
+	info, err := os.Stat(snapshots[0])
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	// only the most recent snapshots are kept
+	require.NoError(t, dbbackup.Prune(backupDir, testPrefix, 2))
+	listed, err = dbbackup.ListSnapshots(backupDir, testPrefix)
+	require.NoError(t, err)
+	require.Equal(t, snapshots[3:], listed)
+}
+
+func TestRestore(t *testing.T) {
+	dbPath := t.TempDir()
+	backupDir := t.TempDir()
+	db := openTestDB(t, dbPath)
+	for i := uint64(0); i < 10; i++ {
+		require.NoError(t, putEntry(db, i))
+	}
+	snapshot, err := dbbackup.Snapshot(db, backupDir, testPrefix)
+	require.NoError(t, err)
+	require.NoError(t, putEntry(db, 10))
+
+	// the database cannot be replaced while it is open
+	_, err = dbbackup.Restore(snapshot, dbPath, testDBFileName, validateTestDB, nil)
+	require.ErrorIs(t, err, dbbackup.ErrDBInUse)
+	require.NoError(t, db.Close())
+
+	// an invalid snapshot is not swapped in
+	invalid := filepath.Join(backupDir, "invalid.db")
+	require.NoError(t, os.WriteFile(invalid, []byte("not a database"), 0600))
+	_, err = dbbackup.Restore(invalid, dbPath, testDBFileName, validateTestDB, nil)
+	require.Error(t, err)
+	_, err = dbbackup.Restore(snapshot, dbPath, testDBFileName, func(kvdb.Backend) error {
+		return fmt.Errorf("rejected")
+	}, nil)
+	require.ErrorContains(t, err, "rejected")
+
+	// a failing merge aborts the restore
+	_, err = dbbackup.Restore(snapshot, dbPath, testDBFileName, validateTestDB, func(kvdb.Backend, kvdb.Backend) error {
+		return fmt.Errorf("unreadable")
+	})
+	require.ErrorContains(t, err, "unreadable")
+
+	// the entries added since the snapshot are carried over
+	_, err = dbbackup.Restore(snapshot, dbPath, testDBFileName, validateTestDB, copyTestEntries)
+	require.NoError(t, err)
+	db, err = dbbackup.OpenExisting(dbPath, testDBFileName)
+	require.NoError(t, err)
+	require.Equal(t, 11, countEntries(t, db))
+	require.NoError(t, db.Close())
+
+	previous, err := dbbackup.Restore(snapshot, dbPath, testDBFileName, validateTestDB, nil)
+	require.NoError(t, err)
+	require.FileExists(t, previous)
+
+	db, err = dbbackup.OpenExisting(dbPath, testDBFileName)
+	require.NoError(t, err)
+	defer db.Close()
+	require.Equal(t, 10, countEntries(t, db))
+
+	_, err = os.Stat(filepath.Join(dbPath, testDBFileName+".restoring"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -0,0 +1,28 @@
+package client
+
+import (
+	"context"
+
+	"google.golang.org/grpc/credentials"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/types"
+)
+
+var _ credentials.PerRPCCredentials = tokenCredentials{}
+
+// tokenCredentials attaches a bearer token to the metadata of every call
+type tokenCredentials struct {
+	token string
+}
+
+func (c tokenCredentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{
+		types.AuthMetadataKey: types.AuthScheme + " " + c.token,
+	}, nil
+}
+
+// RequireTransportSecurity returns true so that the token is never sent in
+// cleartext, i.e., only over TLS or a Unix domain socket
+func (c tokenCredentials) RequireTransportSecurity() bool {
+	return true
+}
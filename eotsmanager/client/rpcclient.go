@@ -2,27 +2,70 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials/local"
+	"google.golang.org/grpc/status"
 
 	"github.com/babylonchain/finality-provider/eotsmanager"
 	"github.com/babylonchain/finality-provider/eotsmanager/proto"
 	"github.com/babylonchain/finality-provider/eotsmanager/types"
+	"github.com/babylonchain/finality-provider/metrics"
+	"github.com/babylonchain/finality-provider/util"
 )
 
 var _ eotsmanager.EOTSManager = &EOTSManagerGRpcClient{}
 
 type EOTSManagerGRpcClient struct {
-	client proto.EOTSManagerClient
-	conn   *grpc.ClientConn
+	client   proto.EOTSManagerClient
+	conn     *grpc.ClientConn
+	sessions sessionCache
 }
 
-func NewEOTSManagerGRpcClient(remoteAddr string) (*EOTSManagerGRpcClient, error) {
-	conn, err := grpc.Dial(remoteAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+// NewEOTSManagerGRpcClient connects to the EOTS manager at the given address.
+// The connection is secured by the given TLS config, or is plaintext if it is nil.
+// The auth token, if not empty, is attached to every call, which is refused over plaintext
+// TCP so that the token is never sent in cleartext, and the status and latency of
+// every call are recorded in the gRPC client metrics
+// A Unix domain socket address, i.e., unix:///path/to.sock, is only dialed if the socket is
+// not accessible by other users
+func NewEOTSManagerGRpcClient(remoteAddr string, tlsCfg *tls.Config, authToken string) (*EOTSManagerGRpcClient, error) {
+	socketPath, isUnixSocket := util.UnixSocketPath(remoteAddr)
+	if isUnixSocket {
+		if err := util.CheckUnixSocket(socketPath); err != nil {
+			return nil, fmt.Errorf("invalid EOTS manager socket: %w", err)
+		}
+	}
+
+	creds := insecure.NewCredentials()
+	switch {
+	case tlsCfg != nil:
+		creds = credentials.NewTLS(tlsCfg)
+	case isUnixSocket:
+		// the local credentials do not exchange anything with the server but
+		// let the auth token be sent as the socket is not reachable remotely
+		creds = local.NewCredentials()
+	case authToken != "":
+		return nil, fmt.Errorf("the auth token is only sent over TLS or a Unix domain socket, "+
+			"refusing to send it to %s over plaintext TCP", remoteAddr)
+	}
+
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithUnaryInterceptor(metrics.NewGrpcMetrics().UnaryClientInterceptor),
+	}
+	if authToken != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(tokenCredentials{token: authToken}))
+	}
+
+	conn, err := grpc.Dial(remoteAddr, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build gRPC connection to %s: %w", remoteAddr, err)
 	}
@@ -50,6 +93,11 @@ func (c *EOTSManagerGRpcClient) Ping() error {
 	return nil
 }
 
+// Health returns the status report of the EOTS manager
+func (c *EOTSManagerGRpcClient) Health() (*proto.HealthResponse, error) {
+	return c.client.Health(context.Background(), &proto.HealthRequest{})
+}
+
 func (c *EOTSManagerGRpcClient) CreateKey(name, passphrase, hdPath string) ([]byte, error) {
 	req := &proto.CreateKeyRequest{Name: name, Passphrase: passphrase, HdPath: hdPath}
 	res, err := c.client.CreateKey(context.Background(), req)
@@ -66,9 +114,14 @@ func (c *EOTSManagerGRpcClient) CreateRandomnessPairList(uid, chainID []byte, st
 		ChainId:     chainID,
 		StartHeight: startHeight,
 		Num:         num,
-		Passphrase:  passphrase,
 	}
-	res, err := c.client.CreateRandomnessPairList(context.Background(), req)
+	var res *proto.CreateRandomnessPairListResponse
+	err := c.withSession(uid, passphrase, func(session, passphrase string) error {
+		req.Session, req.Passphrase = session, passphrase
+		var err error
+		res, err = c.client.CreateRandomnessPairList(context.Background(), req)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -101,14 +154,22 @@ func (c *EOTSManagerGRpcClient) KeyRecord(uid []byte, passphrase string) (*types
 
 func (c *EOTSManagerGRpcClient) SignEOTS(uid, chaiID, msg []byte, height uint64, passphrase string) (*btcec.ModNScalar, error) {
 	req := &proto.SignEOTSRequest{
-		Uid:        uid,
-		ChainId:    chaiID,
-		Msg:        msg,
-		Height:     height,
-		Passphrase: passphrase,
+		Uid:     uid,
+		ChainId: chaiID,
+		Msg:     msg,
+		Height:  height,
 	}
-	res, err := c.client.SignEOTS(context.Background(), req)
+	var res *proto.SignEOTSResponse
+	err := c.withSession(uid, passphrase, func(session, passphrase string) error {
+		req.Session, req.Passphrase = session, passphrase
+		var err error
+		res, err = c.client.SignEOTS(context.Background(), req)
+		return err
+	})
 	if err != nil {
+		if status.Code(err) == codes.AlreadyExists {
+			return nil, fmt.Errorf("failed to sign EOTS at height %d: %w", height, types.ErrDoubleSign)
+		}
 		return nil, err
 	}
 
@@ -118,9 +179,53 @@ func (c *EOTSManagerGRpcClient) SignEOTS(uid, chaiID, msg []byte, height uint64,
 	return &s, nil
 }
 
+func (c *EOTSManagerGRpcClient) SignEOTSBatch(uid, chainID []byte, reqs []*types.EOTSSignRequest, passphrase string) ([]*btcec.ModNScalar, error) {
+	req := &proto.SignEOTSBatchRequest{
+		Uid:     uid,
+		ChainId: chainID,
+		Items:   make([]*proto.SignEOTSBatchItem, 0, len(reqs)),
+	}
+	for _, r := range reqs {
+		req.Items = append(req.Items, &proto.SignEOTSBatchItem{Height: r.Height, Msg: r.Msg})
+	}
+
+	var res *proto.SignEOTSBatchResponse
+	err := c.withSession(uid, passphrase, func(session, passphrase string) error {
+		req.Session, req.Passphrase = session, passphrase
+		var err error
+		res, err = c.client.SignEOTSBatch(context.Background(), req)
+		return err
+	})
+	if err != nil {
+		if status.Code(err) == codes.AlreadyExists {
+			return nil, fmt.Errorf("failed to sign EOTS batch: %w", types.ErrDoubleSign)
+		}
+		return nil, err
+	}
+
+	if len(res.Sigs) != len(reqs) {
+		return nil, fmt.Errorf("expected %d signatures, got %d", len(reqs), len(res.Sigs))
+	}
+
+	sigs := make([]*btcec.ModNScalar, 0, len(res.Sigs))
+	for _, sigBytes := range res.Sigs {
+		var s btcec.ModNScalar
+		s.SetByteSlice(sigBytes)
+		sigs = append(sigs, &s)
+	}
+
+	return sigs, nil
+}
+
 func (c *EOTSManagerGRpcClient) SignSchnorrSig(uid, msg []byte, passphrase string) (*schnorr.Signature, error) {
-	req := &proto.SignSchnorrSigRequest{Uid: uid, Msg: msg, Passphrase: passphrase}
-	res, err := c.client.SignSchnorrSig(context.Background(), req)
+	req := &proto.SignSchnorrSigRequest{Uid: uid, Msg: msg}
+	var res *proto.SignSchnorrSigResponse
+	err := c.withSession(uid, passphrase, func(session, passphrase string) error {
+		req.Session, req.Passphrase = session, passphrase
+		var err error
+		res, err = c.client.SignSchnorrSig(context.Background(), req)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -133,6 +238,40 @@ func (c *EOTSManagerGRpcClient) SignSchnorrSig(uid, msg []byte, passphrase strin
 	return sig, nil
 }
 
+func (c *EOTSManagerGRpcClient) ListKeys() ([]*types.KeyInfo, error) {
+	res, err := c.client.ListKeys(context.Background(), &proto.ListKeysRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return eotsmanager.KeyInfoListFromProto(res.Keys), nil
+}
+
+// ListAuditRecords returns the records of the signing audit log of the
+// EOTS manager matching the given filters
+func (c *EOTSManagerGRpcClient) ListAuditRecords(req *proto.ListAuditRecordsRequest) ([]*proto.AuditRecord, error) {
+	res, err := c.client.ListAuditRecords(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Records, nil
+}
+
+// BackupDB asks the EOTS manager to write a snapshot of its database to the
+// backup directory and returns the path of the snapshot
+func (c *EOTSManagerGRpcClient) BackupDB() (string, error) {
+	res, err := c.client.BackupDB(context.Background(), &proto.BackupDBRequest{})
+	if err != nil {
+		return "", err
+	}
+
+	return res.Path, nil
+}
+
+// Close locks the keys unlocked by the client and closes the connection
 func (c *EOTSManagerGRpcClient) Close() error {
+	c.lockAllSessions()
+
 	return c.conn.Close()
 }
@@ -0,0 +1,48 @@
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/proto"
+	"github.com/babylonchain/finality-provider/util"
+)
+
+// TestUnixSocket tests that the client connects to the EOTS manager over a
+// Unix domain socket only accessible by its owner, and that an auth token is
+// sent over the socket but never over plaintext TCP
+func TestUnixSocket(t *testing.T) {
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	s := &sessionServer{privKey: privKey, sessions: make(map[string]bool)}
+
+	socketPath := filepath.Join(t.TempDir(), "eotsd.sock")
+	addr := util.UnixSocketScheme + socketPath
+	l, err := util.ListenRPC(addr)
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	proto.RegisterEOTSManagerServer(grpcServer, s)
+	go func() {
+		_ = grpcServer.Serve(l)
+	}()
+	defer grpcServer.Stop()
+
+	c, err := NewEOTSManagerGRpcClient(addr, nil, "id.secret")
+	require.NoError(t, err)
+	_, err = c.SignSchnorrSig(schnorr.SerializePubKey(privKey.PubKey()), make([]byte, 32), "pass")
+	require.NoError(t, err)
+	require.NoError(t, c.Close())
+
+	require.NoError(t, os.Chmod(socketPath, 0666))
+	_, err = NewEOTSManagerGRpcClient(addr, nil, "")
+	require.ErrorContains(t, err, "other users")
+
+	_, err = NewEOTSManagerGRpcClient("127.0.0.1:12582", nil, "id.secret")
+	require.ErrorContains(t, err, "plaintext TCP")
+}
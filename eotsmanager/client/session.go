@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/proto"
+)
+
+// sessionCache holds the unlock sessions of the keys used by the client, so
+// that the passphrase is only sent to unlock each key
+type sessionCache struct {
+	mu  sync.Mutex
+	ttl time.Duration
+	// sessions are the handles of the sessions by the hex of the key
+	sessions map[string]string
+}
+
+// EnableSessions makes the client unlock each key on its first use for the
+// given time and pass the handle of the session instead of the passphrase.
+// Sessions are disabled again if the EOTS manager does not support them
+func (c *EOTSManagerGRpcClient) EnableSessions(ttl time.Duration) {
+	c.sessions.mu.Lock()
+	defer c.sessions.mu.Unlock()
+
+	c.sessions.ttl = ttl
+	if c.sessions.sessions == nil {
+		c.sessions.sessions = make(map[string]string)
+	}
+}
+
+// UnlockKey unlocks the key for the given time, where zero means the
+// maximum allowed by the EOTS manager, and returns the handle of the session
+// and its expiry
+func (c *EOTSManagerGRpcClient) UnlockKey(uid []byte, passphrase string, ttl time.Duration) (string, time.Time, error) {
+	req := &proto.UnlockKeyRequest{Uid: uid, Passphrase: passphrase, TtlSeconds: uint64(ttl.Seconds())}
+	res, err := c.client.UnlockKey(context.Background(), req)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return res.Session, time.Unix(res.ExpiresAt, 0), nil
+}
+
+// LockKey wipes the key of the session in the EOTS manager
+func (c *EOTSManagerGRpcClient) LockKey(session string) error {
+	_, err := c.client.LockKey(context.Background(), &proto.LockKeyRequest{Session: session})
+
+	return err
+}
+
+// withSession calls call with the session of the key, unlocking the key
+// first if it has no session, or with the passphrase if sessions are
+// disabled. The key is unlocked again once if its session has expired
+func (c *EOTSManagerGRpcClient) withSession(uid []byte, passphrase string, call func(session, passphrase string) error) error {
+	session, err := c.session(uid, passphrase)
+	if err != nil {
+		return err
+	}
+	if session == "" {
+		return call("", passphrase)
+	}
+
+	err = call(session, "")
+	if status.Code(err) != codes.NotFound {
+		return err
+	}
+
+	c.dropSession(uid, session)
+	if session, err = c.session(uid, passphrase); err != nil {
+		return err
+	}
+	if session == "" {
+		return call("", passphrase)
+	}
+
+	return call(session, "")
+}
+
+// session returns the session of the key, unlocking the key if it has none,
+// or an empty handle if sessions are disabled or the passphrase is unknown,
+// in which case the EOTS manager signs with the passphrase it holds, if any
+func (c *EOTSManagerGRpcClient) session(uid []byte, passphrase string) (string, error) {
+	c.sessions.mu.Lock()
+	defer c.sessions.mu.Unlock()
+
+	if c.sessions.ttl == 0 || passphrase == "" {
+		return "", nil
+	}
+	key := hex.EncodeToString(uid)
+	if session, ok := c.sessions.sessions[key]; ok {
+		return session, nil
+	}
+
+	session, _, err := c.UnlockKey(uid, passphrase, c.sessions.ttl)
+	switch status.Code(err) {
+	case codes.OK:
+		c.sessions.sessions[key] = session
+		return session, nil
+	case codes.Unimplemented, codes.FailedPrecondition:
+		// the EOTS manager predates the sessions, has them disabled,
+		// or holds the keys in an external signer
+		c.sessions.ttl = 0
+		return "", nil
+	default:
+		return "", err
+	}
+}
+
+func (c *EOTSManagerGRpcClient) dropSession(uid []byte, session string) {
+	c.sessions.mu.Lock()
+	defer c.sessions.mu.Unlock()
+
+	key := hex.EncodeToString(uid)
+	if c.sessions.sessions[key] == session {
+		delete(c.sessions.sessions, key)
+	}
+}
+
+// lockAllSessions wipes the keys of all the sessions of the client
+func (c *EOTSManagerGRpcClient) lockAllSessions() {
+	c.sessions.mu.Lock()
+	defer c.sessions.mu.Unlock()
+
+	for key, session := range c.sessions.sessions {
+		_ = c.LockKey(session)
+		delete(c.sessions.sessions, key)
+	}
+}
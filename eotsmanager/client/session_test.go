@@ -0,0 +1,131 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/proto"
+)
+
+// sessionServer is an EOTS manager which only signs with unlock sessions
+type sessionServer struct {
+	proto.UnimplementedEOTSManagerServer
+
+	privKey *btcec.PrivateKey
+
+	mu         sync.Mutex
+	disabled   bool
+	unlocks    int
+	sessions   map[string]bool
+	passphrase []string
+}
+
+func (s *sessionServer) Ping(context.Context, *proto.PingRequest) (*proto.PingResponse, error) {
+	return &proto.PingResponse{}, nil
+}
+
+func (s *sessionServer) UnlockKey(context.Context, *proto.UnlockKeyRequest) (*proto.UnlockKeyResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.disabled {
+		return nil, status.Error(codes.FailedPrecondition, "unlocking keys is disabled")
+	}
+	s.unlocks++
+	session := fmt.Sprintf("session-%d", s.unlocks)
+	s.sessions[session] = true
+
+	return &proto.UnlockKeyResponse{Session: session, ExpiresAt: time.Now().Add(time.Hour).Unix()}, nil
+}
+
+func (s *sessionServer) LockKey(_ context.Context, req *proto.LockKeyRequest) (*proto.LockKeyResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.sessions, req.Session)
+
+	return &proto.LockKeyResponse{}, nil
+}
+
+func (s *sessionServer) SignSchnorrSig(_ context.Context, req *proto.SignSchnorrSigRequest) (*proto.SignSchnorrSigResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.passphrase = append(s.passphrase, req.Passphrase)
+	if req.Session != "" && !s.sessions[req.Session] {
+		return nil, status.Error(codes.NotFound, "the unlock session does not exist or has expired")
+	}
+
+	sig, err := schnorr.Sign(s.privKey, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.SignSchnorrSigResponse{Sig: sig.Serialize()}, nil
+}
+
+// TestSessions tests that the client unlocks a key on its first use, unlocks
+// it again once its session expires, and falls back to the passphrase if the
+// EOTS manager does not allow unlocking keys
+func TestSessions(t *testing.T) {
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	s := &sessionServer{privKey: privKey, sessions: make(map[string]bool)}
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	proto.RegisterEOTSManagerServer(grpcServer, s)
+	go func() {
+		_ = grpcServer.Serve(l)
+	}()
+	defer grpcServer.Stop()
+
+	c, err := NewEOTSManagerGRpcClient(l.Addr().String(), nil, "")
+	require.NoError(t, err)
+	c.EnableSessions(time.Hour)
+
+	uid := schnorr.SerializePubKey(privKey.PubKey())
+	msg := make([]byte, 32)
+	for i := 0; i < 3; i++ {
+		_, err = c.SignSchnorrSig(uid, msg, "pass")
+		require.NoError(t, err)
+	}
+	require.Equal(t, 1, s.unlocks)
+	require.Equal(t, []string{"", "", ""}, s.passphrase)
+
+	// the expired session is replaced transparently
+	s.mu.Lock()
+	s.sessions = make(map[string]bool)
+	s.mu.Unlock()
+	_, err = c.SignSchnorrSig(uid, msg, "pass")
+	require.NoError(t, err)
+	require.Equal(t, 2, s.unlocks)
+
+	// the sessions are locked on close
+	require.NoError(t, c.Close())
+	require.Empty(t, s.sessions)
+
+	c, err = NewEOTSManagerGRpcClient(l.Addr().String(), nil, "")
+	require.NoError(t, err)
+	defer c.Close()
+	c.EnableSessions(time.Hour)
+	s.mu.Lock()
+	s.disabled = true
+	s.passphrase = nil
+	s.mu.Unlock()
+	_, err = c.SignSchnorrSig(uid, msg, "pass")
+	require.NoError(t, err)
+	require.Equal(t, []string{"pass"}, s.passphrase)
+}
@@ -0,0 +1,237 @@
+package daemon
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	bbntypes "github.com/babylonchain/babylon/types"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/urfave/cli"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/config"
+	"github.com/babylonchain/finality-provider/eotsmanager/proto"
+	"github.com/babylonchain/finality-provider/eotsmanager/store"
+)
+
+type AuditRecordOutput struct {
+	Seq       uint64 `json:"seq"`
+	Time      string `json:"time"`
+	Caller    string `json:"caller"`
+	Method    string `json:"method"`
+	PubKeyHex string `json:"pub_key_hex,omitempty"`
+	ChainID   string `json:"chain_id,omitempty"`
+	Height    uint64 `json:"height,omitempty"`
+	Num       uint32 `json:"num,omitempty"`
+	MsgHash   string `json:"msg_hash,omitempty"`
+	Result    string `json:"result"`
+	Hash      string `json:"hash"`
+}
+
+type AuditVerifyOutput struct {
+	NumRecords      uint64 `json:"num_records"`
+	LastHash        string `json:"last_hash"`
+	AnchoredRecords uint64 `json:"anchored_records"`
+}
+
+var AuditCommands = []cli.Command{
+	{
+		Name:     "audit",
+		Usage:    "Command sets of inspecting the audit log of the signing calls.",
+		Category: "Audit",
+		Subcommands: []cli.Command{
+			ListAuditRecordsCmd, VerifyAuditLogCmd,
+		},
+	},
+}
+
+var ListAuditRecordsCmd = cli.Command{
+	Name:  "list",
+	Usage: "List the records of the audit log matching the given filters.",
+	Description: `Times are given in RFC3339 format, e.g., 2024-01-02T15:04:05Z.
+	The daemon should be stopped as the command accesses its database.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "The path to the eotsd home directory",
+			Value: config.DefaultEOTSDir,
+		},
+		cli.StringFlag{
+			Name:  fpPkFlag,
+			Usage: "Only list the records of the EOTS key with the given hex public key",
+		},
+		cli.Uint64Flag{
+			Name:  startHeightFlag,
+			Usage: "Only list the records at or above the given height",
+		},
+		cli.Uint64Flag{
+			Name:  endHeightFlag,
+			Usage: "Only list the records at or below the given height",
+		},
+		cli.StringFlag{
+			Name:  startTimeFlag,
+			Usage: "Only list the records made at or after the given time",
+		},
+		cli.StringFlag{
+			Name:  endTimeFlag,
+			Usage: "Only list the records made at or before the given time",
+		},
+		cli.UintFlag{
+			Name:  limitFlag,
+			Usage: "The maximum number of records to list, or 0 for no limit",
+		},
+	},
+	Action: listAuditRecords,
+}
+
+var VerifyAuditLogCmd = cli.Command{
+	Name:  "verify",
+	Usage: "Verify that the audit log has neither been tampered with nor has gaps.",
+	Description: `The log is also checked against its anchor, i.e., the number of records
+	and the hash of the last one which the daemon writes to the AuditAnchor file, so that
+	removing the latest records is detected as well. The number of records and the hash
+	of the last one are printed on success. The daemon should be stopped as the command
+	accesses its database.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "The path to the eotsd home directory",
+			Value: config.DefaultEOTSDir,
+		},
+	},
+	Action: verifyAuditLog,
+}
+
+func listAuditRecords(ctx *cli.Context) error {
+	filter := &store.AuditFilter{
+		StartHeight: ctx.Uint64(startHeightFlag),
+		EndHeight:   ctx.Uint64(endHeightFlag),
+		Limit:       uint32(ctx.Uint(limitFlag)),
+	}
+	if pkHex := ctx.String(fpPkFlag); pkHex != "" {
+		pk, err := bbntypes.NewBIP340PubKeyFromHex(pkHex)
+		if err != nil {
+			return fmt.Errorf("invalid EOTS public key %s: %w", pkHex, err)
+		}
+		filter.Pk = pk.MustMarshal()
+	}
+	var err error
+	if filter.StartTime, err = parseTimeFlag(ctx, startTimeFlag); err != nil {
+		return err
+	}
+	if filter.EndTime, err = parseTimeFlag(ctx, endTimeFlag); err != nil {
+		return err
+	}
+
+	auditStore, db, err := loadAuditStore(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	records, err := auditStore.ListAuditRecords(filter)
+	if err != nil {
+		return fmt.Errorf("failed to list audit records: %w", err)
+	}
+
+	outs := make([]AuditRecordOutput, 0, len(records))
+	for _, r := range records {
+		outs = append(outs, auditRecordToOutput(r))
+	}
+	printRespJSON(outs)
+
+	return nil
+}
+
+func verifyAuditLog(ctx *cli.Context) error {
+	auditStore, db, err := loadAuditStore(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	num, lastHash, err := auditStore.VerifyAuditLog()
+	if err != nil {
+		return err
+	}
+
+	anchor, err := auditStore.CheckAuditAnchor()
+	if errors.Is(err, store.ErrAuditAnchorNotFound) && num > 0 {
+		return fmt.Errorf("the audit log is not anchored, its latest records could have been removed")
+	}
+	if err != nil && !errors.Is(err, store.ErrAuditAnchorNotFound) {
+		return err
+	}
+
+	out := AuditVerifyOutput{
+		NumRecords: num,
+		LastHash:   hex.EncodeToString(lastHash),
+	}
+	if anchor != nil {
+		out.AnchoredRecords = anchor.NumRecords
+	}
+	printRespJSON(out)
+
+	return nil
+}
+
+func loadAuditStore(ctx *cli.Context) (*store.AuditStore, kvdb.Backend, error) {
+	homePath, err := getHomeFlag(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load home flag: %w", err)
+	}
+
+	cfg, err := config.LoadConfig(homePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load config at %s: %w", homePath, err)
+	}
+
+	dbBackend, err := cfg.DatabaseConfig.GetDbBackend()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create db backend: %w", err)
+	}
+
+	auditStore, err := store.NewAuditStore(dbBackend, cfg.AuditAnchor)
+	if err != nil {
+		dbBackend.Close()
+		return nil, nil, fmt.Errorf("failed to initiate audit store: %w", err)
+	}
+
+	return auditStore, dbBackend, nil
+}
+
+func parseTimeFlag(ctx *cli.Context, flag string) (time.Time, error) {
+	value := ctx.String(flag)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s %s: %w", flag, value, err)
+	}
+
+	return t, nil
+}
+
+func auditRecordToOutput(r *proto.AuditRecord) AuditRecordOutput {
+	result := r.Result
+	if result == "" {
+		result = "success"
+	}
+
+	return AuditRecordOutput{
+		Seq:       r.Seq,
+		Time:      time.Unix(0, r.Timestamp).UTC().Format(time.RFC3339Nano),
+		Caller:    r.Caller,
+		Method:    r.Method,
+		PubKeyHex: hex.EncodeToString(r.Pk),
+		ChainID:   string(r.ChainId),
+		Height:    r.Height,
+		Num:       r.Num,
+		MsgHash:   hex.EncodeToString(r.MsgHash),
+		Result:    result,
+		Hash:      hex.EncodeToString(r.Hash),
+	}
+}
@@ -0,0 +1,73 @@
+package daemon_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dcli "github.com/babylonchain/finality-provider/eotsmanager/cmd/eotsd/daemon"
+	"github.com/babylonchain/finality-provider/testutil"
+)
+
+// FuzzAuditListVerify tests that signatures made by the CLI are recorded in
+// the audit log, which can be listed and verified
+func FuzzAuditListVerify(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 5)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		tempDir := t.TempDir()
+		homeDir := filepath.Join(tempDir, "eots-home")
+		app := testApp()
+		hFlag := fmt.Sprintf("--home=%s", homeDir)
+		err := app.Run([]string{"eotsd", "init", hFlag})
+		require.NoError(t, err)
+
+		keyOutput := appRunWithOutput(r, t, app, []string{"eotsd", "keys", "add", hFlag, "--key-name=fp"})
+		var keyOut dcli.KeyOutput
+		err = json.Unmarshal([]byte(searchInTxt(keyOutput, "for recovery):")), &keyOut)
+		require.NoError(t, err)
+
+		fpInfoPath := filepath.Join(tempDir, "fpInfo.json")
+		writeFpInfoToFile(r, t, fpInfoPath, keyOut.PubKeyHex)
+		numSigs := r.Intn(3) + 1
+		for i := 0; i < numSigs; i++ {
+			appRunSignSchnorr(r, t, app, []string{fpInfoPath, hFlag, "--key-name=fp"})
+		}
+
+		listOutput := appRunWithOutput(r, t, app, []string{"eotsd", "audit", "list", hFlag, "--btc-pk=" + keyOut.PubKeyHex})
+		var records []dcli.AuditRecordOutput
+		err = json.Unmarshal([]byte(searchInTxt(listOutput, "")), &records)
+		require.NoError(t, err)
+		require.Len(t, records, numSigs)
+		for i, record := range records {
+			require.Equal(t, uint64(i+1), record.Seq)
+			require.Equal(t, "SignSchnorrSig", record.Method)
+			require.Equal(t, keyOut.PubKeyHex, record.PubKeyHex)
+			require.Equal(t, "success", record.Result)
+		}
+
+		limitOutput := appRunWithOutput(r, t, app, []string{"eotsd", "audit", "list", hFlag, "--limit=1"})
+		err = json.Unmarshal([]byte(searchInTxt(limitOutput, "")), &records)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+
+		verifyOutput := appRunWithOutput(r, t, app, []string{"eotsd", "audit", "verify", hFlag})
+		var verified dcli.AuditVerifyOutput
+		err = json.Unmarshal([]byte(searchInTxt(verifyOutput, "")), &verified)
+		require.NoError(t, err)
+		require.Equal(t, uint64(numSigs), verified.NumRecords)
+		// every signature of the CLI moves the anchor as well
+		require.Equal(t, uint64(numSigs), verified.AnchoredRecords)
+
+		// removing the anchor is detected
+		require.NoError(t, os.Remove(filepath.Join(homeDir, "audit.anchor")))
+		err = app.Run([]string{"eotsd", "audit", "verify", hFlag})
+		require.ErrorContains(t, err, "not anchored")
+	})
+}
@@ -0,0 +1,358 @@
+package daemon
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/urfave/cli"
+
+	"github.com/babylonchain/finality-provider/dbbackup"
+	"github.com/babylonchain/finality-provider/eotsmanager/client"
+	"github.com/babylonchain/finality-provider/eotsmanager/config"
+	"github.com/babylonchain/finality-provider/eotsmanager/store"
+	"github.com/babylonchain/finality-provider/sqlitedb"
+	"github.com/babylonchain/finality-provider/util"
+)
+
+type DBBackupOutput struct {
+	Path   string `json:"path"`
+	Online bool   `json:"online"`
+}
+
+type DBRestoreOutput struct {
+	Restored         string `json:"restored"`
+	Previous         string `json:"previous,omitempty"`
+	SignRecordsKept  int    `json:"sign_records_kept"`
+	TokensKept       int    `json:"tokens_kept"`
+	AuditRecordsKept int    `json:"audit_records_kept"`
+}
+
+type MigrationOutput struct {
+	Version     uint32 `json:"version"`
+	Description string `json:"description"`
+}
+
+type DBConvertOutput struct {
+	Source      string            `json:"source"`
+	Destination string            `json:"destination"`
+	Records     map[string]uint64 `json:"records"`
+}
+
+type DBMigrateOutput struct {
+	FromVersion uint32            `json:"from_version"`
+	ToVersion   uint32            `json:"to_version"`
+	Applied     []MigrationOutput `json:"applied"`
+	DryRun      bool              `json:"dry_run"`
+}
+
+var DBCommands = []cli.Command{
+	{
+		Name:     "db",
+		Usage:    "Command sets of backing up, restoring, migrating, and converting the database.",
+		Category: "Database",
+		Subcommands: []cli.Command{
+			BackupDBCmd, RestoreDBCmd, MigrateDBCmd, ConvertDBCmd,
+		},
+	},
+}
+
+var BackupDBCmd = cli.Command{
+	Name:  "backup",
+	Usage: "Write a snapshot of the database to the backup directory.",
+	Description: `The snapshot is taken by the daemon through its RPC server if it is
+	running, or directly from the database file otherwise. The oldest snapshots are
+	removed according to the configured number of snapshots to keep.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "The path to the eotsd home directory",
+			Value: config.DefaultEOTSDir,
+		},
+		cli.StringFlag{
+			Name:  authTokenFlag,
+			Usage: "The token to authenticate to the daemon with if auth is enabled",
+		},
+		cli.StringFlag{
+			Name:  clientCertFlag,
+			Usage: "The path to the client certificate if the daemon enforces mutual TLS",
+		},
+		cli.StringFlag{
+			Name:  clientKeyFlag,
+			Usage: "The path to the client key if the daemon enforces mutual TLS",
+		},
+	},
+	Action: backupDB,
+}
+
+var RestoreDBCmd = cli.Command{
+	Name:      "restore",
+	Usage:     "Replace the database with a snapshot once it is validated.",
+	ArgsUsage: "[snapshot-path]",
+	Description: `The daemon should be stopped. The replaced database is kept next to the
+	restored one with a .pre-restore suffix. The signing records of the replaced
+	database are copied into the restored one, so that the double-signing
+	protection does not roll back to the time of the snapshot. Its tokens and audit
+	log replace the ones of the snapshot as well, so that revoked tokens are not
+	brought back and the audit log is not truncated. The restore is refused if they
+	cannot be read, unless --force is given, which also anchors the audit log at the
+	last record of the snapshot.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "The path to the eotsd home directory",
+			Value: config.DefaultEOTSDir,
+		},
+		cli.BoolFlag{
+			Name:  forceFlag,
+			Usage: "Restore the snapshot without the signing records, tokens, and audit log of the replaced database, e.g., if it is corrupted",
+		},
+	},
+	Action: restoreDB,
+}
+
+var MigrateDBCmd = cli.Command{
+	Name:  "migrate",
+	Usage: "Upgrade the database to the schema of this version of eotsd.",
+	Description: `The daemon applies the pending migrations itself when it starts, so
+	this command is mostly useful with --dry-run to check them beforehand. The daemon
+	should be stopped.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "The path to the eotsd home directory",
+			Value: config.DefaultEOTSDir,
+		},
+		cli.BoolFlag{
+			Name:  dryRunFlag,
+			Usage: "Apply the migrations in a transaction which is rolled back",
+		},
+	},
+	Action: migrateDB,
+}
+
+var ConvertDBCmd = cli.Command{
+	Name:  "convert",
+	Usage: "Copy the bolt database into a new SQLite database.",
+	Description: `The records of each bucket are counted in both databases once copied,
+	and the conversion fails if they differ. The daemon should be stopped. Set backend
+	to sqlite in the [dbconfig] section of eotsd.conf afterwards to use the SQLite
+	database, which requires eotsd to be built with the kvdb_sqlite tag.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "The path to the eotsd home directory",
+			Value: config.DefaultEOTSDir,
+		},
+	},
+	Action: convertDB,
+}
+
+func backupDB(ctx *cli.Context) error {
+	cfg, err := loadConfigFromHome(ctx)
+	if err != nil {
+		return err
+	}
+	if cfg.DatabaseConfig.IsSqlite() {
+		return fmt.Errorf("the sqlite backend should be backed up with the SQLite tools, e.g., sqlite3 %s \".backup <path>\"",
+			filepath.Join(cfg.DatabaseConfig.DBPath, cfg.DatabaseConfig.SqliteFileName))
+	}
+
+	db, err := dbbackup.OpenExisting(cfg.DatabaseConfig.DBPath, cfg.DatabaseConfig.DBFileName)
+	if errors.Is(err, dbbackup.ErrDBInUse) {
+		path, err := backupDBOnline(ctx, cfg)
+		if err != nil {
+			return err
+		}
+		printRespJSON(DBBackupOutput{Path: path, Online: true})
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open the database: %w", err)
+	}
+	defer db.Close()
+
+	path, err := dbbackup.Snapshot(db, cfg.Backup.Dir, config.DBBackupPrefix)
+	if err != nil {
+		return err
+	}
+	if err := dbbackup.Prune(cfg.Backup.Dir, config.DBBackupPrefix, cfg.Backup.Keep); err != nil {
+		return err
+	}
+
+	printRespJSON(DBBackupOutput{Path: path})
+
+	return nil
+}
+
+// backupDBOnline asks the running daemon to take the snapshot, as the
+// database file is locked while it runs
+func backupDBOnline(ctx *cli.Context, cfg *config.Config) (string, error) {
+	var tlsCfg *tls.Config
+	if !cfg.TLS.Disable {
+		var err error
+		tlsCfg, err = util.NewPinnedClientTLSConfig(cfg.TLS.CertPath, ctx.String(clientCertFlag), ctx.String(clientKeyFlag))
+		if err != nil {
+			return "", fmt.Errorf("failed to load the TLS config: %w", err)
+		}
+	}
+
+	em, err := client.NewEOTSManagerGRpcClient(cfg.RpcListener, tlsCfg, ctx.String(authTokenFlag))
+	if err != nil {
+		return "", fmt.Errorf("failed to connect to the running daemon: %w", err)
+	}
+	defer em.Close()
+
+	return em.BackupDB()
+}
+
+func restoreDB(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return fmt.Errorf("expected the path to the snapshot as the only argument")
+	}
+	snapshotPath := ctx.Args().First()
+
+	cfg, err := loadConfigFromHome(ctx)
+	if err != nil {
+		return err
+	}
+	if cfg.DatabaseConfig.IsSqlite() {
+		return fmt.Errorf("the sqlite backend should be restored with the SQLite tools while the daemon is stopped")
+	}
+
+	var (
+		output = DBRestoreOutput{Restored: snapshotPath}
+		merge  dbbackup.Merger
+	)
+	if !ctx.Bool(forceFlag) {
+		merge = func(live, restored kvdb.Backend) error {
+			var err error
+			if output.SignRecordsKept, err = store.CopySignRecords(live, restored); err != nil {
+				return fmt.Errorf("%w (use --%s to restore the snapshot without the signing records)", err, forceFlag)
+			}
+			if output.TokensKept, err = store.CopyAuthTokens(live, restored); err != nil {
+				return fmt.Errorf("%w (use --%s to restore the tokens of the snapshot)", err, forceFlag)
+			}
+			if output.AuditRecordsKept, err = store.CopyAuditLog(live, restored); err != nil {
+				return fmt.Errorf("%w (use --%s to restore the audit log of the snapshot)", err, forceFlag)
+			}
+			return nil
+		}
+	}
+
+	previous, err := dbbackup.Restore(snapshotPath, cfg.DatabaseConfig.DBPath, cfg.DatabaseConfig.DBFileName, store.ValidateDB, merge)
+	if err != nil {
+		return err
+	}
+
+	// the audit log of the snapshot is older than the anchor, which is
+	// moved to it as acknowledged by --force
+	if ctx.Bool(forceFlag) {
+		if err := reanchorAuditLog(cfg); err != nil {
+			return err
+		}
+	}
+
+	output.Previous = previous
+	printRespJSON(output)
+
+	return nil
+}
+
+func reanchorAuditLog(cfg *config.Config) error {
+	db, err := cfg.DatabaseConfig.GetDbBackend()
+	if err != nil {
+		return fmt.Errorf("failed to open the restored database: %w", err)
+	}
+	defer db.Close()
+
+	auditStore, err := store.NewAuditStore(db, cfg.AuditAnchor)
+	if err != nil {
+		return fmt.Errorf("failed to initiate audit store: %w", err)
+	}
+
+	return auditStore.UpdateAuditAnchor()
+}
+
+func migrateDB(ctx *cli.Context) error {
+	cfg, err := loadConfigFromHome(ctx)
+	if err != nil {
+		return err
+	}
+
+	db, err := openExistingDB(cfg.DatabaseConfig)
+	if err != nil {
+		return fmt.Errorf("failed to open the database: %w", err)
+	}
+	defer db.Close()
+
+	res, err := store.MigrateDB(db, ctx.Bool(dryRunFlag))
+	if err != nil {
+		return err
+	}
+
+	out := DBMigrateOutput{
+		FromVersion: res.FromVersion,
+		ToVersion:   res.ToVersion,
+		Applied:     make([]MigrationOutput, 0, len(res.Applied)),
+		DryRun:      res.DryRun,
+	}
+	for _, m := range res.Applied {
+		out.Applied = append(out.Applied, MigrationOutput{Version: m.Version, Description: m.Description})
+	}
+	printRespJSON(out)
+
+	return nil
+}
+
+func convertDB(ctx *cli.Context) error {
+	cfg, err := loadConfigFromHome(ctx)
+	if err != nil {
+		return err
+	}
+
+	dbCfg := cfg.DatabaseConfig
+	records, err := sqlitedb.ConvertBolt(dbCfg.DBPath, dbCfg.DBFileName, dbCfg.DBConfigToSqliteConfig())
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(DBConvertOutput{
+		Source:      filepath.Join(dbCfg.DBPath, dbCfg.DBFileName),
+		Destination: filepath.Join(dbCfg.DBPath, dbCfg.SqliteFileName),
+		Records:     records,
+	})
+
+	return nil
+}
+
+// openExistingDB opens the existing database of the configured backend,
+// failing fast if a bolt database is in use by the daemon
+func openExistingDB(dbCfg *config.DBConfig) (kvdb.Backend, error) {
+	if !dbCfg.IsSqlite() {
+		return dbbackup.OpenExisting(dbCfg.DBPath, dbCfg.DBFileName)
+	}
+
+	if _, err := os.Stat(filepath.Join(dbCfg.DBPath, dbCfg.SqliteFileName)); err != nil {
+		return nil, err
+	}
+
+	return dbCfg.GetDbBackend()
+}
+
+func loadConfigFromHome(ctx *cli.Context) (*config.Config, error) {
+	homePath, err := getHomeFlag(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load home flag: %w", err)
+	}
+
+	cfg, err := config.LoadConfig(homePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config at %s: %w", homePath, err)
+	}
+
+	return cfg, nil
+}
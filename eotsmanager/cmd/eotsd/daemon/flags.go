@@ -6,15 +6,51 @@ const (
 	homeFlag        = "home"
 	forceFlag       = "force"
 	rpcListenerFlag = "rpc-listener"
+	secretsFileFlag = "secrets-file"
 	fpPkFlag        = "btc-pk"
 	signatureFlag   = "signature"
 
 	// flags for keys
-	keyNameFlag        = "key-name"
-	passphraseFlag     = "passphrase"
-	hdPathFlag         = "hd-path"
-	keyringBackendFlag = "keyring-backend"
-	recoverFlag        = "recover"
+	keyNameFlag          = "key-name"
+	passphraseFlag       = "passphrase"
+	hdPathFlag           = "hd-path"
+	keyringBackendFlag   = "keyring-backend"
+	recoverFlag          = "recover"
+	backupPassphraseFlag = "backup-passphrase"
+	passphraseFileFlag   = "passphrase-file"
+	passphrasePromptFlag = "passphrase-prompt"
+	thresholdFlag        = "threshold"
+	sharesFlag           = "shares"
+	withoutHistoryFlag   = "without-history"
+
+	// flags for tokens
+	tokenNameFlag = "name"
+	methodFlag    = "method"
+	adminFlag     = "admin"
+
+	// flags for audit
+	startHeightFlag = "start-height"
+	endHeightFlag   = "end-height"
+	startTimeFlag   = "start-time"
+	endTimeFlag     = "end-time"
+	limitFlag       = "limit"
+
+	// flags for forensics
+	pubRandFlag = "pub-rand"
+	msg1Flag    = "msg1"
+	sig1Flag    = "sig1"
+	msg2Flag    = "msg2"
+	sig2Flag    = "sig2"
+	heightFlag  = "height"
+
+	// flags for db
+	authTokenFlag  = "auth-token"
+	clientCertFlag = "client-cert"
+	clientKeyFlag  = "client-key"
+	dryRunFlag     = "dry-run"
+
+	// flags for tls
+	hostFlag = "host"
 
 	defaultKeyringBackend = keyring.BackendTest
 	defaultHdPath         = ""
@@ -0,0 +1,284 @@
+package daemon
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	bbntypes "github.com/babylonchain/babylon/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/urfave/cli"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/config"
+	"github.com/babylonchain/finality-provider/eotsmanager/forensics"
+	"github.com/babylonchain/finality-provider/log"
+)
+
+// ForensicsReport records the evidence of an equivocation and the secret key
+// extracted from it
+type ForensicsReport struct {
+	PubKeyHex      string `json:"pub_key_hex"`
+	PubRandHex     string `json:"pub_rand_hex"`
+	Height         uint64 `json:"height,omitempty"`
+	Msg1Hex        string `json:"msg1_hex"`
+	Sig1Hex        string `json:"sig1_hex"`
+	Msg2Hex        string `json:"msg2_hex"`
+	Sig2Hex        string `json:"sig2_hex"`
+	ExtractedSkHex string `json:"extracted_sk_hex"`
+	ExtractedAt    string `json:"extracted_at"`
+}
+
+// SignedForensicsReport is the report along with the Schnorr signature of an
+// EOTS key over the sha256 hash of its compact JSON encoding
+type SignedForensicsReport struct {
+	Report              ForensicsReport `json:"report"`
+	ReportHashHex       string          `json:"report_hash_hex"`
+	SignerPubKeyHex     string          `json:"signer_pub_key_hex,omitempty"`
+	SchnorrSignatureHex string          `json:"schnorr_signature_hex,omitempty"`
+}
+
+var ForensicsCommands = []cli.Command{
+	{
+		Name:     "forensics",
+		Usage:    "Command sets of investigating the equivocations of finality providers.",
+		Category: "Forensics",
+		Subcommands: []cli.Command{
+			ExtractSKCmd,
+		},
+	},
+}
+
+var ExtractSKCmd = cli.Command{
+	Name:  "extract",
+	Usage: "Extract the secret key of an EOTS public key from two conflicting signatures.",
+	Description: `Both signatures are verified against the public key and the public
+	randomness before the key is extracted. Messages, signatures, and public randomness
+	are given in hex. If --height is set, the messages are the hashes of the two
+	conflicting blocks at that height, as found in the slashing evidence of Babylon,
+	and the finality votes over them are verified. The report is signed with the key
+	given by --key-name if set, and the signature is recorded in the audit log.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:     fpPkFlag,
+			Usage:    "The hex EOTS public key of the finality provider which equivocated",
+			Required: true,
+		},
+		cli.StringFlag{
+			Name:     pubRandFlag,
+			Usage:    "The hex public randomness used by both signatures",
+			Required: true,
+		},
+		cli.StringFlag{
+			Name:     msg1Flag,
+			Usage:    "The first hex message, or block hash if --height is set",
+			Required: true,
+		},
+		cli.StringFlag{
+			Name:     sig1Flag,
+			Usage:    "The hex EOTS signature over the first message",
+			Required: true,
+		},
+		cli.StringFlag{
+			Name:     msg2Flag,
+			Usage:    "The second hex message, or block hash if --height is set",
+			Required: true,
+		},
+		cli.StringFlag{
+			Name:     sig2Flag,
+			Usage:    "The hex EOTS signature over the second message",
+			Required: true,
+		},
+		cli.Uint64Flag{
+			Name:  heightFlag,
+			Usage: "The height of the two conflicting blocks whose hashes are given as messages",
+		},
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "The path to the eotsd home directory of the key signing the report",
+			Value: config.DefaultEOTSDir,
+		},
+		cli.StringFlag{
+			Name:  keyNameFlag,
+			Usage: "The name of the key signing the report, which is left unsigned if not set",
+		},
+		cli.StringFlag{
+			Name:  passphraseFlag,
+			Usage: "The passphrase used to decrypt the keyring",
+			Value: defaultPassphrase,
+		},
+		passphraseFileCliFlag,
+		passphrasePromptCliFlag,
+		cli.StringFlag{
+			Name:  keyringBackendFlag,
+			Usage: "The backend of the keyring",
+			Value: defaultKeyringBackend,
+		},
+	},
+	Action: extractSK,
+}
+
+func extractSK(ctx *cli.Context) error {
+	evidence, err := evidenceFromFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	sk, err := evidence.ExtractSK()
+	if err != nil {
+		return err
+	}
+
+	report := ForensicsReport{
+		PubKeyHex:      bbntypes.NewBIP340PubKeyFromBTCPK(evidence.PubKey).MarshalHex(),
+		PubRandHex:     ctx.String(pubRandFlag),
+		Height:         ctx.Uint64(heightFlag),
+		Msg1Hex:        hex.EncodeToString(evidence.Msg1),
+		Sig1Hex:        ctx.String(sig1Flag),
+		Msg2Hex:        hex.EncodeToString(evidence.Msg2),
+		Sig2Hex:        ctx.String(sig2Flag),
+		ExtractedSkHex: hex.EncodeToString(sk.Serialize()),
+		ExtractedAt:    time.Now().UTC().Format(time.RFC3339),
+	}
+	reportBytes, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+	reportHash := sha256.Sum256(reportBytes)
+
+	signed := SignedForensicsReport{
+		Report:        report,
+		ReportHashHex: hex.EncodeToString(reportHash[:]),
+	}
+	if keyName := ctx.String(keyNameFlag); keyName != "" {
+		signature, signerPk, err := signReport(ctx, keyName, reportHash[:])
+		if err != nil {
+			return err
+		}
+		signed.SignerPubKeyHex = signerPk.MarshalHex()
+		signed.SchnorrSignatureHex = hex.EncodeToString(signature.Serialize())
+	}
+
+	printRespJSON(signed)
+
+	return nil
+}
+
+// evidenceFromFlags parses the evidence, building the finality votes from
+// the block hashes if the height is set
+func evidenceFromFlags(ctx *cli.Context) (*forensics.Evidence, error) {
+	fpPk, err := bbntypes.NewBIP340PubKeyFromHex(ctx.String(fpPkFlag))
+	if err != nil {
+		return nil, fmt.Errorf("invalid EOTS public key: %w", err)
+	}
+	pk, err := fpPk.ToBTCPK()
+	if err != nil {
+		return nil, fmt.Errorf("invalid EOTS public key: %w", err)
+	}
+
+	pubRandBytes, err := decodeHex32(ctx.String(pubRandFlag))
+	if err != nil {
+		return nil, fmt.Errorf("invalid public randomness: %w", err)
+	}
+	var pubRand btcec.FieldVal
+	if overflow := pubRand.SetByteSlice(pubRandBytes); overflow {
+		return nil, fmt.Errorf("invalid public randomness: overflow")
+	}
+
+	evidence := &forensics.Evidence{
+		PubKey:  pk,
+		PubRand: &pubRand,
+	}
+	if evidence.Msg1, err = hex.DecodeString(ctx.String(msg1Flag)); err != nil {
+		return nil, fmt.Errorf("invalid first message: %w", err)
+	}
+	if evidence.Msg2, err = hex.DecodeString(ctx.String(msg2Flag)); err != nil {
+		return nil, fmt.Errorf("invalid second message: %w", err)
+	}
+	if ctx.IsSet(heightFlag) {
+		evidence.Msg1 = forensics.FinalityVoteMsg(ctx.Uint64(heightFlag), evidence.Msg1)
+		evidence.Msg2 = forensics.FinalityVoteMsg(ctx.Uint64(heightFlag), evidence.Msg2)
+	}
+	if evidence.Sig1, err = parseEOTSSig(ctx.String(sig1Flag)); err != nil {
+		return nil, fmt.Errorf("invalid first signature: %w", err)
+	}
+	if evidence.Sig2, err = parseEOTSSig(ctx.String(sig2Flag)); err != nil {
+		return nil, fmt.Errorf("invalid second signature: %w", err)
+	}
+
+	return evidence, nil
+}
+
+// signReport signs the hash of the report with the key of the given name
+// and records the signature in the audit log
+func signReport(ctx *cli.Context, keyName string, reportHash []byte) (*schnorr.Signature, *bbntypes.BIP340PubKey, error) {
+	homePath, err := getHomeFlag(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load home flag: %w", err)
+	}
+
+	cfg, err := config.LoadConfig(homePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load config at %s: %w", homePath, err)
+	}
+
+	logger, err := log.NewRootLoggerWithFile(config.LogFile(homePath), cfg.LogLevel)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load the logger")
+	}
+
+	dbBackend, err := cfg.DatabaseConfig.GetDbBackend()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create db backend: %w", err)
+	}
+	defer dbBackend.Close()
+
+	eotsManager, err := newEOTSManager(cfg, homePath, ctx.String(keyringBackendFlag), dbBackend, logger)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create EOTS manager: %w", err)
+	}
+	defer eotsManager.Close()
+
+	passphrase, err := getPassphrase(ctx, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signature, pubKey, err := eotsManager.SignSchnorrSigFromKeyname(keyName, passphrase, reportHash)
+	if auditErr := auditLocalSign(dbBackend, cfg.AuditAnchor, "local:eotsd forensics extract", pubKey, reportHash, err); auditErr != nil {
+		return nil, nil, auditErr
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to sign the report: %w", err)
+	}
+
+	return signature, pubKey, nil
+}
+
+func parseEOTSSig(sigHex string) (*btcec.ModNScalar, error) {
+	sigBytes, err := decodeHex32(sigHex)
+	if err != nil {
+		return nil, err
+	}
+
+	var sig btcec.ModNScalar
+	if overflow := sig.SetByteSlice(sigBytes); overflow {
+		return nil, fmt.Errorf("overflow")
+	}
+
+	return &sig, nil
+}
+
+func decodeHex32(s string) ([]byte, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != 32 {
+		return nil, fmt.Errorf("expected 32 bytes, got %d", len(b))
+	}
+
+	return b, nil
+}
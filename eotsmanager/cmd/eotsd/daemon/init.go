@@ -58,6 +58,17 @@ func initHome(c *cli.Context) error {
 
 	defaultConfig := eotscfg.DefaultConfig()
 	defaultConfig.DatabaseConfig.DBPath = dataDir
+	defaultConfig.TLS = eotscfg.DefaultTLSConfigWithHomePath(homePath)
+	defaultConfig.AuditAnchor = eotscfg.AuditAnchorFile(homePath)
+
+	// Generate a self-signed TLS certificate for the RPC server, the existing
+	// one is kept so that clients pinning it do not need to be updated
+	if !util.FileExists(defaultConfig.TLS.CertPath) {
+		if err := util.GenerateSelfSignedCert(defaultConfig.TLS.CertPath, defaultConfig.TLS.KeyPath, nil); err != nil {
+			return err
+		}
+	}
+
 	fileParser := flags.NewParser(defaultConfig, flags.Default)
 
 	return flags.NewIniParser(fileParser).WriteFile(eotscfg.ConfigFile(homePath), flags.IniIncludeComments|flags.IniIncludeDefaults)
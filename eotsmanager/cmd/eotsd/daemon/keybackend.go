@@ -0,0 +1,39 @@
+package daemon
+
+import (
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"go.uber.org/zap"
+
+	"github.com/babylonchain/finality-provider/eotsmanager"
+	"github.com/babylonchain/finality-provider/eotsmanager/config"
+	"github.com/babylonchain/finality-provider/eotsmanager/extsigner"
+)
+
+// newEOTSManager creates the EOTS manager with the key backend of the config,
+// which is the external signer if enabled or the keyring of the given type
+func newEOTSManager(cfg *config.Config, homePath, keyringBackend string, dbBackend kvdb.Backend, logger *zap.Logger) (*eotsmanager.LocalEOTSManager, error) {
+	signerCfg := cfg.ExternalSigner
+	if signerCfg == nil || !signerCfg.Enable {
+		return eotsmanager.NewLocalEOTSManager(homePath, keyringBackend, dbBackend, logger)
+	}
+
+	var client *extsigner.Client
+	if signerCfg.SocketPath != "" {
+		client = extsigner.NewSocketClient(signerCfg.SocketPath, signerCfg.Timeout)
+		logger.Info("using the external signer", zap.String("socket", signerCfg.SocketPath))
+	} else {
+		command, args := signerCfg.CommandArgs()
+		client = extsigner.NewProcessClient(command, args, signerCfg.Timeout)
+		logger.Info("using the external signer", zap.String("command", signerCfg.Command))
+	}
+
+	em, err := eotsmanager.NewLocalEOTSManagerWithKeyBackend(client, dbBackend, logger)
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to create EOTS manager: %w", err)
+	}
+
+	return em, nil
+}
@@ -2,19 +2,26 @@ package daemon
 
 import (
 	"bufio"
+	"bytes"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/go-bip39"
+	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/urfave/cli"
 
 	bbntypes "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/finality-provider/eotsmanager"
 	"github.com/babylonchain/finality-provider/eotsmanager/config"
+	"github.com/babylonchain/finality-provider/eotsmanager/types"
 	"github.com/babylonchain/finality-provider/log"
+	"github.com/babylonchain/finality-provider/util"
 )
 
 type KeyOutput struct {
@@ -29,11 +36,20 @@ var KeysCommands = []cli.Command{
 		Usage:    "Command sets of managing keys for interacting with BTC eots keys.",
 		Category: "Key management",
 		Subcommands: []cli.Command{
-			AddKeyCmd,
+			AddKeyCmd, ListKeysCmd, ShowKeyCmd, ExportKeyCmd, ImportKeyCmd, SplitKeyCmd, CombineKeyCmd,
 		},
 	},
 }
 
+// KeyInfoOutput is the public information of a key, where the creation
+// time is empty for keys created before it was recorded
+type KeyInfoOutput struct {
+	Name      string   `json:"name" yaml:"name"`
+	PubKeyHex string   `json:"pub_key_hex" yaml:"pub_key_hex"`
+	CreatedAt string   `json:"created_at,omitempty" yaml:"created_at"`
+	ChainIDs  []string `json:"chain_ids" yaml:"chain_ids"`
+}
+
 var AddKeyCmd = cli.Command{
 	Name:  "add",
 	Usage: "Add a key to the EOTS manager keyring.",
@@ -53,6 +69,8 @@ var AddKeyCmd = cli.Command{
 			Usage: "The pass phrase used to encrypt the keys",
 			Value: defaultPassphrase,
 		},
+		passphraseFileCliFlag,
+		passphrasePromptCliFlag,
 		cli.StringFlag{
 			Name:  hdPathFlag,
 			Usage: "The hd path used to derive the private key",
@@ -72,6 +90,210 @@ var AddKeyCmd = cli.Command{
 	Action: addKey,
 }
 
+var ListKeysCmd = cli.Command{
+	Name:  "list",
+	Usage: "List the keys of the EOTS manager and the chains their randomness is generated for.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "Path to the keyring directory",
+			Value: config.DefaultEOTSDir,
+		},
+	},
+	Action: listKeys,
+}
+
+var ShowKeyCmd = cli.Command{
+	Name:  "show",
+	Usage: "Show the key of the EOTS manager with the given name or public key.",
+	Description: `Show the key associated with the key-name or btc-pk flag.
+	If the both flags are supplied, btc-pk takes priority`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "Path to the keyring directory",
+			Value: config.DefaultEOTSDir,
+		},
+		cli.StringFlag{
+			Name:  keyNameFlag,
+			Usage: "The name of the key to show",
+		},
+		cli.StringFlag{
+			Name:  fpPkFlag,
+			Usage: "The hex of the EOTS public key to show",
+		},
+	},
+	Action: showKey,
+}
+
+var ExportKeyCmd = cli.Command{
+	Name:      "export",
+	Usage:     "Export a key along with its metadata and signing history into an encrypted backup file.",
+	UsageText: "export [file-path]",
+	Description: `Export the key associated with the key-name or btc-pk flag into the
+	given file, encrypted by the backup passphrase. If the both flags are supplied,
+	btc-pk takes priority`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "Path to the keyring directory",
+			Value: config.DefaultEOTSDir,
+		},
+		cli.StringFlag{
+			Name:  keyNameFlag,
+			Usage: "The name of the key to export",
+		},
+		cli.StringFlag{
+			Name:  fpPkFlag,
+			Usage: "The hex of the EOTS public key to export",
+		},
+		cli.StringFlag{
+			Name:  passphraseFlag,
+			Usage: "The passphrase used to decrypt the keyring",
+			Value: defaultPassphrase,
+		},
+		passphraseFileCliFlag,
+		passphrasePromptCliFlag,
+		cli.StringFlag{
+			Name:     backupPassphraseFlag,
+			Usage:    "The passphrase used to encrypt the backup file",
+			Required: true,
+		},
+		cli.StringFlag{
+			Name:  keyringBackendFlag,
+			Usage: "The backend of the keyring",
+			Value: defaultKeyringBackend,
+		},
+	},
+	Action: exportKey,
+}
+
+var ImportKeyCmd = cli.Command{
+	Name:      "import",
+	Usage:     "Import a key along with its metadata and signing history from an encrypted backup file.",
+	UsageText: "import [file-path]",
+	Description: `Import the key in the given backup file created by the export command.
+	The import is refused if the public key or the key name already exists`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "Path to the keyring directory",
+			Value: config.DefaultEOTSDir,
+		},
+		cli.StringFlag{
+			Name:  keyNameFlag,
+			Usage: "The name to save the key under, which defaults to the name in the backup",
+		},
+		cli.StringFlag{
+			Name:  passphraseFlag,
+			Usage: "The pass phrase used to encrypt the keys",
+			Value: defaultPassphrase,
+		},
+		passphraseFileCliFlag,
+		passphrasePromptCliFlag,
+		cli.StringFlag{
+			Name:     backupPassphraseFlag,
+			Usage:    "The passphrase used to decrypt the backup file",
+			Required: true,
+		},
+		cli.StringFlag{
+			Name:  keyringBackendFlag,
+			Usage: "The backend of the keyring",
+			Value: defaultKeyringBackend,
+		},
+	},
+	Action: importKey,
+}
+
+var SplitKeyCmd = cli.Command{
+	Name:      "split",
+	Usage:     "Split a key into Shamir shares written to separate files.",
+	UsageText: "split [output-dir]",
+	Description: `Split the key associated with the key-name or btc-pk flag into the
+	given number of shares, any threshold of which recover the key. Each share is
+	written to a separate file in the output directory. If the both flags are
+	supplied, btc-pk takes priority`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "Path to the keyring directory",
+			Value: config.DefaultEOTSDir,
+		},
+		cli.StringFlag{
+			Name:  keyNameFlag,
+			Usage: "The name of the key to split",
+		},
+		cli.StringFlag{
+			Name:  fpPkFlag,
+			Usage: "The hex of the EOTS public key to split",
+		},
+		cli.StringFlag{
+			Name:  passphraseFlag,
+			Usage: "The passphrase used to decrypt the keyring",
+			Value: defaultPassphrase,
+		},
+		passphraseFileCliFlag,
+		passphrasePromptCliFlag,
+		cli.UintFlag{
+			Name:     thresholdFlag,
+			Usage:    "The number of shares required to recover the key",
+			Required: true,
+		},
+		cli.UintFlag{
+			Name:     sharesFlag,
+			Usage:    "The number of shares to split the key into",
+			Required: true,
+		},
+		cli.StringFlag{
+			Name:  keyringBackendFlag,
+			Usage: "The backend of the keyring",
+			Value: defaultKeyringBackend,
+		},
+	},
+	Action: splitKey,
+}
+
+var CombineKeyCmd = cli.Command{
+	Name:      "combine",
+	Usage:     "Recover a key from Shamir shares created by the split command.",
+	UsageText: "combine [share-file] [share-file]...",
+	Description: `Verify the given shares, recover the key, and check that it yields
+	the public key of the split. The recovery is refused if the key already exists in
+	the keyring. As the shares do not carry the signing records of the key, a database
+	backup holding them should be restored with eotsd db restore first, so that the key
+	keeps its protection against double signing. Otherwise, the recovery is refused
+	unless --without-history is given.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "Path to the keyring directory",
+			Value: config.DefaultEOTSDir,
+		},
+		cli.StringFlag{
+			Name:  keyNameFlag,
+			Usage: "The name to save the key under, which defaults to the name in the shares",
+		},
+		cli.StringFlag{
+			Name:  passphraseFlag,
+			Usage: "The pass phrase used to encrypt the keys",
+			Value: defaultPassphrase,
+		},
+		passphraseFileCliFlag,
+		passphrasePromptCliFlag,
+		cli.StringFlag{
+			Name:  keyringBackendFlag,
+			Usage: "The backend of the keyring",
+			Value: defaultKeyringBackend,
+		},
+		cli.BoolFlag{
+			Name: withoutHistoryFlag,
+			Usage: "Recover the key although the database holds none of its signing records, " +
+				"so that it can double sign the heights it signed before",
+		},
+	},
+	Action: combineKey,
+}
+
 func addKey(ctx *cli.Context) error {
 	keyName := ctx.String(keyNameFlag)
 	keyringBackend := ctx.String(keyringBackendFlag)
@@ -97,10 +319,11 @@ func addKey(ctx *cli.Context) error {
 	}
 	defer dbBackend.Close()
 
-	eotsManager, err := eotsmanager.NewLocalEOTSManager(homePath, keyringBackend, dbBackend, logger)
+	eotsManager, err := newEOTSManager(cfg, homePath, keyringBackend, dbBackend, logger)
 	if err != nil {
 		return fmt.Errorf("failed to create EOTS manager: %w", err)
 	}
+	defer eotsManager.Close()
 
 	eotsPk, mnemonic, err := createKey(ctx, eotsManager, keyName)
 	if err != nil {
@@ -118,14 +341,34 @@ func addKey(ctx *cli.Context) error {
 }
 
 // createKey checks if recover flag is set to create a key from mnemonic or if not set, randomly creates it.
+// Keys of an external signer are generated by the signer, so no mnemonic is returned for them
 func createKey(
 	ctx *cli.Context,
 	eotsManager *eotsmanager.LocalEOTSManager,
 	keyName string,
 ) (eotsPk *bbntypes.BIP340PubKey, mnemonic string, err error) {
-	passphrase := ctx.String(passphraseFlag)
+	passphrase, err := getPassphrase(ctx, true)
+	if err != nil {
+		return nil, "", err
+	}
 	hdPath := ctx.String(hdPathFlag)
 
+	if !eotsManager.HoldsPrivKeys() {
+		if ctx.Bool(recoverFlag) {
+			return nil, "", fmt.Errorf("keys cannot be recovered from mnemonics into the external signer: %w",
+				eotsmanager.ErrPrivKeyNotExposed)
+		}
+		pkBytes, err := eotsManager.CreateKey(keyName, passphrase, hdPath)
+		if err != nil {
+			return nil, "", err
+		}
+		eotsPk, err = bbntypes.NewBIP340PubKey(pkBytes)
+		if err != nil {
+			return nil, "", err
+		}
+		return eotsPk, "", nil
+	}
+
 	mnemonic, err = getMnemonic(ctx)
 	if err != nil {
 		return nil, "", err
@@ -165,3 +408,315 @@ func printRespJSONKeys(resp interface{}) {
 	fmt.Printf("New key for the BTC chain is created "+
 		"(mnemonic should be kept in a safe place for recovery):\n%s\n", jsonBytes)
 }
+
+func listKeys(ctx *cli.Context) error {
+	keys, err := loadKeyInfoList(ctx)
+	if err != nil {
+		return err
+	}
+
+	outs := make([]KeyInfoOutput, 0, len(keys))
+	for _, k := range keys {
+		outs = append(outs, keyInfoToOutput(k))
+	}
+	printRespJSON(outs)
+
+	return nil
+}
+
+func showKey(ctx *cli.Context) error {
+	keyName := ctx.String(keyNameFlag)
+	fpPkStr := ctx.String(fpPkFlag)
+	if len(fpPkStr) == 0 && len(keyName) == 0 {
+		return fmt.Errorf("at least one of the flags: %s, %s needs to be informed", keyNameFlag, fpPkFlag)
+	}
+
+	var fpPk *bbntypes.BIP340PubKey
+	if len(fpPkStr) > 0 {
+		pk, err := bbntypes.NewBIP340PubKeyFromHex(fpPkStr)
+		if err != nil {
+			return fmt.Errorf("invalid EOTS public key %s: %w", fpPkStr, err)
+		}
+		fpPk = pk
+	}
+
+	keys, err := loadKeyInfoList(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, k := range keys {
+		if (fpPk != nil && bytes.Equal(k.PubKey, *fpPk)) || (fpPk == nil && k.Name == keyName) {
+			printRespJSON(keyInfoToOutput(k))
+			return nil
+		}
+	}
+
+	if fpPk != nil {
+		return fmt.Errorf("key with public key %s is not found", fpPkStr)
+	}
+	return fmt.Errorf("key with name %s is not found", keyName)
+}
+
+func exportKey(ctx *cli.Context) error {
+	keyName := ctx.String(keyNameFlag)
+	fpPkStr := ctx.String(fpPkFlag)
+
+	outputPath := ctx.Args().First()
+	if len(outputPath) == 0 {
+		return errors.New("invalid argument, please provide a valid file path as output argument")
+	}
+	if util.FileExists(outputPath) {
+		return fmt.Errorf("the output file %s already exists", outputPath)
+	}
+
+	if len(fpPkStr) == 0 && len(keyName) == 0 {
+		return fmt.Errorf("at least one of the flags: %s, %s needs to be informed", keyNameFlag, fpPkFlag)
+	}
+
+	eotsManager, dbBackend, err := loadLocalEOTSManager(ctx, ctx.String(keyringBackendFlag))
+	if err != nil {
+		return err
+	}
+	defer dbBackend.Close()
+
+	fpPk, err := loadFpPk(eotsManager, keyName, fpPkStr)
+	if err != nil {
+		return err
+	}
+
+	passphrase, err := getPassphrase(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	armor, err := eotsManager.ExportKey(fpPk, passphrase, ctx.String(backupPassphraseFlag))
+	if err != nil {
+		return fmt.Errorf("failed to export key: %w", err)
+	}
+
+	if err := os.WriteFile(outputPath, []byte(armor), 0600); err != nil {
+		return fmt.Errorf("failed to write the backup to %s: %w", outputPath, err)
+	}
+
+	fmt.Printf("Key %s is exported to %s\n", hex.EncodeToString(fpPk), outputPath)
+	return nil
+}
+
+func importKey(ctx *cli.Context) error {
+	inputPath := ctx.Args().First()
+	if len(inputPath) == 0 {
+		return errors.New("invalid argument, please provide a valid file path as input argument")
+	}
+
+	armor, err := os.ReadFile(inputPath)
+	if err != nil {
+		return fmt.Errorf("failed to read the backup from %s: %w", inputPath, err)
+	}
+
+	passphrase, err := getPassphrase(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	eotsManager, dbBackend, err := loadLocalEOTSManager(ctx, ctx.String(keyringBackendFlag))
+	if err != nil {
+		return err
+	}
+	defer dbBackend.Close()
+
+	eotsPk, err := eotsManager.ImportKey(
+		string(armor), ctx.String(backupPassphraseFlag), ctx.String(keyNameFlag), passphrase,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to import key: %w", err)
+	}
+
+	keys, err := eotsManager.ListKeys()
+	if err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if bytes.Equal(k.PubKey, eotsPk.MustMarshal()) {
+			printRespJSON(keyInfoToOutput(k))
+			break
+		}
+	}
+
+	return nil
+}
+
+func splitKey(ctx *cli.Context) error {
+	keyName := ctx.String(keyNameFlag)
+	fpPkStr := ctx.String(fpPkFlag)
+
+	outputDir := ctx.Args().First()
+	if len(outputDir) == 0 {
+		return errors.New("invalid argument, please provide a valid directory as output argument")
+	}
+	if len(fpPkStr) == 0 && len(keyName) == 0 {
+		return fmt.Errorf("at least one of the flags: %s, %s needs to be informed", keyNameFlag, fpPkFlag)
+	}
+
+	eotsManager, dbBackend, err := loadLocalEOTSManager(ctx, ctx.String(keyringBackendFlag))
+	if err != nil {
+		return err
+	}
+	defer dbBackend.Close()
+
+	fpPk, err := loadFpPk(eotsManager, keyName, fpPkStr)
+	if err != nil {
+		return err
+	}
+
+	passphrase, err := getPassphrase(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	total := uint32(ctx.Uint(sharesFlag))
+	armors, err := eotsManager.SplitKey(fpPk, passphrase, uint32(ctx.Uint(thresholdFlag)), total)
+	if err != nil {
+		return fmt.Errorf("failed to split key: %w", err)
+	}
+
+	if err := util.MakeDirectory(outputDir); err != nil {
+		return err
+	}
+	pkHex := hex.EncodeToString(fpPk)
+	for i, armor := range armors {
+		sharePath := filepath.Join(outputDir, fmt.Sprintf("%s-share-%d-of-%d.txt", pkHex, i+1, total))
+		if util.FileExists(sharePath) {
+			return fmt.Errorf("the share file %s already exists", sharePath)
+		}
+		if err := os.WriteFile(sharePath, []byte(armor), 0600); err != nil {
+			return fmt.Errorf("failed to write the share to %s: %w", sharePath, err)
+		}
+		fmt.Printf("Share %d of key %s is written to %s\n", i+1, pkHex, sharePath)
+	}
+
+	return nil
+}
+
+func combineKey(ctx *cli.Context) error {
+	sharePaths := ctx.Args()
+	if len(sharePaths) == 0 {
+		return errors.New("invalid argument, please provide the paths of the share files")
+	}
+
+	armors := make([]string, 0, len(sharePaths))
+	for _, p := range sharePaths {
+		armor, err := os.ReadFile(p)
+		if err != nil {
+			return fmt.Errorf("failed to read the share from %s: %w", p, err)
+		}
+		armors = append(armors, string(armor))
+	}
+
+	passphrase, err := getPassphrase(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	eotsManager, dbBackend, err := loadLocalEOTSManager(ctx, ctx.String(keyringBackendFlag))
+	if err != nil {
+		return err
+	}
+	defer dbBackend.Close()
+
+	withoutHistory := ctx.Bool(withoutHistoryFlag)
+	eotsPk, err := eotsManager.RecoverKeyFromShares(armors, ctx.String(keyNameFlag), passphrase, withoutHistory)
+	if err != nil {
+		if errors.Is(err, eotsmanager.ErrNoSignHistory) {
+			return fmt.Errorf("failed to recover key: %w (use --%s to recover it anyway)", err, withoutHistoryFlag)
+		}
+		return fmt.Errorf("failed to recover key: %w", err)
+	}
+
+	fmt.Printf("Key %s is recovered and verified\n", eotsPk.MarshalHex())
+	if withoutHistory {
+		fmt.Fprintf(os.Stderr, "WARNING: the key %s has no signing history in this database, "+
+			"so nothing prevents it from signing a different message at a height it signed before, "+
+			"which leaks the key and gets the finality provider slashed. Do not sign with it until "+
+			"the heights it may have signed are finalized or its signing records are restored.\n",
+			eotsPk.MarshalHex())
+	}
+	return nil
+}
+
+// loadFpPk returns the public key given by its hex, or the public key of the
+// key with the given name if the hex is empty
+func loadFpPk(eotsManager *eotsmanager.LocalEOTSManager, keyName, fpPkStr string) ([]byte, error) {
+	if len(fpPkStr) > 0 {
+		pk, err := bbntypes.NewBIP340PubKeyFromHex(fpPkStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EOTS public key %s: %w", fpPkStr, err)
+		}
+		return pk.MustMarshal(), nil
+	}
+
+	pk, err := eotsManager.LoadBIP340PubKeyFromKeyName(keyName)
+	if err != nil {
+		return nil, err
+	}
+	return pk.MustMarshal(), nil
+}
+
+func loadLocalEOTSManager(ctx *cli.Context, keyringBackend string) (*eotsmanager.LocalEOTSManager, kvdb.Backend, error) {
+	homePath, err := getHomeFlag(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load home flag: %w", err)
+	}
+
+	cfg, err := config.LoadConfig(homePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load config at %s: %w", homePath, err)
+	}
+
+	logger, err := log.NewRootLoggerWithFile(config.LogFile(homePath), cfg.LogLevel)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load the logger")
+	}
+
+	dbBackend, err := cfg.DatabaseConfig.GetDbBackend()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create db backend: %w", err)
+	}
+
+	if keyringBackend == "" {
+		keyringBackend = cfg.KeyringBackend
+	}
+	eotsManager, err := newEOTSManager(cfg, homePath, keyringBackend, dbBackend, logger)
+	if err != nil {
+		dbBackend.Close()
+		return nil, nil, fmt.Errorf("failed to create EOTS manager: %w", err)
+	}
+
+	return eotsManager, dbBackend, nil
+}
+
+func loadKeyInfoList(ctx *cli.Context) ([]*types.KeyInfo, error) {
+	eotsManager, dbBackend, err := loadLocalEOTSManager(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	defer dbBackend.Close()
+
+	return eotsManager.ListKeys()
+}
+
+func keyInfoToOutput(k *types.KeyInfo) KeyInfoOutput {
+	out := KeyInfoOutput{
+		Name:      k.Name,
+		PubKeyHex: hex.EncodeToString(k.PubKey),
+		ChainIDs:  make([]string, 0, len(k.ChainIDs)),
+	}
+	if !k.CreatedAt.IsZero() {
+		out.CreatedAt = k.CreatedAt.UTC().Format(time.RFC3339)
+	}
+	for _, id := range k.ChainIDs {
+		out.ChainIDs = append(out.ChainIDs, string(id))
+	}
+
+	return out
+}
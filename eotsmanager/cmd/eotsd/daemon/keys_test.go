@@ -0,0 +1,141 @@
+package daemon_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dcli "github.com/babylonchain/finality-provider/eotsmanager/cmd/eotsd/daemon"
+	"github.com/babylonchain/finality-provider/testutil"
+)
+
+func FuzzKeysListShow(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 5)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		homeDir := filepath.Join(t.TempDir(), "eots-home")
+		app := testApp()
+		hFlag := fmt.Sprintf("--home=%s", homeDir)
+		err := app.Run([]string{"eotsd", "init", hFlag})
+		require.NoError(t, err)
+
+		keyName := testutil.GenRandomHexStr(r, 10)
+		keyOutput := appRunWithOutput(r, t, app, []string{"eotsd", "keys", "add", hFlag, "--key-name=" + keyName})
+		var keyOut dcli.KeyOutput
+		err = json.Unmarshal([]byte(searchInTxt(keyOutput, "for recovery):")), &keyOut)
+		require.NoError(t, err)
+
+		listOutput := appRunWithOutput(r, t, app, []string{"eotsd", "keys", "list", hFlag})
+		var listed []dcli.KeyInfoOutput
+		err = json.Unmarshal([]byte(searchInTxt(listOutput, "")), &listed)
+		require.NoError(t, err)
+		require.Len(t, listed, 1)
+		require.Equal(t, keyName, listed[0].Name)
+		require.Equal(t, keyOut.PubKeyHex, listed[0].PubKeyHex)
+		require.NotEmpty(t, listed[0].CreatedAt)
+		require.Empty(t, listed[0].ChainIDs)
+
+		for _, flag := range []string{"--key-name=" + keyName, "--btc-pk=" + keyOut.PubKeyHex} {
+			showOutput := appRunWithOutput(r, t, app, []string{"eotsd", "keys", "show", hFlag, flag})
+			var shown dcli.KeyInfoOutput
+			err = json.Unmarshal([]byte(searchInTxt(showOutput, "")), &shown)
+			require.NoError(t, err)
+			require.Equal(t, listed[0], shown)
+		}
+
+		err = app.Run([]string{"eotsd", "keys", "show", hFlag, "--key-name=unknown"})
+		require.Error(t, err)
+	})
+}
+
+func FuzzKeysExportImport(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 3)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		tempDir := t.TempDir()
+		app := testApp()
+		hFlag := fmt.Sprintf("--home=%s", filepath.Join(tempDir, "eots-home"))
+		hFlag2 := fmt.Sprintf("--home=%s", filepath.Join(tempDir, "eots-home-2"))
+		for _, h := range []string{hFlag, hFlag2} {
+			err := app.Run([]string{"eotsd", "init", h})
+			require.NoError(t, err)
+		}
+
+		keyName := testutil.GenRandomHexStr(r, 10)
+		keyOutput := appRunWithOutput(r, t, app, []string{"eotsd", "keys", "add", hFlag, "--key-name=" + keyName})
+		var keyOut dcli.KeyOutput
+		err := json.Unmarshal([]byte(searchInTxt(keyOutput, "for recovery):")), &keyOut)
+		require.NoError(t, err)
+
+		backupPath := filepath.Join(tempDir, "key.backup")
+		backupFlag := "--backup-passphrase=" + testutil.GenRandomHexStr(r, 8)
+		err = app.Run([]string{"eotsd", "keys", "export", backupPath, hFlag, "--key-name=" + keyName, backupFlag})
+		require.NoError(t, err)
+
+		// importing into the same home collides with the existing key
+		err = app.Run([]string{"eotsd", "keys", "import", backupPath, hFlag, backupFlag})
+		require.Error(t, err)
+
+		importOutput := appRunWithOutput(r, t, app, []string{"eotsd", "keys", "import", backupPath, hFlag2, backupFlag})
+		// skip the log line printed along with the output
+		jsonOutput := importOutput[strings.Index(importOutput, "{\n"):]
+		var imported dcli.KeyInfoOutput
+		err = json.Unmarshal([]byte(jsonOutput), &imported)
+		require.NoError(t, err)
+		require.Equal(t, keyName, imported.Name)
+		require.Equal(t, keyOut.PubKeyHex, imported.PubKeyHex)
+	})
+}
+
+func FuzzKeysSplitCombine(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 3)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		tempDir := t.TempDir()
+		app := testApp()
+		hFlag := fmt.Sprintf("--home=%s", filepath.Join(tempDir, "eots-home"))
+		hFlag2 := fmt.Sprintf("--home=%s", filepath.Join(tempDir, "eots-home-2"))
+		for _, h := range []string{hFlag, hFlag2} {
+			err := app.Run([]string{"eotsd", "init", h})
+			require.NoError(t, err)
+		}
+
+		keyName := testutil.GenRandomHexStr(r, 10)
+		keyOutput := appRunWithOutput(r, t, app, []string{"eotsd", "keys", "add", hFlag, "--key-name=" + keyName})
+		var keyOut dcli.KeyOutput
+		err := json.Unmarshal([]byte(searchInTxt(keyOutput, "for recovery):")), &keyOut)
+		require.NoError(t, err)
+
+		sharesDir := filepath.Join(tempDir, "shares")
+		err = app.Run([]string{"eotsd", "keys", "split", sharesDir, hFlag, "--key-name=" + keyName,
+			"--threshold=2", "--shares=3"})
+		require.NoError(t, err)
+
+		sharePaths, err := filepath.Glob(filepath.Join(sharesDir, keyOut.PubKeyHex+"-share-*-of-3.txt"))
+		require.NoError(t, err)
+		require.Len(t, sharePaths, 3)
+
+		err = app.Run([]string{"eotsd", "keys", "combine", sharePaths[0], hFlag2})
+		require.Error(t, err)
+
+		err = app.Run([]string{"eotsd", "keys", "combine", sharePaths[0], sharePaths[2], hFlag2})
+		require.Error(t, err)
+
+		err = app.Run([]string{"eotsd", "keys", "combine", sharePaths[0], sharePaths[2], hFlag2, "--without-history"})
+		require.NoError(t, err)
+
+		showOutput := appRunWithOutput(r, t, app, []string{"eotsd", "keys", "show", hFlag2, "--key-name=" + keyName})
+		var shown dcli.KeyInfoOutput
+		err = json.Unmarshal([]byte(searchInTxt(showOutput, "")), &shown)
+		require.NoError(t, err)
+		require.Equal(t, keyOut.PubKeyHex, shown.PubKeyHex)
+	})
+}
@@ -0,0 +1,37 @@
+package daemon
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/babylonchain/finality-provider/util"
+)
+
+// passphraseEnvVar holds the passphrase of the keys if it is given by none
+// of the passphrase flags
+const passphraseEnvVar = "EOTSD_PASSPHRASE"
+
+var (
+	passphraseFileCliFlag = cli.StringFlag{
+		Name:  passphraseFileFlag,
+		Usage: "The file holding the passphrase on its first line, instead of --" + passphraseFlag,
+	}
+	passphrasePromptCliFlag = cli.BoolFlag{
+		Name:  passphrasePromptFlag,
+		Usage: "Prompt for the passphrase on the terminal, instead of --" + passphraseFlag,
+	}
+)
+
+// getPassphrase reads the passphrase from --passphrase, --passphrase-file,
+// --passphrase-prompt, or the EOTSD_PASSPHRASE environment variable. The
+// passphrase is asked twice when prompting if confirm is set, as when it
+// encrypts a new key
+func getPassphrase(ctx *cli.Context, confirm bool) (string, error) {
+	return util.ReadPassphrase(util.PassphraseSource{
+		Value:   ctx.String(passphraseFlag),
+		IsSet:   ctx.IsSet(passphraseFlag),
+		File:    ctx.String(passphraseFileFlag),
+		EnvVar:  passphraseEnvVar,
+		Prompt:  ctx.Bool(passphrasePromptFlag),
+		Confirm: confirm,
+	})
+}
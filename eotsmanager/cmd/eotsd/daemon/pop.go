@@ -9,7 +9,6 @@ import (
 	bbnparams "github.com/babylonchain/babylon/app/params"
 	bbn "github.com/babylonchain/babylon/types"
 	btcstktypes "github.com/babylonchain/babylon/x/btcstaking/types"
-	"github.com/babylonchain/finality-provider/eotsmanager"
 	"github.com/babylonchain/finality-provider/eotsmanager/config"
 	"github.com/babylonchain/finality-provider/log"
 	"github.com/urfave/cli"
@@ -55,6 +54,8 @@ var ExportPoPCommand = cli.Command{
 			Usage: "The passphrase used to decrypt the keyring",
 			Value: defaultPassphrase,
 		},
+		passphraseFileCliFlag,
+		passphrasePromptCliFlag,
 		cli.StringFlag{
 			Name:  keyringBackendFlag,
 			Usage: "The backend of the keyring",
@@ -67,8 +68,11 @@ var ExportPoPCommand = cli.Command{
 func ExportPoP(ctx *cli.Context) error {
 	keyName := ctx.String(keyNameFlag)
 	fpPkStr := ctx.String(fpPkFlag)
-	passphrase := ctx.String(passphraseFlag)
 	keyringBackend := ctx.String(keyringBackendFlag)
+	passphrase, err := getPassphrase(ctx, false)
+	if err != nil {
+		return err
+	}
 
 	args := ctx.Args()
 	bbnAddressStr := args.First()
@@ -102,10 +106,11 @@ func ExportPoP(ctx *cli.Context) error {
 	}
 	defer dbBackend.Close()
 
-	eotsManager, err := eotsmanager.NewLocalEOTSManager(homePath, keyringBackend, dbBackend, logger)
+	eotsManager, err := newEOTSManager(cfg, homePath, keyringBackend, dbBackend, logger)
 	if err != nil {
 		return fmt.Errorf("failed to create EOTS manager: %w", err)
 	}
+	defer eotsManager.Close()
 
 	hashOfMsgToSign := tmhash.Sum(bbnAddr.Bytes())
 	btcSig, pubKey, err := singMsg(eotsManager, keyName, fpPkStr, passphrase, hashOfMsgToSign)
@@ -8,12 +8,16 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	bbntypes "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/finality-provider/eotsmanager"
 	"github.com/babylonchain/finality-provider/eotsmanager/config"
+	"github.com/babylonchain/finality-provider/eotsmanager/proto"
+	"github.com/babylonchain/finality-provider/eotsmanager/store"
 	"github.com/babylonchain/finality-provider/log"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/urfave/cli"
 )
 
@@ -50,6 +54,8 @@ var SignSchnorrSig = cli.Command{
 			Usage: "The passphrase used to decrypt the keyring",
 			Value: defaultPassphrase,
 		},
+		passphraseFileCliFlag,
+		passphrasePromptCliFlag,
 		cli.StringFlag{
 			Name:  keyringBackendFlag,
 			Usage: "The backend of the keyring",
@@ -124,8 +130,11 @@ func SignSchnorrVerify(ctx *cli.Context) error {
 func SignSchnorr(ctx *cli.Context) error {
 	keyName := ctx.String(keyNameFlag)
 	fpPkStr := ctx.String(fpPkFlag)
-	passphrase := ctx.String(passphraseFlag)
 	keyringBackend := ctx.String(keyringBackendFlag)
+	passphrase, err := getPassphrase(ctx, false)
+	if err != nil {
+		return err
+	}
 
 	args := ctx.Args()
 	inputFilePath := args.First()
@@ -158,10 +167,11 @@ func SignSchnorr(ctx *cli.Context) error {
 	}
 	defer dbBackend.Close()
 
-	eotsManager, err := eotsmanager.NewLocalEOTSManager(homePath, keyringBackend, dbBackend, logger)
+	eotsManager, err := newEOTSManager(cfg, homePath, keyringBackend, dbBackend, logger)
 	if err != nil {
 		return fmt.Errorf("failed to create EOTS manager: %w", err)
 	}
+	defer eotsManager.Close()
 
 	hashOfMsgToSign, err := hashFromFile(inputFilePath)
 	if err != nil {
@@ -169,6 +179,9 @@ func SignSchnorr(ctx *cli.Context) error {
 	}
 
 	signature, pubKey, err := singMsg(eotsManager, keyName, fpPkStr, passphrase, hashOfMsgToSign)
+	if auditErr := auditLocalSign(dbBackend, cfg.AuditAnchor, "local:eotsd sign-schnorr", pubKey, hashOfMsgToSign, err); auditErr != nil {
+		return auditErr
+	}
 	if err != nil {
 		return fmt.Errorf("failed to sign msg: %w", err)
 	}
@@ -219,6 +232,39 @@ func singMsg(
 	return eotsManager.SignSchnorrSigFromKeyname(keyName, passphrase, hashOfMsgToSign)
 }
 
+// auditLocalSign records a signature made by the CLI in the audit log, as
+// the ones requested over RPC
+func auditLocalSign(db kvdb.Backend, anchorPath, caller string, pubKey *bbntypes.BIP340PubKey, msg []byte, signErr error) error {
+	auditStore, err := store.NewAuditStore(db, anchorPath)
+	if err != nil {
+		return fmt.Errorf("failed to initiate audit store: %w", err)
+	}
+	// the record is not appended to a log which does not extend its anchor
+	if _, err := auditStore.CheckAuditAnchor(); err != nil && !errors.Is(err, store.ErrAuditAnchorNotFound) {
+		return fmt.Errorf("failed to check the audit log against its anchor: %w", err)
+	}
+
+	msgHash := sha256.Sum256(msg)
+	record := &proto.AuditRecord{
+		Timestamp: time.Now().UnixNano(),
+		Caller:    caller,
+		Method:    "SignSchnorrSig",
+		MsgHash:   msgHash[:],
+	}
+	if pubKey != nil {
+		record.Pk = pubKey.MustMarshal()
+	}
+	if signErr != nil {
+		record.Result = signErr.Error()
+	}
+
+	if err := auditStore.AppendAuditRecords([]*proto.AuditRecord{record}); err != nil {
+		return fmt.Errorf("failed to record the signature in the audit log: %w", err)
+	}
+
+	return nil
+}
+
 func printRespJSON(resp interface{}) {
 	jsonBytes, err := json.MarshalIndent(resp, "", "    ")
 	if err != nil {
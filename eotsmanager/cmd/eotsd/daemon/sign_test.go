@@ -151,5 +151,8 @@ func testApp() *cli.App {
 	app.Name = "eotsd"
 	app.Commands = append(app.Commands, dcli.StartCommand, dcli.InitCommand, dcli.SignSchnorrSig, dcli.VerifySchnorrSig, dcli.ExportPoPCommand)
 	app.Commands = append(app.Commands, dcli.KeysCommands...)
+	app.Commands = append(app.Commands, dcli.TokensCommands...)
+	app.Commands = append(app.Commands, dcli.AuditCommands...)
+	app.Commands = append(app.Commands, dcli.TLSCommands...)
 	return app
 }
@@ -2,13 +2,11 @@ package daemon
 
 import (
 	"fmt"
-	"net"
 	"path/filepath"
 
 	"github.com/lightningnetwork/lnd/signal"
 	"github.com/urfave/cli"
 
-	"github.com/babylonchain/finality-provider/eotsmanager"
 	"github.com/babylonchain/finality-provider/eotsmanager/config"
 	eotsservice "github.com/babylonchain/finality-provider/eotsmanager/service"
 	"github.com/babylonchain/finality-provider/log"
@@ -29,6 +27,10 @@ var StartCommand = cli.Command{
 			Name:  rpcListenerFlag,
 			Usage: "The address that the RPC server listens to",
 		},
+		cli.StringFlag{
+			Name:  secretsFileFlag,
+			Usage: "The JSON file mapping key names to their passphrases, used for the requests without a passphrase",
+		},
 	},
 	Action: startFn,
 }
@@ -46,13 +48,25 @@ func startFn(ctx *cli.Context) error {
 
 	rpcListener := ctx.String(rpcListenerFlag)
 	if rpcListener != "" {
-		_, err := net.ResolveTCPAddr("tcp", rpcListener)
-		if err != nil {
+		if err := util.ValidateRPCListener(rpcListener); err != nil {
 			return fmt.Errorf("invalid RPC listener address %s, %w", rpcListener, err)
 		}
 		cfg.RpcListener = rpcListener
 	}
 
+	var passphrases map[string]string
+	if secretsFile := ctx.String(secretsFileFlag); secretsFile != "" {
+		// anyone able to call the daemon could sign with the keys whose
+		// passphrases it holds
+		if _, isUnix := util.UnixSocketPath(cfg.RpcListener); !isUnix && !cfg.EnableAuth {
+			return fmt.Errorf("the secrets file requires EnableAuth or an RPC listener on a Unix domain socket")
+		}
+		passphrases, err = util.ReadSecretsFile(secretsFile)
+		if err != nil {
+			return fmt.Errorf("failed to read the secrets file: %w", err)
+		}
+	}
+
 	logger, err := log.NewRootLoggerWithFile(config.LogFile(homePath), cfg.LogLevel)
 	if err != nil {
 		return fmt.Errorf("failed to load the logger")
@@ -63,10 +77,12 @@ func startFn(ctx *cli.Context) error {
 		return fmt.Errorf("failed to create db backend: %w", err)
 	}
 
-	eotsManager, err := eotsmanager.NewLocalEOTSManager(homePath, cfg.KeyringBackend, dbBackend, logger)
+	eotsManager, err := newEOTSManager(cfg, homePath, cfg.KeyringBackend, dbBackend, logger)
 	if err != nil {
 		return fmt.Errorf("failed to create EOTS manager: %w", err)
 	}
+	defer eotsManager.Close()
+	eotsManager.SetKeyPassphrases(passphrases)
 
 	// Hook interceptor for os signals.
 	shutdownInterceptor, err := signal.Intercept()
@@ -74,7 +90,10 @@ func startFn(ctx *cli.Context) error {
 		return err
 	}
 
-	eotsServer := eotsservice.NewEOTSManagerServer(cfg, logger, eotsManager, dbBackend, shutdownInterceptor)
+	eotsServer, err := eotsservice.NewEOTSManagerServer(cfg, logger, eotsManager, dbBackend, shutdownInterceptor)
+	if err != nil {
+		return fmt.Errorf("failed to create EOTS manager server: %w", err)
+	}
 
 	return eotsServer.RunUntilShutdown()
 }
@@ -0,0 +1,90 @@
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/config"
+	"github.com/babylonchain/finality-provider/util"
+)
+
+type TLSRotateOutput struct {
+	CertPath       string `json:"cert_path"`
+	PreviousExpiry string `json:"previous_expiry,omitempty"`
+	Expiry         string `json:"expiry"`
+}
+
+var TLSCommands = []cli.Command{
+	{
+		Name:     "tls",
+		Usage:    "Command sets of managing the TLS certificate of the RPC server.",
+		Category: "TLS",
+		Subcommands: []cli.Command{
+			RotateTLSCertCmd,
+		},
+	},
+}
+
+var RotateTLSCertCmd = cli.Command{
+	Name:  "rotate",
+	Usage: "Replace the self-signed TLS certificate and key of the RPC server.",
+	Description: `The daemon never rotates its certificate by itself as the clients pinning
+	it, e.g., the finality-provider daemons, would fail their handshakes. After the
+	rotation, the new certificate should be given to every client and the daemon should
+	be restarted to serve it. Only certificates generated by eotsd can be rotated, the
+	other ones should be renewed with their CA.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "The path to the eotsd home directory",
+			Value: config.DefaultEOTSDir,
+		},
+		cli.StringSliceFlag{
+			Name:  hostFlag,
+			Usage: "An extra host name or IP address the certificate is valid for (repeatable)",
+		},
+	},
+	Action: rotateTLSCert,
+}
+
+func rotateTLSCert(ctx *cli.Context) error {
+	cfg, err := loadConfigFromHome(ctx)
+	if err != nil {
+		return err
+	}
+
+	if cfg.TLS.Disable {
+		return errors.New("TLS is disabled in the config")
+	}
+
+	var output TLSRotateOutput
+	if util.FileExists(cfg.TLS.CertPath) {
+		expiry, autogenerated, err := util.CertExpiry(cfg.TLS.CertPath)
+		if err != nil {
+			return err
+		}
+		if !autogenerated {
+			return fmt.Errorf("the TLS certificate at %s was not generated by eotsd and "+
+				"should be renewed with its CA", cfg.TLS.CertPath)
+		}
+		output.PreviousExpiry = expiry.Format(time.RFC3339)
+	}
+
+	if err := util.GenerateSelfSignedCert(cfg.TLS.CertPath, cfg.TLS.KeyPath, ctx.StringSlice(hostFlag)); err != nil {
+		return err
+	}
+
+	expiry, _, err := util.CertExpiry(cfg.TLS.CertPath)
+	if err != nil {
+		return err
+	}
+	output.CertPath = cfg.TLS.CertPath
+	output.Expiry = expiry.Format(time.RFC3339)
+
+	printRespJSON(output)
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package daemon_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dcli "github.com/babylonchain/finality-provider/eotsmanager/cmd/eotsd/daemon"
+	"github.com/babylonchain/finality-provider/testutil"
+)
+
+func FuzzTLSRotate(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 3)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		homeDir := filepath.Join(t.TempDir(), "eots-home")
+		app := testApp()
+		hFlag := fmt.Sprintf("--home=%s", homeDir)
+		err := app.Run([]string{"eotsd", "init", hFlag})
+		require.NoError(t, err)
+
+		certPath := filepath.Join(homeDir, "tls.cert")
+		oldCert, err := os.ReadFile(certPath)
+		require.NoError(t, err)
+
+		output := appRunWithOutput(r, t, app, []string{"eotsd", "tls", "rotate", hFlag, "--host=eotsd.example.com"})
+		var rotated dcli.TLSRotateOutput
+		err = json.Unmarshal([]byte(searchInTxt(output, "")), &rotated)
+		require.NoError(t, err)
+		require.Equal(t, certPath, rotated.CertPath)
+		require.NotEmpty(t, rotated.PreviousExpiry)
+		require.NotEmpty(t, rotated.Expiry)
+
+		newCert, err := os.ReadFile(certPath)
+		require.NoError(t, err)
+		require.NotEqual(t, oldCert, newCert)
+	})
+}
@@ -0,0 +1,216 @@
+package daemon
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	bbntypes "github.com/babylonchain/babylon/types"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/urfave/cli"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/config"
+	"github.com/babylonchain/finality-provider/eotsmanager/proto"
+	eotsservice "github.com/babylonchain/finality-provider/eotsmanager/service"
+	"github.com/babylonchain/finality-provider/eotsmanager/store"
+)
+
+type TokenOutput struct {
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	Token     string   `json:"token,omitempty"`
+	Methods   []string `json:"methods"`
+	BtcPks    []string `json:"btc_pks"`
+	Admin     bool     `json:"admin"`
+	CreatedAt string   `json:"created_at"`
+}
+
+var TokensCommands = []cli.Command{
+	{
+		Name:     "tokens",
+		Usage:    "Command sets of managing the tokens which authenticate RPC callers.",
+		Category: "Token management",
+		Subcommands: []cli.Command{
+			CreateTokenCmd, RevokeTokenCmd, ListTokensCmd,
+		},
+	},
+}
+
+var CreateTokenCmd = cli.Command{
+	Name:  "create",
+	Usage: "Create a token allowing to call the given RPC methods with the given EOTS keys.",
+	Description: `The token is only printed once and should be passed to the client,
+	e.g., as EOTSManagerAuthToken in the finality-provider config. If no btc-pk is given,
+	the token can be used with any EOTS key, otherwise it cannot call the methods which
+	do not use a key except LockKey. BackupDB can only be allowed to an admin token. The
+	daemon should be stopped as the command accesses its database.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "The path to the eotsd home directory",
+			Value: config.DefaultEOTSDir,
+		},
+		cli.StringFlag{
+			Name:     tokenNameFlag,
+			Usage:    "A label of the token, e.g., the name of the client",
+			Required: true,
+		},
+		cli.StringSliceFlag{
+			Name:     methodFlag,
+			Usage:    "An RPC method the token is allowed to call, e.g., SignEOTS (repeatable)",
+			Required: true,
+		},
+		cli.StringSliceFlag{
+			Name:  fpPkFlag,
+			Usage: "The hex of an EOTS public key the token is allowed to use (repeatable)",
+		},
+		cli.BoolFlag{
+			Name:  adminFlag,
+			Usage: "Allow the token to call the admin methods, i.e., BackupDB, which requires no btc-pk",
+		},
+	},
+	Action: createToken,
+}
+
+var RevokeTokenCmd = cli.Command{
+	Name:      "revoke",
+	Usage:     "Revoke the token with the given id.",
+	UsageText: "revoke [token-id]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "The path to the eotsd home directory",
+			Value: config.DefaultEOTSDir,
+		},
+	},
+	Action: revokeToken,
+}
+
+var ListTokensCmd = cli.Command{
+	Name:  "list",
+	Usage: "List the tokens which are not revoked.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "The path to the eotsd home directory",
+			Value: config.DefaultEOTSDir,
+		},
+	},
+	Action: listTokens,
+}
+
+func createToken(ctx *cli.Context) error {
+	var pks [][]byte
+	for _, pkHex := range ctx.StringSlice(fpPkFlag) {
+		pk, err := bbntypes.NewBIP340PubKeyFromHex(pkHex)
+		if err != nil {
+			return fmt.Errorf("invalid EOTS public key %s: %w", pkHex, err)
+		}
+		pks = append(pks, pk.MustMarshal())
+	}
+
+	token, record, err := eotsservice.NewAuthToken(ctx.String(tokenNameFlag), ctx.StringSlice(methodFlag), pks,
+		ctx.Bool(adminFlag))
+	if err != nil {
+		return fmt.Errorf("failed to create token: %w", err)
+	}
+
+	tokenStore, db, err := loadTokenStore(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if err := tokenStore.AddAuthToken(record); err != nil {
+		return fmt.Errorf("failed to save token: %w", err)
+	}
+
+	out := tokenToOutput(record)
+	out.Token = token
+	printRespJSON(out)
+
+	return nil
+}
+
+func revokeToken(ctx *cli.Context) error {
+	id := ctx.Args().First()
+	if id == "" {
+		return errors.New("invalid argument, please provide the id of the token to revoke")
+	}
+
+	tokenStore, db, err := loadTokenStore(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if err := tokenStore.DeleteAuthToken(id); err != nil {
+		return fmt.Errorf("failed to revoke token %s: %w", id, err)
+	}
+
+	fmt.Printf("Token %s is revoked\n", id)
+	return nil
+}
+
+func listTokens(ctx *cli.Context) error {
+	tokenStore, db, err := loadTokenStore(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	records, err := tokenStore.ListAuthTokens()
+	if err != nil {
+		return fmt.Errorf("failed to list tokens: %w", err)
+	}
+
+	outs := make([]TokenOutput, 0, len(records))
+	for _, r := range records {
+		outs = append(outs, tokenToOutput(r))
+	}
+	printRespJSON(outs)
+
+	return nil
+}
+
+func loadTokenStore(ctx *cli.Context) (*store.TokenStore, kvdb.Backend, error) {
+	homePath, err := getHomeFlag(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load home flag: %w", err)
+	}
+
+	cfg, err := config.LoadConfig(homePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load config at %s: %w", homePath, err)
+	}
+
+	dbBackend, err := cfg.DatabaseConfig.GetDbBackend()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create db backend: %w", err)
+	}
+
+	tokenStore, err := store.NewTokenStore(dbBackend)
+	if err != nil {
+		dbBackend.Close()
+		return nil, nil, fmt.Errorf("failed to initiate token store: %w", err)
+	}
+
+	return tokenStore, dbBackend, nil
+}
+
+func tokenToOutput(r *proto.AuthToken) TokenOutput {
+	pks := make([]string, 0, len(r.Pks))
+	for _, pk := range r.Pks {
+		pks = append(pks, hex.EncodeToString(pk))
+	}
+
+	return TokenOutput{
+		ID:        r.Id,
+		Name:      r.Name,
+		Methods:   r.Methods,
+		BtcPks:    pks,
+		Admin:     r.Admin,
+		CreatedAt: time.Unix(r.CreatedAt, 0).UTC().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,66 @@
+package daemon_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dcli "github.com/babylonchain/finality-provider/eotsmanager/cmd/eotsd/daemon"
+	"github.com/babylonchain/finality-provider/testutil"
+)
+
+func FuzzTokensCreateRevokeList(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 5)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		homeDir := filepath.Join(t.TempDir(), "eots-home")
+		app := testApp()
+		hFlag := fmt.Sprintf("--home=%s", homeDir)
+		err := app.Run([]string{"eotsd", "init", hFlag})
+		require.NoError(t, err)
+
+		keyOutput := appRunWithOutput(r, t, app, []string{"eotsd", "keys", "add", hFlag, "--key-name=fp"})
+		var keyOut dcli.KeyOutput
+		err = json.Unmarshal([]byte(searchInTxt(keyOutput, "for recovery):")), &keyOut)
+		require.NoError(t, err)
+
+		name := testutil.GenRandomHexStr(r, 5)
+		createOutput := appRunWithOutput(r, t, app, []string{"eotsd", "tokens", "create", hFlag,
+			"--name=" + name, "--method=SignEOTS", "--method=CreateRandomnessPairList", "--btc-pk=" + keyOut.PubKeyHex})
+		var created dcli.TokenOutput
+		err = json.Unmarshal([]byte(searchInTxt(createOutput, "")), &created)
+		require.NoError(t, err)
+		require.Equal(t, name, created.Name)
+		require.NotEmpty(t, created.Token)
+		require.Equal(t, []string{"SignEOTS", "CreateRandomnessPairList"}, created.Methods)
+		require.Equal(t, []string{keyOut.PubKeyHex}, created.BtcPks)
+
+		// unknown methods are refused
+		err = app.Run([]string{"eotsd", "tokens", "create", hFlag, "--name=bad", "--method=Unknown"})
+		require.Error(t, err)
+
+		listOutput := appRunWithOutput(r, t, app, []string{"eotsd", "tokens", "list", hFlag})
+		var listed []dcli.TokenOutput
+		err = json.Unmarshal([]byte(searchInTxt(listOutput, "")), &listed)
+		require.NoError(t, err)
+		require.Len(t, listed, 1)
+		require.Equal(t, created.ID, listed[0].ID)
+		// the token itself is never shown again
+		require.Empty(t, listed[0].Token)
+
+		err = app.Run([]string{"eotsd", "tokens", "revoke", created.ID, hFlag})
+		require.NoError(t, err)
+		err = app.Run([]string{"eotsd", "tokens", "revoke", created.ID, hFlag})
+		require.Error(t, err)
+
+		listOutput = appRunWithOutput(r, t, app, []string{"eotsd", "tokens", "list", hFlag})
+		err = json.Unmarshal([]byte(searchInTxt(listOutput, "")), &listed)
+		require.NoError(t, err)
+		require.Empty(t, listed)
+	})
+}
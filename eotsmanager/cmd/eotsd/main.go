@@ -23,6 +23,11 @@ func main() {
 		dcli.ExportPoPCommand,
 	)
 	app.Commands = append(app.Commands, dcli.KeysCommands...)
+	app.Commands = append(app.Commands, dcli.TokensCommands...)
+	app.Commands = append(app.Commands, dcli.AuditCommands...)
+	app.Commands = append(app.Commands, dcli.DBCommands...)
+	app.Commands = append(app.Commands, dcli.ForensicsCommands...)
+	app.Commands = append(app.Commands, dcli.TLSCommands...)
 
 	if err := app.Run(os.Args); err != nil {
 		fatal(err)
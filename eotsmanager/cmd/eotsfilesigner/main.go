@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/urfave/cli"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/extsigner"
+)
+
+const (
+	keyFileFlag = "key-file"
+	socketFlag  = "socket"
+)
+
+func fatal(err error) {
+	fmt.Fprintf(os.Stderr, "[eotsfilesigner] %v\n", err)
+	os.Exit(1)
+}
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "eotsfilesigner"
+	app.Usage = "Reference external signer of eotsd keeping the EOTS keys unencrypted in a file, for tests only."
+	app.Flags = []cli.Flag{
+		cli.StringFlag{
+			Name:     keyFileFlag,
+			Usage:    "The path to the JSON file of the keys, which is created on the first new key",
+			Required: true,
+		},
+		cli.StringFlag{
+			Name:  socketFlag,
+			Usage: "The path to the Unix socket to listen to, or empty to serve the stdin and stdout",
+		},
+	}
+	app.Action = run
+
+	if err := app.Run(os.Args); err != nil {
+		fatal(err)
+	}
+}
+
+func run(ctx *cli.Context) error {
+	signer, err := extsigner.NewFileSigner(ctx.String(keyFileFlag))
+	if err != nil {
+		return err
+	}
+
+	socketPath := ctx.String(socketFlag)
+	if socketPath == "" {
+		return extsigner.Serve(os.Stdin, os.Stdout, signer)
+	}
+
+	l, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return fmt.Errorf("failed to listen to %s: %w", socketPath, err)
+	}
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigCh
+		l.Close()
+	}()
+
+	return extsigner.ServeListener(l, signer)
+}
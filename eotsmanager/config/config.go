@@ -2,26 +2,30 @@ package config
 
 import (
 	"fmt"
-	"net"
 	"path/filepath"
 	"strconv"
+	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/jessevdk/go-flags"
 
+	"github.com/babylonchain/finality-provider/dbbackup"
 	"github.com/babylonchain/finality-provider/metrics"
 	"github.com/babylonchain/finality-provider/util"
 )
 
 const (
-	defaultLogLevel       = "debug"
-	defaultDataDirname    = "data"
-	defaultLogDirname     = "logs"
-	defaultLogFilename    = "eotsd.log"
-	defaultConfigFileName = "eotsd.conf"
-	DefaultRPCPort        = 12582
-	defaultKeyringBackend = keyring.BackendTest
+	defaultLogLevel        = "debug"
+	defaultDataDirname     = "data"
+	defaultBackupDirname   = "backups"
+	defaultLogDirname      = "logs"
+	defaultLogFilename     = "eotsd.log"
+	defaultConfigFileName  = "eotsd.conf"
+	defaultAuditAnchorFile = "audit.anchor"
+	DefaultRPCPort         = 12582
+	defaultKeyringBackend  = keyring.BackendTest
+	defaultMaxSessionTTL   = time.Hour
 )
 
 var (
@@ -37,10 +41,22 @@ var (
 type Config struct {
 	LogLevel       string          `long:"loglevel" description:"Logging level for all subsystems" choice:"trace" choice:"debug" choice:"info" choice:"warn" choice:"error" choice:"fatal"`
 	KeyringBackend string          `long:"keyring-type" description:"Type of keyring to use"`
-	RpcListener    string          `long:"rpclistener" description:"the listener for RPC connections, e.g., 127.0.0.1:1234"`
+	RpcListener    string          `long:"rpclistener" description:"the listener for RPC connections, e.g., 127.0.0.1:1234 or unix:///path/to.sock"`
+	EnableAuth     bool            `long:"enableauth" description:"Require RPC callers to present a bearer token created by eotsd tokens create"`
+	PolicyFile     string          `long:"policyfile" description:"Path to the JSON file of the signing policies of the EOTS keys, which is reloaded on SIGHUP. No policy is enforced if empty"`
+	MaxSessionTTL  time.Duration   `long:"maxsessionttl" description:"The longest time for which UnlockKey keeps a decrypted key in memory. Unlocking keys is disabled if zero"`
+	AuditAnchor    string          `long:"auditanchor" description:"Path to the file holding the number of records and the last hash of the audit log, against which the log is verified. It should be kept apart from the database, e.g., on another volume"`
 	Metrics        *metrics.Config `group:"metrics" namespace:"metrics"`
 
 	DatabaseConfig *DBConfig `group:"dbconfig" namespace:"dbconfig"`
+
+	TLS *TLSConfig `group:"tls" namespace:"tls"`
+
+	Lease *LeaseConfig `group:"lease" namespace:"lease"`
+
+	ExternalSigner *ExternalSignerConfig `group:"externalsigner" namespace:"externalsigner"`
+
+	Backup *dbbackup.Config `group:"dbbackup" namespace:"dbbackup"`
 }
 
 // LoadConfig initializes and parses the config using a config file and command
@@ -80,8 +96,7 @@ func LoadConfig(homePath string) (*Config, error) {
 // illegal values or combination of values are set. All file system paths are
 // normalized. The cleaned up config is returned on success.
 func (cfg *Config) Validate() error {
-	_, err := net.ResolveTCPAddr("tcp", cfg.RpcListener)
-	if err != nil {
+	if err := util.ValidateRPCListener(cfg.RpcListener); err != nil {
 		return fmt.Errorf("invalid RPC listener address %s, %w", cfg.RpcListener, err)
 	}
 
@@ -89,6 +104,14 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("the keyring backend should not be empty")
 	}
 
+	if cfg.MaxSessionTTL < 0 {
+		return fmt.Errorf("the max session ttl should not be negative")
+	}
+
+	if cfg.AuditAnchor == "" {
+		return fmt.Errorf("the audit anchor path should not be empty")
+	}
+
 	if cfg.Metrics == nil {
 		return fmt.Errorf("empty metrics config")
 	}
@@ -97,6 +120,52 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("invalid metrics config")
 	}
 
+	if cfg.TLS == nil {
+		return fmt.Errorf("empty TLS config")
+	}
+
+	if err := cfg.TLS.Validate(); err != nil {
+		return fmt.Errorf("invalid TLS config: %w", err)
+	}
+
+	if cfg.Lease == nil {
+		return fmt.Errorf("empty lease config")
+	}
+
+	if err := cfg.Lease.Validate(); err != nil {
+		return fmt.Errorf("invalid lease config: %w", err)
+	}
+
+	if cfg.ExternalSigner == nil {
+		return fmt.Errorf("empty external signer config")
+	}
+
+	if err := cfg.ExternalSigner.Validate(); err != nil {
+		return fmt.Errorf("invalid external signer config: %w", err)
+	}
+
+	if cfg.Backup == nil {
+		return fmt.Errorf("empty backup config")
+	}
+
+	if err := cfg.Backup.Validate(); err != nil {
+		return fmt.Errorf("invalid backup config: %w", err)
+	}
+
+	if cfg.DatabaseConfig == nil {
+		return fmt.Errorf("empty database config")
+	}
+
+	if err := cfg.DatabaseConfig.Validate(); err != nil {
+		return fmt.Errorf("invalid database config: %w", err)
+	}
+
+	// the snapshots are copies of bolt files, while SQLite databases are
+	// backed up with the SQLite tools
+	if cfg.DatabaseConfig.IsSqlite() && cfg.Backup.Interval > 0 {
+		return fmt.Errorf("periodic backups are not supported by the sqlite backend")
+	}
+
 	return nil
 }
 
@@ -104,6 +173,10 @@ func ConfigFile(homePath string) string {
 	return filepath.Join(homePath, defaultConfigFileName)
 }
 
+func AuditAnchorFile(homePath string) string {
+	return filepath.Join(homePath, defaultAuditAnchorFile)
+}
+
 func LogDir(homePath string) string {
 	return filepath.Join(homePath, defaultLogDirname)
 }
@@ -116,6 +189,10 @@ func DataDir(homePath string) string {
 	return filepath.Join(homePath, defaultDataDirname)
 }
 
+func BackupDir(homePath string) string {
+	return filepath.Join(homePath, defaultBackupDirname)
+}
+
 func DefaultConfig() *Config {
 	return DefaultConfigWithHomePath(DefaultEOTSDir)
 }
@@ -126,7 +203,13 @@ func DefaultConfigWithHomePath(homePath string) *Config {
 		KeyringBackend: defaultKeyringBackend,
 		DatabaseConfig: DefaultDBConfigWithHomePath(homePath),
 		RpcListener:    defaultRpcListener,
+		MaxSessionTTL:  defaultMaxSessionTTL,
+		AuditAnchor:    AuditAnchorFile(homePath),
 		Metrics:        metrics.DefaultEotsConfig(),
+		TLS:            DefaultTLSConfigWithHomePath(homePath),
+		Lease:          DefaultLeaseConfigWithHomePath(homePath),
+		ExternalSigner: DefaultExternalSignerConfig(),
+		Backup:         dbbackup.DefaultConfig(BackupDir(homePath)),
 	}
 	if err := cfg.Validate(); err != nil {
 		panic(err)
@@ -1,16 +1,39 @@
 package config
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/lightningnetwork/lnd/kvdb"
+
+	"github.com/babylonchain/finality-provider/sqlitedb"
 )
 
 const (
-	defaultDbName = "eots.db"
+	defaultDbName       = "eots.db"
+	defaultSqliteDbName = "eots.sqlite"
+
+	defaultSqliteTimeout     = 30 * time.Second
+	defaultSqliteBusyTimeout = 5 * time.Second
+	// the connections are shared by all the databases of the process
+	defaultSqliteMaxConnections = 10
+	// sqliteTablePrefix is the prefix of the table holding the key-value
+	// pairs in the SQLite database
+	sqliteTablePrefix = "eots"
+
+	// BoltBackend is the name of the default bolt database backend
+	BoltBackend = "bolt"
+	// SqliteBackend is the name of the SQLite database backend
+	SqliteBackend = "sqlite"
+
+	// DBBackupPrefix is the prefix of the names of the database snapshots
+	DBBackupPrefix = "eots"
 )
 
 type DBConfig struct {
+	// Backend is the database backend, which is either bolt or sqlite.
+	Backend string `long:"backend" description:"The database backend, either bolt or sqlite. The sqlite backend requires a binary built with the kvdb_sqlite tag." choice:"bolt" choice:"sqlite"`
+
 	// DBPath is the directory path in which the database file should be
 	// stored.
 	DBPath string `long:"dbpath" description:"The directory path in which the database file should be stored."`
@@ -38,6 +61,17 @@ type DBConfig struct {
 	// DBTimeout specifies the timeout value to use when opening the wallet
 	// database.
 	DBTimeout time.Duration `long:"dbtimeout" description:"Specifies the timeout value to use when opening the wallet database."`
+
+	// SqliteFileName is the name of the SQLite database file, which is
+	// stored in DBPath as well.
+	SqliteFileName string `long:"sqlitefilename" description:"The name of the database file of the sqlite backend."`
+
+	// SqliteTimeout is the time after which an SQLite query times out.
+	SqliteTimeout time.Duration `long:"sqlitetimeout" description:"The time after which a query of the sqlite backend times out."`
+
+	// SqliteBusyTimeout is the maximum time to wait for the SQLite database
+	// to be unlocked by another connection.
+	SqliteBusyTimeout time.Duration `long:"sqlitebusytimeout" description:"The maximum time to wait for a connection of the sqlite backend to become available for a query."`
 }
 
 func DefaultDBConfig() *DBConfig {
@@ -52,9 +86,34 @@ func DefaultDBConfigWithHomePath(homePath string) *DBConfig {
 		AutoCompact:       false,
 		AutoCompactMinAge: kvdb.DefaultBoltAutoCompactMinAge,
 		DBTimeout:         kvdb.DefaultDBTimeout,
+		Backend:           BoltBackend,
+		SqliteFileName:    defaultSqliteDbName,
+		SqliteTimeout:     defaultSqliteTimeout,
+		SqliteBusyTimeout: defaultSqliteBusyTimeout,
 	}
 }
 
+// IsSqlite returns whether the database is stored by the sqlite backend
+func (db *DBConfig) IsSqlite() bool {
+	return db.Backend == SqliteBackend
+}
+
+func (db *DBConfig) Validate() error {
+	switch db.Backend {
+	// the config files written before the backend was configurable
+	// default to bolt
+	case "", BoltBackend:
+	case SqliteBackend:
+		if db.SqliteFileName == "" {
+			return fmt.Errorf("the file name of the sqlite backend should not be empty")
+		}
+	default:
+		return fmt.Errorf("unsupported database backend %s", db.Backend)
+	}
+
+	return nil
+}
+
 func (db *DBConfig) DBConfigToBoltBackendConfig() *kvdb.BoltBackendConfig {
 	return &kvdb.BoltBackendConfig{
 		DBPath:            db.DBPath,
@@ -66,6 +125,21 @@ func (db *DBConfig) DBConfigToBoltBackendConfig() *kvdb.BoltBackendConfig {
 	}
 }
 
+func (db *DBConfig) DBConfigToSqliteConfig() *sqlitedb.Config {
+	return &sqlitedb.Config{
+		DBPath:         db.DBPath,
+		DBFileName:     db.SqliteFileName,
+		TablePrefix:    sqliteTablePrefix,
+		Timeout:        db.SqliteTimeout,
+		BusyTimeout:    db.SqliteBusyTimeout,
+		MaxConnections: defaultSqliteMaxConnections,
+	}
+}
+
 func (db *DBConfig) GetDbBackend() (kvdb.Backend, error) {
+	if db.IsSqlite() {
+		return sqlitedb.Open(db.DBConfigToSqliteConfig())
+	}
+
 	return kvdb.GetBoltBackend(db.DBConfigToBoltBackendConfig())
 }
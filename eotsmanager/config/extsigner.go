@@ -0,0 +1,52 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const defaultExternalSignerTimeout = 10 * time.Second
+
+// ExternalSignerConfig defines the external signer holding the EOTS keys in
+// place of the keyring, which is reached either through a Unix socket or
+// through the stdin and stdout of a process started by eotsd
+type ExternalSignerConfig struct {
+	Enable     bool          `long:"enable" description:"Delegate the operations needing the EOTS private keys to an external signer instead of the keyring"`
+	SocketPath string        `long:"socketpath" description:"The path to the Unix socket the external signer listens to"`
+	Command    string        `long:"command" description:"The command starting the external signer, which serves the stdin and stdout, used if the socket path is empty"`
+	Timeout    time.Duration `long:"timeout" description:"The timeout of a request to the external signer"`
+}
+
+func DefaultExternalSignerConfig() *ExternalSignerConfig {
+	return &ExternalSignerConfig{
+		Enable:  false,
+		Timeout: defaultExternalSignerTimeout,
+	}
+}
+
+func (cfg *ExternalSignerConfig) Validate() error {
+	if !cfg.Enable {
+		return nil
+	}
+
+	if (cfg.SocketPath == "") == (strings.TrimSpace(cfg.Command) == "") {
+		return fmt.Errorf("exactly one of the socket path and the command of the external signer should be set")
+	}
+
+	if cfg.Timeout < 0 {
+		return fmt.Errorf("the timeout of the external signer should not be negative")
+	}
+
+	return nil
+}
+
+// CommandArgs splits the command into the program and its arguments
+func (cfg *ExternalSignerConfig) CommandArgs() (string, []string) {
+	fields := strings.Fields(cfg.Command)
+	if len(fields) == 0 {
+		return "", nil
+	}
+
+	return fields[0], fields[1:]
+}
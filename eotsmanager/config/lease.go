@@ -0,0 +1,67 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+)
+
+const (
+	defaultLeaseFilename      = "lease.db"
+	defaultLeaseTTL           = 15 * time.Second
+	defaultLeaseRenewInterval = 5 * time.Second
+	defaultLeaseSignMargin    = 5 * time.Second
+)
+
+// LeaseConfig defines the signing lease shared by redundant replicas of the
+// EOTS manager, which only serve EOTS signing requests while holding it
+type LeaseConfig struct {
+	Enable        bool          `long:"enable" description:"Only serve EOTS signing requests while holding the signing lease shared with the other replicas"`
+	Path          string        `long:"path" description:"The path to the lease database file shared by the replicas, e.g., on a network file system"`
+	HolderID      string        `long:"holderid" description:"The identifier of this replica, which must be unique among the replicas, e.g., not a host name shared by containers"`
+	TTL           time.Duration `long:"ttl" description:"The duration of the lease, after which another replica can take it over if it is not renewed"`
+	RenewInterval time.Duration `long:"renewinterval" description:"The interval of acquiring or renewing the lease, which should be well below the TTL"`
+	SignMargin    time.Duration `long:"signmargin" description:"The minimum time left on the lease to sign, which should be below the TTL minus the renew interval, e.g., half of it"`
+}
+
+func DefaultLeaseConfigWithHomePath(homePath string) *LeaseConfig {
+	return &LeaseConfig{
+		Enable:        false,
+		Path:          filepath.Join(DataDir(homePath), defaultLeaseFilename),
+		TTL:           defaultLeaseTTL,
+		RenewInterval: defaultLeaseRenewInterval,
+		SignMargin:    defaultLeaseSignMargin,
+	}
+}
+
+func (cfg *LeaseConfig) Validate() error {
+	if !cfg.Enable {
+		return nil
+	}
+
+	if cfg.Path == "" {
+		return fmt.Errorf("the lease path should not be empty")
+	}
+
+	// replicas with the same id would all hold the lease
+	if cfg.HolderID == "" {
+		return fmt.Errorf("the lease holder id should not be empty")
+	}
+
+	if cfg.TTL <= 0 {
+		return fmt.Errorf("the lease TTL should be positive")
+	}
+
+	if cfg.RenewInterval <= 0 || cfg.RenewInterval >= cfg.TTL {
+		return fmt.Errorf("the lease renew interval should be positive and below the TTL %v", cfg.TTL)
+	}
+
+	// the lease is renewed with a full TTL, and has at least the TTL minus
+	// the renew interval left in between, unless a renewal fails
+	if cfg.SignMargin <= 0 || cfg.SignMargin >= cfg.TTL-cfg.RenewInterval {
+		return fmt.Errorf("the lease sign margin should be positive and below the TTL minus the renew interval %v",
+			cfg.TTL-cfg.RenewInterval)
+	}
+
+	return nil
+}
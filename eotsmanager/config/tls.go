@@ -0,0 +1,53 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+const (
+	defaultTLSCertFilename = "tls.cert"
+	defaultTLSKeyFilename  = "tls.key"
+)
+
+var (
+	// DefaultTLSCertPath is the path to the TLS certificate of the RPC server
+	// in the default EOTS home directory
+	DefaultTLSCertPath = TLSCertFile(DefaultEOTSDir)
+)
+
+// TLSConfig defines the TLS settings of the RPC server
+type TLSConfig struct {
+	Disable      bool   `long:"disable" description:"Serve RPC requests over plaintext TCP as before TLS became the default, which should only be used if the EOTS manager is not reachable from other hosts; the finality-provider daemons should then disable TLS as well"`
+	CertPath     string `long:"certpath" description:"The path to the TLS certificate of the RPC server"`
+	KeyPath      string `long:"keypath" description:"The path to the TLS key of the RPC server"`
+	ClientCAPath string `long:"clientcapath" description:"The path to the CA certificate that client certificates must be signed by; mutual TLS is enforced if set"`
+}
+
+func DefaultTLSConfigWithHomePath(homePath string) *TLSConfig {
+	return &TLSConfig{
+		Disable:  false,
+		CertPath: TLSCertFile(homePath),
+		KeyPath:  TLSKeyFile(homePath),
+	}
+}
+
+func (cfg *TLSConfig) Validate() error {
+	if cfg.Disable {
+		return nil
+	}
+
+	if cfg.CertPath == "" || cfg.KeyPath == "" {
+		return fmt.Errorf("the TLS certificate and key paths should not be empty unless TLS is disabled")
+	}
+
+	return nil
+}
+
+func TLSCertFile(homePath string) string {
+	return filepath.Join(homePath, defaultTLSCertFilename)
+}
+
+func TLSKeyFile(homePath string) string {
+	return filepath.Join(homePath, defaultTLSKeyFilename)
+}
@@ -29,12 +29,24 @@ type EOTSManager interface {
 	// secret randomness of the given chain at the given height
 	// It fails if the finality provider does not exist or there's no randomness committed to the given height
 	// or passPhrase is incorrect
+	// It fails with ErrDoubleSign if a different message has been signed at the same height, while
+	// signing the same message again returns the same signature
 	SignEOTS(uid []byte, chainID []byte, msg []byte, height uint64, passphrase string) (*btcec.ModNScalar, error)
 
+	// SignEOTSBatch signs the messages at their heights as SignEOTS does, decrypting the key only once,
+	// and returns the signatures in the same order as the requests
+	// It fails with ErrDoubleSign without signing any message if any of the heights has been signed
+	// with a different message, or if the requests contain different messages at the same height
+	SignEOTSBatch(uid []byte, chainID []byte, reqs []*types.EOTSSignRequest, passphrase string) ([]*btcec.ModNScalar, error)
+
 	// SignSchnorrSig signs a Schnorr signature using the private key of the finality provider
 	// It fails if the finality provider does not exist or the message size is not 32 bytes
 	// or passPhrase is incorrect
 	SignSchnorrSig(uid []byte, msg []byte, passphrase string) (*schnorr.Signature, error)
 
+	// ListKeys returns the public information of all the EOTS keys, including the
+	// chains for which randomness has been generated
+	ListKeys() ([]*types.KeyInfo, error)
+
 	Close() error
 }
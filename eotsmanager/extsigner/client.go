@@ -0,0 +1,329 @@
+package extsigner
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/types"
+)
+
+// maxLineSize bounds the size of a response line
+const maxLineSize = 16 * 1024 * 1024
+
+var _ Signer = &Client{}
+
+// Client sends the requests of the EOTS manager to an external signer. It is
+// a key backend of the EOTS manager which never sees the private keys. The
+// connection is re-established on the next request after an I/O failure
+type Client struct {
+	dial    func() (io.ReadWriteCloser, error)
+	timeout time.Duration
+
+	mu     sync.Mutex
+	conn   io.ReadWriteCloser
+	reader *bufio.Reader
+	nextID uint64
+}
+
+// NewSocketClient creates a client of the signer listening on the Unix socket
+// at the given path. A zero timeout means no timeout
+func NewSocketClient(socketPath string, timeout time.Duration) *Client {
+	return &Client{
+		dial: func() (io.ReadWriteCloser, error) {
+			return net.DialTimeout("unix", socketPath, dialTimeout(timeout))
+		},
+		timeout: timeout,
+	}
+}
+
+// NewProcessClient creates a client of the signer started by the given
+// command, which speaks the protocol over its stdin and stdout. The process
+// is started on the first request and restarted after it fails. Its stderr is
+// passed through
+func NewProcessClient(command string, args []string, timeout time.Duration) *Client {
+	return &Client{
+		dial: func() (io.ReadWriteCloser, error) {
+			return startProcess(command, args)
+		},
+		timeout: timeout,
+	}
+}
+
+func (c *Client) CreateKey(name, passphrase string) (*btcec.PublicKey, error) {
+	resp, err := c.call(&Request{Method: MethodCreateKey, KeyName: name, Passphrase: passphrase})
+	if err != nil {
+		return nil, err
+	}
+
+	return schnorr.ParsePubKey(resp.PubKey)
+}
+
+func (c *Client) PubKey(name string) (*btcec.PublicKey, error) {
+	resp, err := c.call(&Request{Method: MethodPubKey, KeyName: name})
+	if err != nil {
+		return nil, err
+	}
+
+	return schnorr.ParsePubKey(resp.PubKey)
+}
+
+func (c *Client) PubRandList(name, passphrase string, chainID []byte, startHeight uint64, num uint32) ([]*btcec.FieldVal, error) {
+	resp, err := c.call(&Request{
+		Method:      MethodPubRandList,
+		KeyName:     name,
+		Passphrase:  passphrase,
+		ChainID:     chainID,
+		StartHeight: startHeight,
+		Num:         num,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	prList := make([]*btcec.FieldVal, 0, len(resp.PubRands))
+	for _, b := range resp.PubRands {
+		if len(b) != 32 {
+			return nil, fmt.Errorf("invalid public randomness of %d bytes from the external signer", len(b))
+		}
+		var pubRand btcec.FieldVal
+		if overflow := pubRand.SetByteSlice(b); overflow {
+			return nil, fmt.Errorf("public randomness %x from the external signer overflows", []byte(b))
+		}
+		prList = append(prList, &pubRand)
+	}
+
+	return prList, nil
+}
+
+func (c *Client) SignEOTS(name, passphrase string, chainID []byte, reqs []*types.EOTSSignRequest) ([]*btcec.ModNScalar, error) {
+	items := make([]SignItem, 0, len(reqs))
+	for _, r := range reqs {
+		items = append(items, SignItem{Height: r.Height, Msg: r.Msg})
+	}
+
+	resp, err := c.call(&Request{
+		Method:     MethodSignEOTS,
+		KeyName:    name,
+		Passphrase: passphrase,
+		ChainID:    chainID,
+		Items:      items,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sigs := make([]*btcec.ModNScalar, 0, len(resp.Sigs))
+	for _, b := range resp.Sigs {
+		if len(b) != 32 {
+			return nil, fmt.Errorf("invalid EOTS signature of %d bytes from the external signer", len(b))
+		}
+		var sig btcec.ModNScalar
+		if overflow := sig.SetByteSlice(b); overflow {
+			return nil, fmt.Errorf("EOTS signature %x from the external signer overflows", []byte(b))
+		}
+		sigs = append(sigs, &sig)
+	}
+
+	return sigs, nil
+}
+
+func (c *Client) SignSchnorr(name, passphrase string, msg []byte) (*schnorr.Signature, error) {
+	resp, err := c.call(&Request{Method: MethodSignSchnorr, KeyName: name, Passphrase: passphrase, Msg: msg})
+	if err != nil {
+		return nil, err
+	}
+
+	return schnorr.ParseSignature(resp.Sig)
+}
+
+// Close closes the connection to the signer, which stops a signer process
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.closeConnLocked()
+}
+
+// call sends the request and waits for its response. The connection is
+// dropped on any failure other than an error returned by the signer, as the
+// stream may be left in an unknown state
+func (c *Client) call(req *Request) (*Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn == nil {
+		conn, err := c.dial()
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to the external signer: %w", err)
+		}
+		c.conn = conn
+		c.reader = bufio.NewReaderSize(conn, 4096)
+	}
+
+	c.nextID++
+	req.ID = c.nextID
+
+	resp, err := c.roundTrip(req)
+	if err != nil {
+		_ = c.closeConnLocked()
+		return nil, fmt.Errorf("failed to call %s on the external signer: %w", req.Method, err)
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("%w: %s: %s", ErrSigner, req.Method, resp.Error)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) roundTrip(req *Request) (*Response, error) {
+	if d, ok := c.conn.(interface{ SetDeadline(time.Time) error }); ok && c.timeout > 0 {
+		if err := d.SetDeadline(time.Now().Add(c.timeout)); err != nil {
+			return nil, err
+		}
+	}
+
+	reqBytes, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := c.conn.Write(append(reqBytes, '\n')); err != nil {
+		return nil, err
+	}
+
+	line, err := readLine(c.reader)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp Response
+	if err := json.Unmarshal(line, &resp); err != nil {
+		return nil, fmt.Errorf("invalid response: %w", err)
+	}
+	if resp.ID != req.ID {
+		return nil, fmt.Errorf("got the response of request %d while waiting for request %d", resp.ID, req.ID)
+	}
+
+	return &resp, nil
+}
+
+func (c *Client) closeConnLocked() error {
+	if c.conn == nil {
+		return nil
+	}
+
+	err := c.conn.Close()
+	c.conn = nil
+	c.reader = nil
+
+	return err
+}
+
+// readLine reads a line of at most maxLineSize bytes without its line feed
+func readLine(r *bufio.Reader) ([]byte, error) {
+	var line []byte
+	for {
+		chunk, isPrefix, err := r.ReadLine()
+		if err != nil {
+			return nil, err
+		}
+		line = append(line, chunk...)
+		if len(line) > maxLineSize {
+			return nil, fmt.Errorf("the line exceeds %d bytes", maxLineSize)
+		}
+		if !isPrefix {
+			return line, nil
+		}
+	}
+}
+
+func dialTimeout(timeout time.Duration) time.Duration {
+	if timeout > 0 {
+		return timeout
+	}
+
+	return 10 * time.Second
+}
+
+// process is the stdin and stdout of a signer process
+type process struct {
+	cmd    *exec.Cmd
+	stdin  *os.File
+	stdout *os.File
+}
+
+func startProcess(command string, args []string) (*process, error) {
+	stdinR, stdinW, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	stdoutR, stdoutW, err := os.Pipe()
+	if err != nil {
+		stdinR.Close()
+		stdinW.Close()
+		return nil, err
+	}
+
+	cmd := exec.Command(command, args...)
+	cmd.Stdin = stdinR
+	cmd.Stdout = stdoutW
+	cmd.Stderr = os.Stderr
+	err = cmd.Start()
+	// the ends of the child are kept by the child only
+	stdinR.Close()
+	stdoutW.Close()
+	if err != nil {
+		stdinW.Close()
+		stdoutR.Close()
+		return nil, fmt.Errorf("failed to start the external signer %s: %w", command, err)
+	}
+
+	return &process{cmd: cmd, stdin: stdinW, stdout: stdoutR}, nil
+}
+
+func (p *process) Read(b []byte) (int, error) {
+	return p.stdout.Read(b)
+}
+
+func (p *process) Write(b []byte) (int, error) {
+	return p.stdin.Write(b)
+}
+
+func (p *process) SetDeadline(t time.Time) error {
+	if err := p.stdin.SetDeadline(t); err != nil {
+		return err
+	}
+
+	return p.stdout.SetDeadline(t)
+}
+
+// Close closes the stdin of the process, which should make it exit, and
+// kills it if it does not exit in time
+func (p *process) Close() error {
+	p.stdin.Close()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- p.cmd.Wait()
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-time.After(5 * time.Second):
+		_ = p.cmd.Process.Kill()
+		err = <-done
+	}
+	p.stdout.Close()
+
+	return err
+}
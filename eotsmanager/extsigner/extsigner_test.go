@@ -0,0 +1,147 @@
+package extsigner_test
+
+import (
+	"math/rand"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/babylonchain/babylon/crypto/eots"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/extsigner"
+	"github.com/babylonchain/finality-provider/eotsmanager/types"
+	"github.com/babylonchain/finality-provider/testutil"
+)
+
+// signerKeyFileEnv makes the test binary serve the file signer over its
+// stdin and stdout, so that it can be started as a signer process
+const signerKeyFileEnv = "EXTSIGNER_TEST_KEY_FILE"
+
+func TestMain(m *testing.M) {
+	if keyFile := os.Getenv(signerKeyFileEnv); keyFile != "" {
+		signer, err := extsigner.NewFileSigner(keyFile)
+		if err != nil {
+			os.Exit(1)
+		}
+		if err := extsigner.Serve(os.Stdin, os.Stdout, signer); err != nil {
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	os.Exit(m.Run())
+}
+
+// FuzzSocketClient tests the operations of a file signer served over a Unix socket
+func FuzzSocketClient(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		dir := t.TempDir()
+		signer, err := extsigner.NewFileSigner(filepath.Join(dir, "keys.json"))
+		require.NoError(t, err)
+
+		// Unix socket paths are limited to around 100 bytes
+		socketDir, err := os.MkdirTemp("", "signer")
+		require.NoError(t, err)
+		defer os.RemoveAll(socketDir)
+		l, err := net.Listen("unix", filepath.Join(socketDir, "s.sock"))
+		require.NoError(t, err)
+		go func() {
+			_ = extsigner.ServeListener(l, signer)
+		}()
+		defer l.Close()
+
+		client := extsigner.NewSocketClient(l.Addr().String(), 5*time.Second)
+		defer client.Close()
+
+		keyName := testutil.GenRandomHexStr(r, 4)
+		pk, err := client.CreateKey(keyName, "")
+		require.NoError(t, err)
+		_, err = client.CreateKey(keyName, "")
+		require.ErrorIs(t, err, extsigner.ErrSigner)
+
+		loadedPk, err := client.PubKey(keyName)
+		require.NoError(t, err)
+		require.True(t, pk.IsEqual(loadedPk))
+		_, err = client.PubKey(keyName + "x")
+		require.ErrorIs(t, err, extsigner.ErrSigner)
+
+		chainID := datagen.GenRandomByteArray(r, 10)
+		startHeight := r.Uint64() % 1000
+		num := r.Uint32()%10 + 1
+		prList, err := client.PubRandList(keyName, "", chainID, startHeight, num)
+		require.NoError(t, err)
+		require.Len(t, prList, int(num))
+		expectedPrList, err := signer.PubRandList(keyName, "", chainID, startHeight, num)
+		require.NoError(t, err)
+		require.Equal(t, expectedPrList, prList)
+
+		reqs := make([]*types.EOTSSignRequest, 0, num)
+		for i := uint32(0); i < num; i++ {
+			reqs = append(reqs, &types.EOTSSignRequest{
+				Height: startHeight + uint64(i),
+				Msg:    datagen.GenRandomByteArray(r, 32),
+			})
+		}
+		sigs, err := client.SignEOTS(keyName, "", chainID, reqs)
+		require.NoError(t, err)
+		require.Len(t, sigs, len(reqs))
+		for i, req := range reqs {
+			require.NoError(t, eots.Verify(pk, prList[i], req.Msg, sigs[i]))
+		}
+
+		msg := datagen.GenRandomByteArray(r, 32)
+		sig, err := client.SignSchnorr(keyName, "", msg)
+		require.NoError(t, err)
+		require.True(t, sig.Verify(msg, pk))
+
+		// the client connects again on the next request after being closed
+		require.NoError(t, client.Close())
+		_, err = client.PubKey(keyName)
+		require.NoError(t, err)
+
+		// the keys are kept across restarts of the signer
+		restarted, err := extsigner.NewFileSigner(filepath.Join(dir, "keys.json"))
+		require.NoError(t, err)
+		restartedPk, err := restarted.PubKey(keyName)
+		require.NoError(t, err)
+		require.Equal(t, schnorr.SerializePubKey(pk), schnorr.SerializePubKey(restartedPk))
+	})
+}
+
+// TestProcessClient tests the operations of a file signer started as a
+// process serving its stdin and stdout
+func TestProcessClient(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	keyFile := filepath.Join(t.TempDir(), "keys.json")
+	t.Setenv(signerKeyFileEnv, keyFile)
+
+	client := extsigner.NewProcessClient(os.Args[0], nil, 5*time.Second)
+
+	pk, err := client.CreateKey("fp", "")
+	require.NoError(t, err)
+
+	chainID := datagen.GenRandomByteArray(r, 10)
+	prList, err := client.PubRandList("fp", "", chainID, 100, 1)
+	require.NoError(t, err)
+	msg := datagen.GenRandomByteArray(r, 32)
+	sigs, err := client.SignEOTS("fp", "", chainID, []*types.EOTSSignRequest{{Height: 100, Msg: msg}})
+	require.NoError(t, err)
+	require.NoError(t, eots.Verify(pk, prList[0], msg, sigs[0]))
+
+	// closing the client stops the process, which is started again by
+	// the next request with the same keys
+	require.NoError(t, client.Close())
+	loadedPk, err := client.PubKey("fp")
+	require.NoError(t, err)
+	require.True(t, pk.IsEqual(loadedPk))
+	require.NoError(t, client.Close())
+}
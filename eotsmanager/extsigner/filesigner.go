@@ -0,0 +1,164 @@
+package extsigner
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/babylonchain/babylon/crypto/eots"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/randgenerator"
+	"github.com/babylonchain/finality-provider/eotsmanager/types"
+)
+
+var _ Signer = &FileSigner{}
+
+// FileSigner is the reference signer, which keeps the private keys unencrypted
+// in a JSON file mapping the key names to the hex of the keys. It ignores the
+// passphrases and is meant for tests and as an example for real signers
+type FileSigner struct {
+	path string
+
+	mu   sync.Mutex
+	keys map[string]*btcec.PrivateKey
+}
+
+// NewFileSigner loads the keys in the file at the given path, which is
+// created on the first new key if it does not exist
+func NewFileSigner(path string) (*FileSigner, error) {
+	s := &FileSigner{
+		path: path,
+		keys: make(map[string]*btcec.PrivateKey),
+	}
+
+	keysBytes, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the key file %s: %w", path, err)
+	}
+
+	var keyHexes map[string]string
+	if err := json.Unmarshal(keysBytes, &keyHexes); err != nil {
+		return nil, fmt.Errorf("failed to parse the key file %s: %w", path, err)
+	}
+	for name, keyHex := range keyHexes {
+		keyBytes, err := hex.DecodeString(keyHex)
+		if err != nil || len(keyBytes) != btcec.PrivKeyBytesLen {
+			return nil, fmt.Errorf("invalid private key %s in the key file %s", name, path)
+		}
+		s.keys[name], _ = btcec.PrivKeyFromBytes(keyBytes)
+	}
+
+	return s, nil
+}
+
+func (s *FileSigner) CreateKey(name, _ string) (*btcec.PublicKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.keys[name]; ok {
+		return nil, fmt.Errorf("the key %s already exists", name)
+	}
+
+	privKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	s.keys[name] = privKey
+
+	if err := s.saveLocked(); err != nil {
+		delete(s.keys, name)
+		return nil, err
+	}
+
+	return privKey.PubKey(), nil
+}
+
+func (s *FileSigner) PubKey(name string) (*btcec.PublicKey, error) {
+	privKey, err := s.privKey(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return privKey.PubKey(), nil
+}
+
+func (s *FileSigner) PubRandList(name, _ string, chainID []byte, startHeight uint64, num uint32) ([]*btcec.FieldVal, error) {
+	privKey, err := s.privKey(name)
+	if err != nil {
+		return nil, err
+	}
+
+	prList := make([]*btcec.FieldVal, 0, num)
+	for i := uint32(0); i < num; i++ {
+		_, pubRand := randgenerator.GenerateRandomness(privKey.Serialize(), chainID, startHeight+uint64(i))
+		prList = append(prList, pubRand)
+	}
+
+	return prList, nil
+}
+
+func (s *FileSigner) SignEOTS(name, _ string, chainID []byte, reqs []*types.EOTSSignRequest) ([]*btcec.ModNScalar, error) {
+	privKey, err := s.privKey(name)
+	if err != nil {
+		return nil, err
+	}
+
+	sigs := make([]*btcec.ModNScalar, 0, len(reqs))
+	for _, req := range reqs {
+		privRand, _ := randgenerator.GenerateRandomness(privKey.Serialize(), chainID, req.Height)
+		sig, err := eots.Sign(privKey, privRand, req.Msg)
+		if err != nil {
+			return nil, err
+		}
+		sigs = append(sigs, sig)
+	}
+
+	return sigs, nil
+}
+
+func (s *FileSigner) SignSchnorr(name, _ string, msg []byte) (*schnorr.Signature, error) {
+	privKey, err := s.privKey(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return schnorr.Sign(privKey, msg)
+}
+
+func (s *FileSigner) privKey(name string) (*btcec.PrivateKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	privKey, ok := s.keys[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown key %s", name)
+	}
+
+	return privKey, nil
+}
+
+func (s *FileSigner) saveLocked() error {
+	keyHexes := make(map[string]string, len(s.keys))
+	for name, privKey := range s.keys {
+		keyHexes[name] = hex.EncodeToString(privKey.Serialize())
+	}
+
+	keysBytes, err := json.MarshalIndent(keyHexes, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(s.path, keysBytes, 0600); err != nil {
+		return fmt.Errorf("failed to write the key file %s: %w", s.path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+// Package extsigner implements the protocol between the EOTS manager and an
+// external signer process holding the EOTS private keys, so that the keys
+// never enter the memory of the EOTS manager.
+//
+// The protocol runs over a stream, either a Unix socket served by the signer
+// or the stdin and stdout of a signer process started by the EOTS manager.
+// Each request and each response is a JSON object on a single line. Requests
+// are sent one at a time and each of them is answered by a response carrying
+// the same id before the next request is sent. Byte strings are hex encoded.
+//
+// A request has the following fields, of which only the ones used by the
+// method are set:
+//
+//	{"id": 1, "method": "sign_eots", "key_name": "fp-key", "passphrase": "",
+//	 "chain_id": "6262..", "start_height": 0, "num": 0,
+//	 "items": [{"height": 100, "msg": "ab.."}], "msg": ""}
+//
+// The methods are
+//
+//   - create_key(key_name, passphrase) -> pub_key: generates a new key
+//   - pub_key(key_name) -> pub_key: returns the public key of a key
+//   - pub_rand_list(key_name, passphrase, chain_id, start_height, num) ->
+//     pub_rands: returns the public randomness of num consecutive heights
+//   - sign_eots(key_name, passphrase, chain_id, items) -> sigs: signs each
+//     message at its height by EOTS
+//   - sign_schnorr(key_name, passphrase, msg) -> sig: signs the message by
+//     BIP-340
+//
+// A response carries the id of the request and either the result of the
+// method or a non-empty error:
+//
+//	{"id": 1, "sigs": ["01ab.."]}
+//	{"id": 1, "error": "unknown key fp-key"}
+//
+// Public keys are 32-byte BIP-340 public keys, public randomness are 32-byte
+// x coordinates, EOTS signatures are 32-byte scalars and Schnorr signatures
+// are 64-byte BIP-340 signatures. The randomness of a height must be derived
+// from the private key as randgenerator.GenerateRandomness does, so that keys
+// can be moved between the signer and the keyring of the EOTS manager.
+package extsigner
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+)
+
+const (
+	MethodCreateKey   = "create_key"
+	MethodPubKey      = "pub_key"
+	MethodPubRandList = "pub_rand_list"
+	MethodSignEOTS    = "sign_eots"
+	MethodSignSchnorr = "sign_schnorr"
+)
+
+// ErrSigner The external signer refused or failed to serve a request
+var ErrSigner = errors.New("the external signer returned an error")
+
+// Request is a request to the external signer
+type Request struct {
+	ID          uint64     `json:"id"`
+	Method      string     `json:"method"`
+	KeyName     string     `json:"key_name"`
+	Passphrase  string     `json:"passphrase,omitempty"`
+	ChainID     HexBytes   `json:"chain_id,omitempty"`
+	StartHeight uint64     `json:"start_height,omitempty"`
+	Num         uint32     `json:"num,omitempty"`
+	Items       []SignItem `json:"items,omitempty"`
+	Msg         HexBytes   `json:"msg,omitempty"`
+}
+
+// SignItem is a message to be signed by EOTS at the given height
+type SignItem struct {
+	Height uint64   `json:"height"`
+	Msg    HexBytes `json:"msg"`
+}
+
+// Response is the response of the external signer to a request
+type Response struct {
+	ID       uint64     `json:"id"`
+	Error    string     `json:"error,omitempty"`
+	PubKey   HexBytes   `json:"pub_key,omitempty"`
+	PubRands []HexBytes `json:"pub_rands,omitempty"`
+	Sigs     []HexBytes `json:"sigs,omitempty"`
+	Sig      HexBytes   `json:"sig,omitempty"`
+}
+
+// HexBytes is a byte string encoded in hex in JSON
+type HexBytes []byte
+
+func (b HexBytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hex.EncodeToString(b))
+}
+
+func (b *HexBytes) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	decoded, err := hex.DecodeString(s)
+	if err != nil {
+		return err
+	}
+	*b = decoded
+
+	return nil
+}
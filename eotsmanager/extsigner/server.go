@@ -0,0 +1,125 @@
+package extsigner
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/types"
+)
+
+// Signer holds the EOTS private keys served by the protocol. It has the same
+// methods as the key backend of the EOTS manager
+type Signer interface {
+	CreateKey(name, passphrase string) (*btcec.PublicKey, error)
+	PubKey(name string) (*btcec.PublicKey, error)
+	PubRandList(name, passphrase string, chainID []byte, startHeight uint64, num uint32) ([]*btcec.FieldVal, error)
+	SignEOTS(name, passphrase string, chainID []byte, reqs []*types.EOTSSignRequest) ([]*btcec.ModNScalar, error)
+	SignSchnorr(name, passphrase string, msg []byte) (*schnorr.Signature, error)
+}
+
+// Serve answers the requests read from r with the signer and writes the
+// responses to w until r is exhausted. Errors of the signer are sent back in
+// the responses, while malformed requests end the stream
+func Serve(r io.Reader, w io.Writer, s Signer) error {
+	reader := bufio.NewReader(r)
+	for {
+		line, err := readLine(reader)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var req Request
+		if err := json.Unmarshal(line, &req); err != nil {
+			return fmt.Errorf("invalid request: %w", err)
+		}
+
+		resp := handle(s, &req)
+		resp.ID = req.ID
+		respBytes, err := json.Marshal(resp)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(append(respBytes, '\n')); err != nil {
+			return err
+		}
+	}
+}
+
+// ServeListener serves each connection accepted by the listener with the
+// signer until the listener is closed
+func ServeListener(l net.Listener, s Signer) error {
+	for {
+		conn, err := l.Accept()
+		if errors.Is(err, net.ErrClosed) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		go func() {
+			defer conn.Close()
+			_ = Serve(conn, conn, s)
+		}()
+	}
+}
+
+func handle(s Signer, req *Request) *Response {
+	var (
+		resp Response
+		err  error
+	)
+	switch req.Method {
+	case MethodCreateKey:
+		var pk *btcec.PublicKey
+		if pk, err = s.CreateKey(req.KeyName, req.Passphrase); err == nil {
+			resp.PubKey = schnorr.SerializePubKey(pk)
+		}
+	case MethodPubKey:
+		var pk *btcec.PublicKey
+		if pk, err = s.PubKey(req.KeyName); err == nil {
+			resp.PubKey = schnorr.SerializePubKey(pk)
+		}
+	case MethodPubRandList:
+		var prList []*btcec.FieldVal
+		prList, err = s.PubRandList(req.KeyName, req.Passphrase, req.ChainID, req.StartHeight, req.Num)
+		for _, pr := range prList {
+			prBytes := pr.Bytes()
+			resp.PubRands = append(resp.PubRands, prBytes[:])
+		}
+	case MethodSignEOTS:
+		reqs := make([]*types.EOTSSignRequest, 0, len(req.Items))
+		for _, item := range req.Items {
+			reqs = append(reqs, &types.EOTSSignRequest{Height: item.Height, Msg: item.Msg})
+		}
+		var sigs []*btcec.ModNScalar
+		sigs, err = s.SignEOTS(req.KeyName, req.Passphrase, req.ChainID, reqs)
+		for _, sig := range sigs {
+			sigBytes := sig.Bytes()
+			resp.Sigs = append(resp.Sigs, sigBytes[:])
+		}
+	case MethodSignSchnorr:
+		var sig *schnorr.Signature
+		if sig, err = s.SignSchnorr(req.KeyName, req.Passphrase, req.Msg); err == nil {
+			resp.Sig = sig.Serialize()
+		}
+	default:
+		err = fmt.Errorf("unknown method %q", req.Method)
+	}
+
+	if err != nil {
+		return &Response{Error: err.Error()}
+	}
+
+	return &resp
+}
@@ -0,0 +1,89 @@
+// Package forensics extracts the secret key of a finality provider which
+// signed two different messages with the same public randomness, which is
+// what makes EOTS signatures extractable and equivocation slashable.
+package forensics
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/babylonchain/babylon/crypto/eots"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+var (
+	// ErrSameMessage The two signatures are over the same message, so they
+	// are not conflicting
+	ErrSameMessage = errors.New("the two signatures are over the same message")
+
+	// ErrInvalidSignature A signature does not verify against the public key
+	// and public randomness
+	ErrInvalidSignature = errors.New("invalid EOTS signature")
+)
+
+// Evidence holds two EOTS signatures of the same public key over different
+// messages with the same public randomness
+type Evidence struct {
+	PubKey  *btcec.PublicKey
+	PubRand *btcec.FieldVal
+	Msg1    []byte
+	Sig1    *btcec.ModNScalar
+	Msg2    []byte
+	Sig2    *btcec.ModNScalar
+}
+
+// FinalityVoteMsg returns the message signed by a finality provider to vote
+// for the block of the given height and hash
+func FinalityVoteMsg(height uint64, blockHash []byte) []byte {
+	return append(sdk.Uint64ToBigEndian(height), blockHash...)
+}
+
+// Verify checks that the messages differ and that both signatures verify
+// against the public key and public randomness
+func (e *Evidence) Verify() error {
+	if e.PubKey == nil || e.PubRand == nil || e.Sig1 == nil || e.Sig2 == nil {
+		return fmt.Errorf("incomplete evidence")
+	}
+	if bytes.Equal(e.Msg1, e.Msg2) {
+		return ErrSameMessage
+	}
+
+	if err := eots.Verify(e.PubKey, e.PubRand, e.Msg1, e.Sig1); err != nil {
+		return fmt.Errorf("%w: the first signature: %v", ErrInvalidSignature, err)
+	}
+	if err := eots.Verify(e.PubKey, e.PubRand, e.Msg2, e.Sig2); err != nil {
+		return fmt.Errorf("%w: the second signature: %v", ErrInvalidSignature, err)
+	}
+
+	return nil
+}
+
+// ExtractSK verifies the evidence and extracts the secret key from it. The
+// key is returned in its BIP-340 form, i.e., with the public key of even Y
+// coordinate, so the key in the keyring may be its negation
+func (e *Evidence) ExtractSK() (*btcec.PrivateKey, error) {
+	if err := e.Verify(); err != nil {
+		return nil, err
+	}
+
+	sk, err := eots.Extract(e.PubKey, e.PubRand, e.Msg1, e.Sig1, e.Msg2, e.Sig2)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract the secret key: %w", err)
+	}
+
+	if sk.PubKey().SerializeCompressed()[0] == secp256k1.PubKeyFormatCompressedOdd {
+		var negated btcec.ModNScalar
+		negated.Set(&sk.Key).Negate()
+		sk = btcec.PrivKeyFromScalar(&negated)
+	}
+
+	if !bytes.Equal(schnorr.SerializePubKey(sk.PubKey()), schnorr.SerializePubKey(e.PubKey)) {
+		return nil, fmt.Errorf("the extracted secret key does not match the public key")
+	}
+
+	return sk, nil
+}
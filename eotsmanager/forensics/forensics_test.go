@@ -0,0 +1,62 @@
+package forensics_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/babylonchain/babylon/crypto/eots"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/forensics"
+	"github.com/babylonchain/finality-provider/eotsmanager/randgenerator"
+	"github.com/babylonchain/finality-provider/testutil"
+)
+
+// FuzzExtractSK tests that the secret key is extracted from two conflicting
+// finality votes, and that invalid evidence is rejected
+func FuzzExtractSK(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		sk, pk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		height := r.Uint64()
+		privRand, pubRand := randgenerator.GenerateRandomness(testutil.GenRandomByteArray(r, 32), []byte("chain-test"), height)
+
+		msg1 := forensics.FinalityVoteMsg(height, testutil.GenRandomByteArray(r, 32))
+		msg2 := forensics.FinalityVoteMsg(height, testutil.GenRandomByteArray(r, 32))
+		sig1, err := eots.Sign(sk, privRand, msg1)
+		require.NoError(t, err)
+		sig2, err := eots.Sign(sk, privRand, msg2)
+		require.NoError(t, err)
+
+		evidence := &forensics.Evidence{
+			PubKey:  pk,
+			PubRand: pubRand,
+			Msg1:    msg1,
+			Sig1:    sig1,
+			Msg2:    msg2,
+			Sig2:    sig2,
+		}
+		extracted, err := evidence.ExtractSK()
+		require.NoError(t, err)
+		require.Equal(t, schnorr.SerializePubKey(pk), schnorr.SerializePubKey(extracted.PubKey()))
+		// the BIP-340 form of the key is returned
+		require.True(t, extracted.Key.Equals(&sk.Key) || extracted.Key.Equals(new(eots.PrivateRand).Set(&sk.Key).Negate()))
+
+		// the same message twice
+		sameMsg := *evidence
+		sameMsg.Msg2, sameMsg.Sig2 = msg1, sig1
+		_, err = sameMsg.ExtractSK()
+		require.ErrorIs(t, err, forensics.ErrSameMessage)
+
+		// a signature over another message
+		invalidSig := *evidence
+		invalidSig.Msg2 = forensics.FinalityVoteMsg(height+1, testutil.GenRandomByteArray(r, 32))
+		_, err = invalidSig.ExtractSK()
+		require.ErrorIs(t, err, forensics.ErrInvalidSignature)
+	})
+}
@@ -0,0 +1,95 @@
+package eotsmanager
+
+import (
+	"errors"
+
+	"github.com/babylonchain/babylon/crypto/eots"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/randgenerator"
+	"github.com/babylonchain/finality-provider/eotsmanager/types"
+)
+
+// ErrPrivKeyNotExposed The key backend keeps the private keys to itself, so
+// the operations handling the private keys themselves are not supported
+var ErrPrivKeyNotExposed = errors.New("the private keys are not exposed by the key backend")
+
+// ErrInvalidEOTSSig The key backend returned an EOTS signature which does not
+// verify against the public key and the public randomness of its height
+var ErrInvalidEOTSSig = errors.New("the key backend returned an invalid EOTS signature")
+
+// KeyBackend holds the EOTS private keys by name and performs the operations
+// needing them, so that a backend such as an external signer can keep the keys
+// out of the memory of the EOTS manager. The randomness of a height must be
+// derived by randgenerator.GenerateRandomness from the private key, so that a
+// key produces the same randomness whichever backend holds it
+type KeyBackend interface {
+	// CreateKey generates a new key under the given name and returns its
+	// public key. It fails if the name is taken
+	CreateKey(name, passphrase string) (*btcec.PublicKey, error)
+
+	// PubKey returns the public key of the key with the given name
+	PubKey(name string) (*btcec.PublicKey, error)
+
+	// PubRandList returns the public randomness of the key for the given chain
+	// from startHeight to startHeight+(num-1)
+	PubRandList(name, passphrase string, chainID []byte, startHeight uint64, num uint32) ([]*btcec.FieldVal, error)
+
+	// SignEOTS signs the messages at their heights with the key and the
+	// randomness of the heights for the given chain, and returns the
+	// signatures in the same order as the requests. The anti-slashing
+	// protection is enforced by the EOTS manager before calling it
+	SignEOTS(name, passphrase string, chainID []byte, reqs []*types.EOTSSignRequest) ([]*btcec.ModNScalar, error)
+
+	// SignSchnorr signs the message with the key by BIP-340
+	SignSchnorr(name, passphrase string, msg []byte) (*schnorr.Signature, error)
+}
+
+// PrivKeyBackend is a key backend holding the private keys within the EOTS
+// manager, which supports creating keys from mnemonics and handing out,
+// importing and deleting private keys for their backup and recovery
+type PrivKeyBackend interface {
+	KeyBackend
+
+	// CreateKeyWithMnemonic derives the key under the given name from the
+	// mnemonic and the HD path
+	CreateKeyWithMnemonic(name, passphrase, hdPath, mnemonic string) (*btcec.PublicKey, error)
+
+	// PrivKey returns the private key of the key with the given name
+	PrivKey(name, passphrase string) (*btcec.PrivateKey, error)
+
+	// ImportPrivKey saves the private key under the given name
+	ImportPrivKey(name, passphrase string, privKey *btcec.PrivateKey) error
+
+	// DeleteKey removes the key with the given name
+	DeleteKey(name string) error
+}
+
+// pubRandListFromPrivKey derives the public randomness of the private key for
+// the given chain from startHeight to startHeight+(num-1)
+func pubRandListFromPrivKey(privKey *btcec.PrivateKey, chainID []byte, startHeight uint64, num uint32) []*btcec.FieldVal {
+	prList := make([]*btcec.FieldVal, 0, num)
+	for i := uint32(0); i < num; i++ {
+		_, pubRand := randgenerator.GenerateRandomness(privKey.Serialize(), chainID, startHeight+uint64(i))
+		prList = append(prList, pubRand)
+	}
+
+	return prList
+}
+
+// signEOTSWithPrivKey signs the messages at their heights with the private key
+// and the randomness of the heights for the given chain
+func signEOTSWithPrivKey(privKey *btcec.PrivateKey, chainID []byte, reqs []*types.EOTSSignRequest) ([]*btcec.ModNScalar, error) {
+	sigs := make([]*btcec.ModNScalar, 0, len(reqs))
+	for _, req := range reqs {
+		privRand, _ := randgenerator.GenerateRandomness(privKey.Serialize(), chainID, req.Height)
+		sig, err := eots.Sign(privKey, privRand, req.Msg)
+		if err != nil {
+			return nil, err
+		}
+		sigs = append(sigs, sig)
+	}
+
+	return sigs, nil
+}
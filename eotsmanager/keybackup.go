@@ -0,0 +1,214 @@
+package eotsmanager
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	bbntypes "github.com/babylonchain/babylon/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	sdkcrypto "github.com/cosmos/cosmos-sdk/crypto"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/chacha20poly1305"
+	pm "google.golang.org/protobuf/proto"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/proto"
+	"github.com/babylonchain/finality-provider/eotsmanager/store"
+)
+
+const (
+	keyBackupBlockType = "EOTS KEY BACKUP"
+	keyBackupVersion   = "1"
+
+	headerVersion = "version"
+	headerKdf     = "kdf"
+	headerSalt    = "salt"
+	kdfArgon2     = "argon2"
+
+	// the parameters of Argon2id are the ones recommended by the
+	// golang.org/x/crypto/argon2 package for IDKey: a single pass over
+	// 64 MiB makes guessing the passphrase memory-hard while a backup is
+	// still derived in well under a second on a small machine, over 4
+	// lanes. They are not recorded in the backup, so changing them needs
+	// a new backup version
+	argon2Time    = 1
+	argon2Memory  = 64 * 1024
+	argon2Threads = 4
+	saltSize      = 16
+)
+
+// ExportKey exports the key pk along with its metadata and signing records
+// into an armored backup encrypted by the backup passphrase
+func (lm *LocalEOTSManager) ExportKey(fpPk []byte, passphrase, backupPassphrase string) (string, error) {
+	if backupPassphrase == "" {
+		return "", errors.New("the backup passphrase should not be empty")
+	}
+
+	record, err := lm.KeyRecord(fpPk, passphrase)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the key record: %w", err)
+	}
+
+	metadata, err := lm.es.GetKeyMetadata(fpPk)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the key metadata: %w", err)
+	}
+
+	signRecords, err := lm.es.ListSignRecords(fpPk)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the signing records: %w", err)
+	}
+
+	backup := &proto.KeyBackup{
+		Name:           record.Name,
+		Pk:             fpPk,
+		PrivKey:        record.PrivKey.Serialize(),
+		Metadata:       metadata,
+		SigningRecords: signRecords,
+	}
+
+	return encryptArmorKeyBackup(backup, backupPassphrase)
+}
+
+// ImportKey imports the key in the armored backup along with its metadata
+// and signing records. The key is saved under keyName, or under its original
+// name if keyName is empty. It fails if the key name or the public key
+// already exists
+func (lm *LocalEOTSManager) ImportKey(armor, backupPassphrase, keyName, passphrase string) (*bbntypes.BIP340PubKey, error) {
+	pkb, err := lm.privKeyBackend()
+	if err != nil {
+		return nil, err
+	}
+
+	backup, err := unarmorDecryptKeyBackup(armor, backupPassphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, pubKey := btcec.PrivKeyFromBytes(backup.PrivKey)
+	eotsPk := bbntypes.NewBIP340PubKeyFromBTCPK(pubKey)
+	if !bytes.Equal(eotsPk.MustMarshal(), backup.Pk) {
+		return nil, fmt.Errorf("the private key in the backup does not match the public key %s",
+			hex.EncodeToString(backup.Pk))
+	}
+
+	if keyName == "" {
+		keyName = backup.Name
+	}
+
+	_, err = lm.es.GetEOTSKeyName(backup.Pk)
+	if err == nil {
+		return nil, fmt.Errorf("%w: %s", store.ErrDuplicateEOTSKeyName, eotsPk.MarshalHex())
+	}
+	if !errors.Is(err, store.ErrEOTSKeyNameNotFound) {
+		return nil, err
+	}
+
+	if err := pkb.ImportPrivKey(keyName, passphrase, privKey); err != nil {
+		return nil, err
+	}
+
+	metadata := backup.Metadata
+	if metadata == nil {
+		metadata = &proto.KeyMetadata{}
+	}
+	if err := lm.es.ImportKey(backup.Pk, keyName, metadata, backup.SigningRecords); err != nil {
+		// do not leave a key in the keyring unknown to the store
+		if delErr := pkb.DeleteKey(keyName); delErr != nil {
+			lm.logger.Error("failed to remove the partially imported key", zap.Error(delErr))
+		}
+		return nil, fmt.Errorf("failed to save the imported key: %w", err)
+	}
+
+	lm.logger.Info(
+		"successfully imported an EOTS key",
+		zap.String("key name", keyName),
+		zap.String("pk", eotsPk.MarshalHex()),
+		zap.Int("signing records", len(backup.SigningRecords)),
+	)
+
+	return eotsPk, nil
+}
+
+func encryptArmorKeyBackup(backup *proto.KeyBackup, passphrase string) (string, error) {
+	plaintext, err := pm.Marshal(backup)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal the key backup: %w", err)
+	}
+	// the plaintext contains the private key
+	defer clear(plaintext)
+
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	aead, err := chacha20poly1305.NewX(deriveBackupKey(passphrase, salt))
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	ciphertext := aead.Seal(nonce, nonce, plaintext, nil)
+
+	headers := map[string]string{
+		headerVersion: keyBackupVersion,
+		headerKdf:     kdfArgon2,
+		headerSalt:    hex.EncodeToString(salt),
+	}
+
+	return sdkcrypto.EncodeArmor(keyBackupBlockType, headers, ciphertext), nil
+}
+
+func unarmorDecryptKeyBackup(armor, passphrase string) (*proto.KeyBackup, error) {
+	blockType, headers, ciphertext, err := sdkcrypto.DecodeArmor(armor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the key backup: %w", err)
+	}
+
+	if blockType != keyBackupBlockType {
+		return nil, fmt.Errorf("unrecognized armor type %s", blockType)
+	}
+	if headers[headerVersion] != keyBackupVersion {
+		return nil, fmt.Errorf("unsupported key backup version %s", headers[headerVersion])
+	}
+	if headers[headerKdf] != kdfArgon2 {
+		return nil, fmt.Errorf("unrecognized KDF type %s", headers[headerKdf])
+	}
+	salt, err := hex.DecodeString(headers[headerSalt])
+	if err != nil || len(salt) != saltSize {
+		return nil, errors.New("invalid salt in the key backup")
+	}
+
+	aead, err := chacha20poly1305.NewX(deriveBackupKey(passphrase, salt))
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("the key backup is too short")
+	}
+
+	nonce, ciphertext := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, errors.New("failed to decrypt the key backup: wrong passphrase or corrupted file")
+	}
+	defer clear(plaintext)
+
+	backup := new(proto.KeyBackup)
+	if err := pm.Unmarshal(plaintext, backup); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the key backup: %w", err)
+	}
+
+	return backup, nil
+}
+
+func deriveBackupKey(passphrase string, salt []byte) []byte {
+	return argon2.IDKey([]byte(passphrase), salt, argon2Time, argon2Memory, argon2Threads, chacha20poly1305.KeySize)
+}
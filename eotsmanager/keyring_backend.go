@@ -0,0 +1,192 @@
+package eotsmanager
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+
+	"github.com/babylonchain/finality-provider/codec"
+	"github.com/babylonchain/finality-provider/eotsmanager/types"
+)
+
+var _ PrivKeyBackend = &KeyringBackend{}
+
+// KeyringBackend is the default key backend, which keeps the EOTS keys in a
+// Cosmos SDK keyring
+type KeyringBackend struct {
+	// mu serializes the accesses to the keyring as the passphrase is
+	// sent to it through input
+	mu sync.Mutex
+	kr keyring.Keyring
+	// input is to send passphrase to kr
+	input *strings.Reader
+}
+
+func NewKeyringBackend(homeDir, keyringBackend string) (*KeyringBackend, error) {
+	inputReader := strings.NewReader("")
+
+	kr, err := initKeyring(homeDir, keyringBackend, inputReader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize keyring: %w", err)
+	}
+
+	return &KeyringBackend{
+		kr:    kr,
+		input: inputReader,
+	}, nil
+}
+
+func initKeyring(homeDir, keyringBackend string, inputReader *strings.Reader) (keyring.Keyring, error) {
+	return keyring.New(
+		"eots-manager",
+		keyringBackend,
+		homeDir,
+		inputReader,
+		codec.MakeCodec(),
+	)
+}
+
+func (kb *KeyringBackend) CreateKey(name, passphrase string) (*btcec.PublicKey, error) {
+	mnemonic, err := NewMnemonic()
+	if err != nil {
+		return nil, err
+	}
+
+	return kb.CreateKeyWithMnemonic(name, passphrase, "", mnemonic)
+}
+
+func (kb *KeyringBackend) CreateKeyWithMnemonic(name, passphrase, hdPath, mnemonic string) (*btcec.PublicKey, error) {
+	kb.mu.Lock()
+	defer kb.mu.Unlock()
+
+	if _, err := kb.kr.Key(name); err == nil {
+		return nil, types.ErrFinalityProviderAlreadyExisted
+	}
+
+	keyringAlgos, _ := kb.kr.SupportedAlgorithms()
+	algo, err := keyring.NewSigningAlgoFromString(secp256k1Type, keyringAlgos)
+	if err != nil {
+		return nil, err
+	}
+
+	// we need to repeat the passphrase to mock the re-entry
+	// as when creating an account, passphrase will be asked twice
+	// by the keyring
+	kb.input.Reset(passphrase + "\n" + passphrase)
+	record, err := kb.kr.NewAccount(name, mnemonic, passphrase, hdPath, algo)
+	if err != nil {
+		return nil, err
+	}
+
+	return pubKeyFromKeyringRecord(record)
+}
+
+func (kb *KeyringBackend) PubKey(name string) (*btcec.PublicKey, error) {
+	kb.mu.Lock()
+	defer kb.mu.Unlock()
+
+	k, err := kb.kr.Key(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load keyring record for key %s: %w", name, err)
+	}
+
+	return pubKeyFromKeyringRecord(k)
+}
+
+func (kb *KeyringBackend) PubRandList(name, passphrase string, chainID []byte, startHeight uint64, num uint32) ([]*btcec.FieldVal, error) {
+	privKey, err := kb.PrivKey(name, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return pubRandListFromPrivKey(privKey, chainID, startHeight, num), nil
+}
+
+func (kb *KeyringBackend) SignEOTS(name, passphrase string, chainID []byte, reqs []*types.EOTSSignRequest) ([]*btcec.ModNScalar, error) {
+	privKey, err := kb.PrivKey(name, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return signEOTSWithPrivKey(privKey, chainID, reqs)
+}
+
+func (kb *KeyringBackend) SignSchnorr(name, passphrase string, msg []byte) (*schnorr.Signature, error) {
+	privKey, err := kb.PrivKey(name, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return schnorr.Sign(privKey, msg)
+}
+
+func (kb *KeyringBackend) PrivKey(name, passphrase string) (*btcec.PrivateKey, error) {
+	kb.mu.Lock()
+	defer kb.mu.Unlock()
+
+	kb.input.Reset(passphrase)
+	k, err := kb.kr.Key(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return eotsPrivKeyFromRecord(k)
+}
+
+func (kb *KeyringBackend) ImportPrivKey(name, passphrase string, privKey *btcec.PrivateKey) error {
+	kb.mu.Lock()
+	defer kb.mu.Unlock()
+
+	if _, err := kb.kr.Key(name); err == nil {
+		return types.ErrFinalityProviderAlreadyExisted
+	}
+
+	// we need to repeat the passphrase to mock the re-entry
+	// as when creating an account
+	kb.input.Reset(passphrase + "\n" + passphrase)
+	if err := kb.kr.ImportPrivKeyHex(name, hex.EncodeToString(privKey.Serialize()), secp256k1Type); err != nil {
+		return fmt.Errorf("failed to import the key into the keyring: %w", err)
+	}
+
+	return nil
+}
+
+func (kb *KeyringBackend) DeleteKey(name string) error {
+	kb.mu.Lock()
+	defer kb.mu.Unlock()
+
+	return kb.kr.Delete(name)
+}
+
+func pubKeyFromKeyringRecord(record *keyring.Record) (*btcec.PublicKey, error) {
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	switch v := pubKey.(type) {
+	case *secp256k1.PubKey:
+		return btcec.ParsePubKey(v.Key)
+	default:
+		return nil, fmt.Errorf("unsupported key type in keyring")
+	}
+}
+
+func eotsPrivKeyFromRecord(k *keyring.Record) (*btcec.PrivateKey, error) {
+	privKeyCached := k.GetLocal().PrivKey.GetCachedValue()
+
+	var privKey *btcec.PrivateKey
+	switch v := privKeyCached.(type) {
+	case *secp256k1.PrivKey:
+		privKey, _ = btcec.PrivKeyFromBytes(v.Key)
+		return privKey, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type in keyring")
+	}
+}
@@ -0,0 +1,260 @@
+package eotsmanager
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	bbntypes "github.com/babylonchain/babylon/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	sdkcrypto "github.com/cosmos/cosmos-sdk/crypto"
+	"go.uber.org/zap"
+	pm "google.golang.org/protobuf/proto"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/proto"
+	"github.com/babylonchain/finality-provider/eotsmanager/shamir"
+	"github.com/babylonchain/finality-provider/eotsmanager/store"
+)
+
+// ErrNoSignHistory The database holds no signing records of the recovered key,
+// so its protection against double signing would start from scratch
+var ErrNoSignHistory = errors.New("the database holds no signing history of the key")
+
+const (
+	keyShareBlockType = "EOTS KEY SHARE"
+	keyShareVersion   = "1"
+
+	headerPk        = "pk"
+	headerShare     = "share"
+	headerThreshold = "threshold"
+)
+
+// SplitKey splits the private key pk into total armored Shamir shares, any
+// threshold of which recover the key through RecoverKeyFromShares. Each share
+// can be verified against the commitments it carries
+func (lm *LocalEOTSManager) SplitKey(fpPk []byte, passphrase string, threshold, total uint32) ([]string, error) {
+	keyName, err := lm.es.GetEOTSKeyName(fpPk)
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, err := lm.getEOTSPrivKey(fpPk, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get EOTS private key: %w", err)
+	}
+	defer privKey.Zero()
+
+	shares, commitments, err := shamir.Split(&privKey.Key, threshold, total)
+	if err != nil {
+		return nil, fmt.Errorf("failed to split the key: %w", err)
+	}
+
+	commitmentBytes := make([][]byte, 0, len(commitments))
+	for _, c := range commitments {
+		commitmentBytes = append(commitmentBytes, c.SerializeCompressed())
+	}
+
+	armors := make([]string, 0, len(shares))
+	for _, s := range shares {
+		value := s.Value.Bytes()
+		share := &proto.KeyShare{
+			Name:        keyName,
+			Pk:          fpPk,
+			Threshold:   threshold,
+			Total:       total,
+			Index:       uint32(s.Index),
+			Value:       value[:],
+			Commitments: commitmentBytes,
+		}
+		s.Value.Zero()
+
+		shareBytes, err := pm.Marshal(share)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal the key share: %w", err)
+		}
+
+		headers := map[string]string{
+			headerVersion:   keyShareVersion,
+			headerPk:        hex.EncodeToString(fpPk),
+			headerShare:     fmt.Sprintf("%d/%d", s.Index, total),
+			headerThreshold: strconv.FormatUint(uint64(threshold), 10),
+		}
+		armors = append(armors, sdkcrypto.EncodeArmor(keyShareBlockType, headers, shareBytes))
+	}
+
+	lm.logger.Info(
+		"split an EOTS key into shares",
+		zap.String("pk", hex.EncodeToString(fpPk)),
+		zap.Uint32("threshold", threshold),
+		zap.Uint32("total", total),
+	)
+
+	return armors, nil
+}
+
+// RecoverKeyFromShares recovers the key from the armored shares created by
+// SplitKey, after verifying each share against the commitments of the split
+// and the recovered key against the public key of the split. If the database
+// knows the key, e.g., once a backup is restored, the key is saved under its
+// name there and keeps its signing history. Otherwise, the key would be used
+// without any protection against double signing what it signed before, so the
+// recovery fails with ErrNoSignHistory unless withoutHistory is set, and the
+// key is saved under keyName, or under its original name if keyName is empty
+func (lm *LocalEOTSManager) RecoverKeyFromShares(armors []string, keyName, passphrase string, withoutHistory bool) (*bbntypes.BIP340PubKey, error) {
+	pkb, err := lm.privKeyBackend()
+	if err != nil {
+		return nil, err
+	}
+
+	keyShares := make([]*proto.KeyShare, 0, len(armors))
+	for _, a := range armors {
+		share, err := unarmorKeyShare(a)
+		if err != nil {
+			return nil, err
+		}
+		keyShares = append(keyShares, share)
+	}
+
+	privKey, err := combineKeyShares(keyShares)
+	if err != nil {
+		return nil, err
+	}
+	defer privKey.Zero()
+
+	fpPk := keyShares[0].Pk
+	pkHex := hex.EncodeToString(fpPk)
+
+	storedName, err := lm.es.GetEOTSKeyName(fpPk)
+	if err == nil {
+		if keyName != "" && keyName != storedName {
+			return nil, fmt.Errorf("the key %s is named %s in the database", pkHex, storedName)
+		}
+		if _, err := pkb.PubKey(storedName); err == nil {
+			return nil, fmt.Errorf("%w: %s", store.ErrDuplicateEOTSKeyName, pkHex)
+		}
+		if err := pkb.ImportPrivKey(storedName, passphrase, privKey); err != nil {
+			return nil, err
+		}
+
+		lm.logger.Info("recovered an EOTS key with its signing history",
+			zap.String("key name", storedName), zap.String("pk", pkHex))
+
+		return bbntypes.NewBIP340PubKeyFromBTCPK(privKey.PubKey()), nil
+	}
+	if !errors.Is(err, store.ErrEOTSKeyNameNotFound) {
+		return nil, err
+	}
+
+	if !withoutHistory {
+		return nil, fmt.Errorf("%w: %s, restore or merge a database backup holding its signing records first",
+			ErrNoSignHistory, pkHex)
+	}
+	if keyName == "" {
+		keyName = keyShares[0].Name
+	}
+
+	if err := pkb.ImportPrivKey(keyName, passphrase, privKey); err != nil {
+		return nil, err
+	}
+
+	eotsPk, err := lm.saveKeyName(keyName, privKey.PubKey())
+	if err != nil {
+		// do not leave a key in the keyring unknown to the store
+		if delErr := pkb.DeleteKey(keyName); delErr != nil {
+			lm.logger.Error("failed to remove the partially recovered key", zap.Error(delErr))
+		}
+		return nil, err
+	}
+
+	lm.logger.Warn("recovered an EOTS key without its signing history, it is not protected "+
+		"against double signing the heights it signed before",
+		zap.String("key name", keyName), zap.String("pk", pkHex))
+
+	return eotsPk, nil
+}
+
+// combineKeyShares checks that the shares belong to the same split, verifies
+// them against the commitments, and recovers the private key of the split
+func combineKeyShares(keyShares []*proto.KeyShare) (*btcec.PrivateKey, error) {
+	if len(keyShares) == 0 {
+		return nil, errors.New("no key share is given")
+	}
+
+	ref := keyShares[0]
+	if uint32(len(keyShares)) < ref.Threshold {
+		return nil, fmt.Errorf("%d shares are given while the threshold is %d", len(keyShares), ref.Threshold)
+	}
+	if uint32(len(ref.Commitments)) != ref.Threshold {
+		return nil, fmt.Errorf("expected %d commitments, got %d", ref.Threshold, len(ref.Commitments))
+	}
+
+	commitments := make([]*btcec.PublicKey, 0, len(ref.Commitments))
+	for _, c := range ref.Commitments {
+		pk, err := btcec.ParsePubKey(c)
+		if err != nil {
+			return nil, fmt.Errorf("invalid commitment: %w", err)
+		}
+		commitments = append(commitments, pk)
+	}
+	// the constant term of the polynomial is the private key
+	if !bytes.Equal(schnorr.SerializePubKey(commitments[0]), ref.Pk) {
+		return nil, errors.New("the commitments do not match the public key of the split")
+	}
+
+	shares := make([]*shamir.Share, 0, len(keyShares))
+	for _, ks := range keyShares {
+		if !bytes.Equal(ks.Pk, ref.Pk) || ks.Threshold != ref.Threshold || ks.Total != ref.Total ||
+			!slices.EqualFunc(ks.Commitments, ref.Commitments, bytes.Equal) {
+			return nil, fmt.Errorf("the share %d does not belong to the same split as the share %d", ks.Index, ref.Index)
+		}
+		if ks.Index == 0 || ks.Index > ks.Total {
+			return nil, fmt.Errorf("invalid share index %d", ks.Index)
+		}
+
+		share := &shamir.Share{Index: uint8(ks.Index)}
+		if len(ks.Value) != 32 || share.Value.SetByteSlice(ks.Value) {
+			return nil, fmt.Errorf("invalid value of the share %d", ks.Index)
+		}
+		if err := shamir.VerifyShare(share, commitments); err != nil {
+			return nil, err
+		}
+		shares = append(shares, share)
+	}
+
+	secret, err := shamir.Combine(shares)
+	if err != nil {
+		return nil, fmt.Errorf("failed to combine the shares: %w", err)
+	}
+	privKey := btcec.PrivKeyFromScalar(secret)
+
+	if !bytes.Equal(schnorr.SerializePubKey(privKey.PubKey()), ref.Pk) {
+		return nil, errors.New("the recovered key does not match the public key of the split")
+	}
+
+	return privKey, nil
+}
+
+func unarmorKeyShare(armor string) (*proto.KeyShare, error) {
+	blockType, headers, shareBytes, err := sdkcrypto.DecodeArmor(armor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the key share: %w", err)
+	}
+
+	if blockType != keyShareBlockType {
+		return nil, fmt.Errorf("unrecognized armor type %s", blockType)
+	}
+	if headers[headerVersion] != keyShareVersion {
+		return nil, fmt.Errorf("unsupported key share version %s", headers[headerVersion])
+	}
+
+	share := new(proto.KeyShare)
+	if err := pm.Unmarshal(shareBytes, share); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the key share: %w", err)
+	}
+
+	return share, nil
+}
@@ -0,0 +1,152 @@
+package lease
+
+import (
+	"encoding/binary"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+const (
+	// fileOpenTimeout bounds the wait for the file lock held by another
+	// replica, which only holds it during an operation
+	fileOpenTimeout = 5 * time.Second
+)
+
+var (
+	leaseBucketName = []byte("lease")
+	leaseKey        = []byte("current")
+)
+
+// FileBackend stores the lease in a bolt database file, e.g., on a file
+// system shared by the replicas. The database is only opened during an
+// operation so that its file lock serializes the operations of the replicas
+type FileBackend struct {
+	cfg *kvdb.BoltBackendConfig
+}
+
+func NewFileBackend(path string) *FileBackend {
+	return &FileBackend{
+		cfg: &kvdb.BoltBackendConfig{
+			DBPath:     filepath.Dir(path),
+			DBFileName: filepath.Base(path),
+			DBTimeout:  fileOpenTimeout,
+		},
+	}
+}
+
+func (b *FileBackend) Acquire(holder string, ttl time.Duration) (*Lease, error) {
+	var acquired *Lease
+	err := b.update(func(bucket kvdb.RwBucket) error {
+		now := time.Now()
+		current, err := decodeLease(bucket.Get(leaseKey))
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case current == nil:
+			acquired = &Lease{Holder: holder, Token: 1}
+		case current.Holder == holder && now.Before(current.Expiry):
+			// renewal keeps the fencing token
+			acquired = &Lease{Holder: holder, Token: current.Token}
+		case now.Before(current.Expiry):
+			acquired = current
+			return ErrNotLeaseHolder
+		default:
+			// takeover of an expired or released lease, even if it was
+			// held by the same holder before, supersedes its token
+			acquired = &Lease{Holder: holder, Token: current.Token + 1}
+		}
+		acquired.Expiry = now.Add(ttl)
+
+		return bucket.Put(leaseKey, encodeLease(acquired))
+	})
+
+	return acquired, err
+}
+
+func (b *FileBackend) Release(holder string, token uint64) error {
+	return b.update(func(bucket kvdb.RwBucket) error {
+		current, err := decodeLease(bucket.Get(leaseKey))
+		if err != nil {
+			return err
+		}
+		if current == nil || current.Holder != holder || current.Token != token {
+			return ErrLeaseSuperseded
+		}
+
+		current.Expiry = time.Now()
+		return bucket.Put(leaseKey, encodeLease(current))
+	})
+}
+
+func (b *FileBackend) Current() (*Lease, error) {
+	var current *Lease
+	err := b.view(func(bucket kvdb.RBucket) error {
+		var err error
+		current, err = decodeLease(bucket.Get(leaseKey))
+		return err
+	})
+
+	return current, err
+}
+
+// view runs fn in a read transaction, which is skipped if no lease has ever
+// been acquired
+func (b *FileBackend) view(fn func(bucket kvdb.RBucket) error) error {
+	db, err := kvdb.GetBoltBackend(b.cfg)
+	if err != nil {
+		return fmt.Errorf("failed to open the lease db: %w", err)
+	}
+	defer db.Close()
+
+	return kvdb.View(db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(leaseBucketName)
+		if bucket == nil {
+			return nil
+		}
+		return fn(bucket)
+	}, func() {})
+}
+
+func (b *FileBackend) update(fn func(bucket kvdb.RwBucket) error) error {
+	db, err := kvdb.GetBoltBackend(b.cfg)
+	if err != nil {
+		return fmt.Errorf("failed to open the lease db: %w", err)
+	}
+	defer db.Close()
+
+	return kvdb.Update(db, func(tx kvdb.RwTx) error {
+		bucket, err := tx.CreateTopLevelBucket(leaseBucketName)
+		if err != nil {
+			return err
+		}
+		return fn(bucket)
+	}, func() {})
+}
+
+// encodeLease encodes the lease as token || expiry || holder
+func encodeLease(l *Lease) []byte {
+	v := make([]byte, 16, 16+len(l.Holder))
+	binary.BigEndian.PutUint64(v[:8], l.Token)
+	binary.BigEndian.PutUint64(v[8:16], uint64(l.Expiry.UnixNano()))
+	return append(v, l.Holder...)
+}
+
+func decodeLease(v []byte) (*Lease, error) {
+	if v == nil {
+		return nil, nil
+	}
+	if len(v) < 16 {
+		return nil, fmt.Errorf("invalid lease record of %d bytes", len(v))
+	}
+
+	return &Lease{
+		Token:  binary.BigEndian.Uint64(v[:8]),
+		Expiry: time.Unix(0, int64(binary.BigEndian.Uint64(v[8:16]))),
+		Holder: string(v[16:]),
+	}, nil
+}
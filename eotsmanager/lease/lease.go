@@ -0,0 +1,216 @@
+package lease
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrNotLeaseHolder The signing lease is held by another instance or
+	// has not been acquired yet
+	ErrNotLeaseHolder = errors.New("the signing lease is not held by this instance")
+
+	// ErrLeaseSuperseded The fencing token of the lease has been superseded
+	// by a takeover of another instance
+	ErrLeaseSuperseded = fmt.Errorf("%w: the fencing token is superseded", ErrNotLeaseHolder)
+)
+
+// Lease is the signing lease shared by the replicas of the EOTS manager
+type Lease struct {
+	// Holder is the identifier of the instance holding the lease
+	Holder string
+	// Token is the fencing token of the lease, which is increased every
+	// time the lease changes hands
+	Token uint64
+	// Expiry is the time after which the lease can be taken over
+	Expiry time.Time
+}
+
+// Backend stores the lease in a place shared by the replicas. Its operations
+// are atomic across all the replicas
+type Backend interface {
+	// Acquire takes the lease for the holder if it is free or expired, or
+	// renews it if it is already held by the holder. A new fencing token is
+	// issued if the lease changes hands. It returns ErrNotLeaseHolder along
+	// with the current lease if another holder has an unexpired lease
+	Acquire(holder string, ttl time.Duration) (*Lease, error)
+	// Release expires the lease if it is held by the holder with the given
+	// token, so that it can be taken over immediately
+	Release(holder string, token uint64) error
+	// Current returns the current lease, or nil if it has never been taken
+	Current() (*Lease, error)
+}
+
+// Keeper acquires and renews the lease in the background on behalf of an
+// instance and tells whether the instance can sign
+type Keeper struct {
+	backend       Backend
+	holder        string
+	ttl           time.Duration
+	renewInterval time.Duration
+	// signMargin is the minimum time left on the lease for the instance to
+	// sign, so that a signing call does not outlive the lease
+	signMargin time.Duration
+	logger     *zap.Logger
+
+	mu    sync.RWMutex
+	lease *Lease
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+func NewKeeper(backend Backend, holder string, ttl, renewInterval, signMargin time.Duration, logger *zap.Logger) *Keeper {
+	return &Keeper{
+		backend:       backend,
+		holder:        holder,
+		ttl:           ttl,
+		renewInterval: renewInterval,
+		signMargin:    signMargin,
+		logger:        logger,
+		quit:          make(chan struct{}),
+	}
+}
+
+// Start tries to acquire the lease and keeps acquiring or renewing it
+// every renew interval until stopped
+func (k *Keeper) Start() {
+	k.tryAcquire()
+
+	k.wg.Add(1)
+	go k.renewLoop()
+}
+
+// Stop stops renewing the lease and releases it if it is held
+func (k *Keeper) Stop() {
+	close(k.quit)
+	k.wg.Wait()
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.lease == nil {
+		return
+	}
+	if err := k.backend.Release(k.holder, k.lease.Token); err != nil {
+		k.logger.Error("failed to release the signing lease", zap.Error(err))
+	} else {
+		k.logger.Info("released the signing lease", zap.Uint64("fencing_token", k.lease.Token))
+	}
+	k.lease = nil
+}
+
+// Check returns the fencing token of the lease if the instance holds a lease
+// with more than the sign margin left, whose token is still the current one
+// in the backend. A nil keeper allows all calls
+func (k *Keeper) Check() (uint64, error) {
+	if k == nil {
+		return 0, nil
+	}
+
+	k.mu.RLock()
+	held := k.lease
+	k.mu.RUnlock()
+	if held == nil {
+		return 0, ErrNotLeaseHolder
+	}
+	if left := time.Until(held.Expiry); left <= k.signMargin {
+		return 0, fmt.Errorf("%w: the lease expires at %v, within the sign margin %v",
+			ErrNotLeaseHolder, held.Expiry, k.signMargin)
+	}
+
+	current, err := k.backend.Current()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the current signing lease: %w", err)
+	}
+	if current == nil || current.Holder != k.holder || current.Token != held.Token {
+		k.drop(held.Token)
+		return 0, ErrLeaseSuperseded
+	}
+
+	return held.Token, nil
+}
+
+// Confirm checks again after signing that the instance still holds the
+// lease with the token returned by Check before signing. The signature should
+// be dropped otherwise, as another instance may have signed in the meantime.
+// A nil keeper confirms all calls
+func (k *Keeper) Confirm(token uint64) error {
+	if k == nil {
+		return nil
+	}
+
+	current, err := k.Check()
+	if err != nil {
+		return err
+	}
+	if current != token {
+		return ErrLeaseSuperseded
+	}
+
+	return nil
+}
+
+// Holder returns the identifier of the instance
+func (k *Keeper) Holder() string {
+	return k.holder
+}
+
+func (k *Keeper) renewLoop() {
+	defer k.wg.Done()
+
+	ticker := time.NewTicker(k.renewInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			k.tryAcquire()
+		case <-k.quit:
+			return
+		}
+	}
+}
+
+func (k *Keeper) tryAcquire() {
+	l, err := k.backend.Acquire(k.holder, k.ttl)
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if errors.Is(err, ErrNotLeaseHolder) {
+		if k.lease != nil {
+			k.logger.Warn("the signing lease is taken over by another instance", zap.String("holder", l.Holder))
+			k.lease = nil
+		}
+		k.logger.Debug("the signing lease is held by another instance",
+			zap.String("holder", l.Holder), zap.Time("expiry", l.Expiry))
+		return
+	}
+	if err != nil {
+		// a held lease is kept until it expires as the failure can be
+		// transient, while Check refuses it afterwards
+		k.logger.Error("failed to acquire or renew the signing lease", zap.Error(err))
+		return
+	}
+
+	if k.lease == nil || k.lease.Token != l.Token {
+		k.logger.Info("acquired the signing lease", zap.String("holder", k.holder),
+			zap.Uint64("fencing_token", l.Token))
+	}
+	k.lease = l
+}
+
+// drop forgets the lease with the given token after it is superseded
+func (k *Keeper) drop(token uint64) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if k.lease != nil && k.lease.Token == token {
+		k.logger.Warn("the signing lease is superseded by another instance", zap.Uint64("fencing_token", token))
+		k.lease = nil
+	}
+}
@@ -0,0 +1,133 @@
+package lease_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/lease"
+)
+
+// TestFileBackend tests that the lease is only taken over after it expires
+// or is released, and that every takeover supersedes the fencing token
+func TestFileBackend(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lease.db")
+	backendA, backendB := lease.NewFileBackend(path), lease.NewFileBackend(path)
+	ttl := 200 * time.Millisecond
+
+	current, err := backendA.Current()
+	require.NoError(t, err)
+	require.Nil(t, current)
+
+	l, err := backendA.Acquire("a", ttl)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), l.Token)
+
+	// renewal keeps the token
+	l, err = backendA.Acquire("a", ttl)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), l.Token)
+
+	l, err = backendB.Acquire("b", ttl)
+	require.ErrorIs(t, err, lease.ErrNotLeaseHolder)
+	require.Equal(t, "a", l.Holder)
+
+	// takeover after expiry
+	time.Sleep(ttl)
+	l, err = backendB.Acquire("b", ttl)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), l.Token)
+	_, err = backendA.Acquire("a", ttl)
+	require.ErrorIs(t, err, lease.ErrNotLeaseHolder)
+	err = backendA.Release("a", 1)
+	require.ErrorIs(t, err, lease.ErrLeaseSuperseded)
+
+	// takeover after release
+	err = backendB.Release("b", 2)
+	require.NoError(t, err)
+	l, err = backendA.Acquire("a", ttl)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), l.Token)
+
+	current, err = backendB.Current()
+	require.NoError(t, err)
+	require.Equal(t, "a", current.Holder)
+	require.Equal(t, uint64(3), current.Token)
+}
+
+// TestKeeperTakeover tests that only one keeper can sign at a time, that the
+// standby takes over once the active one stops, and that a superseded keeper
+// is refused even before its lease expires locally
+func TestKeeperTakeover(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lease.db")
+	backend := lease.NewFileBackend(path)
+	ttl, renewInterval, signMargin := 300*time.Millisecond, 50*time.Millisecond, 100*time.Millisecond
+
+	active := lease.NewKeeper(lease.NewFileBackend(path), "active", ttl, renewInterval, signMargin, zap.NewNop())
+	active.Start()
+	token, err := active.Check()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), token)
+
+	standby := lease.NewKeeper(lease.NewFileBackend(path), "standby", ttl, renewInterval, signMargin, zap.NewNop())
+	standby.Start()
+	_, err = standby.Check()
+	require.ErrorIs(t, err, lease.ErrNotLeaseHolder)
+
+	// the lease is kept renewed beyond its TTL
+	time.Sleep(2 * ttl)
+	_, err = active.Check()
+	require.NoError(t, err)
+	_, err = standby.Check()
+	require.ErrorIs(t, err, lease.ErrNotLeaseHolder)
+
+	active.Stop()
+	require.Eventually(t, func() bool {
+		token, err := standby.Check()
+		return err == nil && token == 2
+	}, 5*ttl, renewInterval)
+
+	// a keeper which does not renew in time is fenced off once superseded
+	stale := lease.NewKeeper(lease.NewFileBackend(path), "stale", time.Hour, time.Hour, time.Minute, zap.NewNop())
+	standby.Stop()
+	stale.Start()
+	defer stale.Stop()
+	token, err = stale.Check()
+	require.NoError(t, err)
+	err = backend.Release("stale", token)
+	require.NoError(t, err)
+	_, err = backend.Acquire("other", ttl)
+	require.NoError(t, err)
+	_, err = stale.Check()
+	require.ErrorIs(t, err, lease.ErrLeaseSuperseded)
+}
+
+// TestKeeperSignMargin tests that a keeper does not sign with less than the
+// sign margin left on its lease, and that a signature is confirmed with the
+// token checked before signing only
+func TestKeeperSignMargin(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lease.db")
+	backend := lease.NewFileBackend(path)
+	ttl, signMargin := 300*time.Millisecond, 200*time.Millisecond
+
+	// the lease is never renewed
+	keeper := lease.NewKeeper(lease.NewFileBackend(path), "active", ttl, time.Hour, signMargin, zap.NewNop())
+	keeper.Start()
+	defer keeper.Stop()
+	token, err := keeper.Check()
+	require.NoError(t, err)
+	require.NoError(t, keeper.Confirm(token))
+	require.ErrorIs(t, keeper.Confirm(token+1), lease.ErrLeaseSuperseded)
+
+	time.Sleep(ttl - signMargin)
+	_, err = keeper.Check()
+	require.ErrorIs(t, err, lease.ErrNotLeaseHolder)
+	require.ErrorIs(t, keeper.Confirm(token), lease.ErrNotLeaseHolder)
+
+	// the lease is not expired yet
+	_, err = backend.Acquire("standby", ttl)
+	require.ErrorIs(t, err, lease.ErrNotLeaseHolder)
+}
@@ -1,10 +1,12 @@
 package eotsmanager
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
-	"strings"
+	"io"
 	"sync"
+	"time"
 
 	"github.com/babylonchain/finality-provider/metrics"
 
@@ -12,14 +14,11 @@ import (
 	bbntypes "github.com/babylonchain/babylon/types"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
-	"github.com/cosmos/cosmos-sdk/crypto/keyring"
-	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/go-bip39"
 	"github.com/lightningnetwork/lnd/kvdb"
 	"go.uber.org/zap"
 
-	"github.com/babylonchain/finality-provider/codec"
-	"github.com/babylonchain/finality-provider/eotsmanager/randgenerator"
+	"github.com/babylonchain/finality-provider/eotsmanager/proto"
 	"github.com/babylonchain/finality-provider/eotsmanager/store"
 	eotstypes "github.com/babylonchain/finality-provider/eotsmanager/types"
 )
@@ -32,50 +31,68 @@ const (
 var _ EOTSManager = &LocalEOTSManager{}
 
 type LocalEOTSManager struct {
-	mu     sync.Mutex
-	kr     keyring.Keyring
-	es     *store.EOTSStore
-	logger *zap.Logger
-	// input is to send passphrase to kr
-	input   *strings.Reader
+	// signMu serializes EOTS signing so that the check and the
+	// persistence of signing records cannot interleave
+	signMu  sync.Mutex
+	kb      KeyBackend
+	es      *store.EOTSStore
+	logger  *zap.Logger
 	metrics *metrics.EotsMetrics
+	// sessions are the keys unlocked by UnlockKey
+	sessions *sessionStore
+	// passphrases are the passphrases of the keys by name, used when a
+	// request does not carry any
+	passphrases map[string]string
 }
 
+// NewLocalEOTSManager creates an EOTS manager keeping the keys in the keyring
+// of the given backend type under homeDir
 func NewLocalEOTSManager(homeDir, keyringBackend string, dbbackend kvdb.Backend, logger *zap.Logger) (*LocalEOTSManager, error) {
-	inputReader := strings.NewReader("")
-
-	es, err := store.NewEOTSStore(dbbackend)
+	kb, err := NewKeyringBackend(homeDir, keyringBackend)
 	if err != nil {
-		return nil, fmt.Errorf("failed to initialize store: %w", err)
+		return nil, err
 	}
 
-	kr, err := initKeyring(homeDir, keyringBackend, inputReader)
+	return NewLocalEOTSManagerWithKeyBackend(kb, dbbackend, logger)
+}
+
+// NewLocalEOTSManagerWithKeyBackend creates an EOTS manager delegating the
+// operations needing the private keys to the given key backend
+func NewLocalEOTSManagerWithKeyBackend(kb KeyBackend, dbbackend kvdb.Backend, logger *zap.Logger) (*LocalEOTSManager, error) {
+	es, err := store.NewEOTSStore(dbbackend)
 	if err != nil {
-		return nil, fmt.Errorf("failed to initialize keyring: %w", err)
+		return nil, fmt.Errorf("failed to initialize store: %w", err)
 	}
 
 	eotsMetrics := metrics.NewEotsMetrics()
 
 	return &LocalEOTSManager{
-		kr:      kr,
-		es:      es,
-		logger:  logger,
-		input:   inputReader,
-		metrics: eotsMetrics,
+		kb:       kb,
+		es:       es,
+		logger:   logger,
+		metrics:  eotsMetrics,
+		sessions: newSessionStore(),
 	}, nil
 }
 
-func initKeyring(homeDir, keyringBackend string, inputReader *strings.Reader) (keyring.Keyring, error) {
-	return keyring.New(
-		"eots-manager",
-		keyringBackend,
-		homeDir,
-		inputReader,
-		codec.MakeCodec(),
-	)
-}
-
+// CreateKey creates a key in the key backend. A key backend holding the private
+// keys derives it from a new mnemonic at the given HD path, while other backends
+// generate it on their own
 func (lm *LocalEOTSManager) CreateKey(name, passphrase, hdPath string) ([]byte, error) {
+	if _, ok := lm.kb.(PrivKeyBackend); !ok {
+		pk, err := lm.kb.CreateKey(name, passphrase)
+		if err != nil {
+			return nil, err
+		}
+
+		eotsPk, err := lm.saveKeyName(name, pk)
+		if err != nil {
+			return nil, err
+		}
+
+		return eotsPk.MustMarshal(), nil
+	}
+
 	mnemonic, err := NewMnemonic()
 	if err != nil {
 		return nil, err
@@ -105,31 +122,41 @@ func NewMnemonic() (string, error) {
 }
 
 func (lm *LocalEOTSManager) CreateKeyWithMnemonic(name, passphrase, hdPath, mnemonic string) (*bbntypes.BIP340PubKey, error) {
-	if lm.keyExists(name) {
-		return nil, eotstypes.ErrFinalityProviderAlreadyExisted
-	}
-
-	keyringAlgos, _ := lm.kr.SupportedAlgorithms()
-	algo, err := keyring.NewSigningAlgoFromString(secp256k1Type, keyringAlgos)
+	pkb, err := lm.privKeyBackend()
 	if err != nil {
 		return nil, err
 	}
 
-	// we need to repeat the passphrase to mock the re-entry
-	// as when creating an account, passphrase will be asked twice
-	// by the keyring
-	lm.input.Reset(passphrase + "\n" + passphrase)
-	record, err := lm.kr.NewAccount(name, mnemonic, passphrase, hdPath, algo)
+	pk, err := pkb.CreateKeyWithMnemonic(name, passphrase, hdPath, mnemonic)
 	if err != nil {
 		return nil, err
 	}
 
-	eotsPk, err := loadBIP340PubKeyFromKeyringRecord(record)
-	if err != nil {
-		return nil, err
+	return lm.saveKeyName(name, pk)
+}
+
+// HoldsPrivKeys tells whether the key backend holds the private keys within
+// the manager, which is needed to create keys from mnemonics and to back up,
+// split and import keys
+func (lm *LocalEOTSManager) HoldsPrivKeys() bool {
+	_, ok := lm.kb.(PrivKeyBackend)
+	return ok
+}
+
+func (lm *LocalEOTSManager) privKeyBackend() (PrivKeyBackend, error) {
+	pkb, ok := lm.kb.(PrivKeyBackend)
+	if !ok {
+		return nil, ErrPrivKeyNotExposed
 	}
 
-	if err := lm.es.AddEOTSKeyName(eotsPk.MustToBTCPK(), name); err != nil {
+	return pkb, nil
+}
+
+// saveKeyName saves the name of the newly created key in the store
+func (lm *LocalEOTSManager) saveKeyName(name string, pk *btcec.PublicKey) (*bbntypes.BIP340PubKey, error) {
+	eotsPk := bbntypes.NewBIP340PubKeyFromBTCPK(pk)
+
+	if err := lm.es.AddEOTSKeyName(pk, name); err != nil {
 		return nil, err
 	}
 
@@ -143,120 +170,272 @@ func (lm *LocalEOTSManager) CreateKeyWithMnemonic(name, passphrase, hdPath, mnem
 	return eotsPk, nil
 }
 
-func loadBIP340PubKeyFromKeyringRecord(record *keyring.Record) (*bbntypes.BIP340PubKey, error) {
-	pubKey, err := record.GetPubKey()
+// CreateRandomnessPairList returns a list of public randomness starting from the given height.
+// The randomness is deterministic so it can be re-generated safely, as the anti-slashing
+// protection is enforced by SignEOTS
+func (lm *LocalEOTSManager) CreateRandomnessPairList(fpPk []byte, chainID []byte, startHeight uint64, num uint32, passphrase string) ([]*btcec.FieldVal, error) {
+	return lm.createRandomnessPairList(lm.kb, fpPk, chainID, startHeight, num, passphrase)
+}
+
+func (lm *LocalEOTSManager) createRandomnessPairList(kb KeyBackend, fpPk []byte, chainID []byte, startHeight uint64, num uint32, passphrase string) ([]*btcec.FieldVal, error) {
+	keyName, err := lm.es.GetEOTSKeyName(fpPk)
 	if err != nil {
 		return nil, err
 	}
 
-	var eotsPk *bbntypes.BIP340PubKey
-	switch v := pubKey.(type) {
-	case *secp256k1.PubKey:
-		pk, err := btcec.ParsePubKey(v.Key)
-		if err != nil {
-			return nil, err
-		}
-		eotsPk = bbntypes.NewBIP340PubKeyFromBTCPK(pk)
-		return eotsPk, nil
-	default:
-		return nil, fmt.Errorf("unsupported key type in keyring")
+	prList, err := kb.PubRandList(keyName, lm.keyPassphrase(keyName, passphrase), chainID, startHeight, num)
+	if err != nil {
+		return nil, err
+	}
+	if len(prList) != int(num) {
+		return nil, fmt.Errorf("the key backend returned %d public randomness while %d are requested", len(prList), num)
 	}
-}
-
-// TODO the current implementation is a PoC, which does not contain any anti-slasher mechanism
-//
-//	a simple anti-slasher mechanism could be that the manager remembers the tuple (fpPk, chainID, height) or
-//	the hash of each generated randomness and return error if the same randomness is requested twice
-func (lm *LocalEOTSManager) CreateRandomnessPairList(fpPk []byte, chainID []byte, startHeight uint64, num uint32, passphrase string) ([]*btcec.FieldVal, error) {
-	prList := make([]*btcec.FieldVal, 0, num)
-
-	for i := uint32(0); i < num; i++ {
-		height := startHeight + uint64(i)
-		_, pubRand, err := lm.getRandomnessPair(fpPk, chainID, height, passphrase)
-		if err != nil {
-			return nil, err
-		}
 
-		prList = append(prList, pubRand)
+	if err := lm.es.AddRandChainID(fpPk, chainID); err != nil {
+		return nil, fmt.Errorf("failed to record the chain id of the randomness: %w", err)
 	}
+
 	lm.metrics.IncrementEotsFpTotalGeneratedRandomnessCounter(hex.EncodeToString(fpPk))
 	lm.metrics.SetEotsFpLastGeneratedRandomnessHeight(hex.EncodeToString(fpPk), float64(startHeight))
 
 	return prList, nil
 }
 
+// SignEOTS signs the given message at the given height with the EOTS key and the
+// randomness of that height. Each signature is persisted so that signing the same
+// message again returns the same signature, while signing a different message at
+// an already signed height fails with ErrDoubleSign, as it would leak the key
 func (lm *LocalEOTSManager) SignEOTS(fpPk []byte, chainID []byte, msg []byte, height uint64, passphrase string) (*btcec.ModNScalar, error) {
-	privRand, _, err := lm.getRandomnessPair(fpPk, chainID, height, passphrase)
+	sigs, err := lm.SignEOTSBatch(fpPk, chainID, []*eotstypes.EOTSSignRequest{{Height: height, Msg: msg}}, passphrase)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get private randomness: %w", err)
+		return nil, err
 	}
 
-	privKey, err := lm.getEOTSPrivKey(fpPk, passphrase)
+	return sigs[0], nil
+}
+
+// SignEOTSBatch signs the given messages at their heights with the EOTS key, which
+// is decrypted only once. All the requests are checked against the signing records
+// before any of them is signed, and the new signatures are persisted atomically
+func (lm *LocalEOTSManager) SignEOTSBatch(fpPk []byte, chainID []byte, reqs []*eotstypes.EOTSSignRequest, passphrase string) ([]*btcec.ModNScalar, error) {
+	return lm.signEOTSBatch(lm.kb, fpPk, chainID, reqs, passphrase)
+}
+
+func (lm *LocalEOTSManager) signEOTSBatch(kb KeyBackend, fpPk []byte, chainID []byte, reqs []*eotstypes.EOTSSignRequest, passphrase string) ([]*btcec.ModNScalar, error) {
+	lm.signMu.Lock()
+	defer lm.signMu.Unlock()
+
+	sigs := make([]*btcec.ModNScalar, len(reqs))
+	// the indices of the requests to be signed, by height
+	toSign := make(map[uint64]int, len(reqs))
+	for i, req := range reqs {
+		if j, ok := toSign[req.Height]; ok {
+			if !bytes.Equal(reqs[j].Msg, req.Msg) {
+				return nil, fmt.Errorf("%w: conflicting messages at height %d in the batch",
+					eotstypes.ErrDoubleSign, req.Height)
+			}
+			continue
+		}
+
+		record, found, err := lm.es.GetSignRecord(fpPk, chainID, req.Height)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the signing record: %w", err)
+		}
+		if !found {
+			toSign[req.Height] = i
+			continue
+		}
+		if !bytes.Equal(record.Msg, req.Msg) {
+			lm.logger.Error("refused to sign a conflicting message",
+				zap.String("eots_pk", hex.EncodeToString(fpPk)),
+				zap.Uint64("height", req.Height),
+			)
+			return nil, fmt.Errorf("%w: height %d", eotstypes.ErrDoubleSign, req.Height)
+		}
+
+		var sig btcec.ModNScalar
+		sig.SetByteSlice(record.EotsSig)
+		sigs[i] = &sig
+	}
+
+	if len(toSign) == 0 {
+		return sigs, nil
+	}
+
+	keyName, err := lm.es.GetEOTSKeyName(fpPk)
+	if err != nil {
+		return nil, err
+	}
+
+	toSignReqs := make([]*eotstypes.EOTSSignRequest, 0, len(toSign))
+	for i, req := range reqs {
+		if sigs[i] == nil && toSign[req.Height] == i {
+			toSignReqs = append(toSignReqs, req)
+		}
+	}
+	newSigs, err := kb.SignEOTS(keyName, lm.keyPassphrase(keyName, passphrase), chainID, toSignReqs)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get EOTS private key: %w", err)
+		return nil, fmt.Errorf("failed to sign EOTS: %w", err)
+	}
+	if len(newSigs) != len(toSignReqs) {
+		return nil, fmt.Errorf("the key backend returned %d EOTS signatures while %d are requested", len(newSigs), len(toSignReqs))
+	}
+	// a backend outside the EOTS manager may be faulty or compromised, so its
+	// signatures are verified before they are persisted and handed out
+	if _, ok := kb.(PrivKeyBackend); !ok {
+		if err := verifyEOTSSigs(kb, keyName, lm.keyPassphrase(keyName, passphrase), fpPk, chainID, toSignReqs, newSigs); err != nil {
+			lm.logger.Error("the key backend returned an invalid EOTS signature",
+				zap.String("eots_pk", hex.EncodeToString(fpPk)),
+				zap.Error(err),
+			)
+			return nil, err
+		}
+	}
+
+	newRecords := make([]*proto.SigningRecordEntry, 0, len(toSign))
+	now := time.Now().Unix()
+	for i, req := range reqs {
+		if sigs[i] != nil {
+			continue
+		}
+		// the request is a repetition of an earlier one in the batch
+		if j := toSign[req.Height]; j != i {
+			sigs[i] = sigs[j]
+			continue
+		}
+
+		sig := newSigs[len(newRecords)]
+		sigs[i] = sig
+
+		sigBytes := sig.Bytes()
+		newRecords = append(newRecords, &proto.SigningRecordEntry{
+			ChainId: chainID,
+			Height:  req.Height,
+			Record: &proto.SigningRecord{
+				Msg:       req.Msg,
+				EotsSig:   sigBytes[:],
+				Timestamp: now,
+			},
+		})
+	}
+
+	if err := lm.es.SaveSignRecords(fpPk, newRecords); err != nil {
+		return nil, fmt.Errorf("failed to save the signing records: %w", err)
 	}
 
 	// Update metrics
-	lm.metrics.IncrementEotsFpTotalEotsSignCounter(hex.EncodeToString(fpPk))
-	lm.metrics.SetEotsFpLastEotsSignHeight(hex.EncodeToString(fpPk), float64(height))
+	for _, r := range newRecords {
+		lm.metrics.IncrementEotsFpTotalEotsSignCounter(hex.EncodeToString(fpPk))
+		lm.metrics.SetEotsFpLastEotsSignHeight(hex.EncodeToString(fpPk), float64(r.Height))
+	}
+
+	return sigs, nil
+}
+
+// verifyEOTSSigs verifies each signature against the public key and the public
+// randomness of the height of its request, as derived by the key backend
+func verifyEOTSSigs(kb KeyBackend, keyName, passphrase string, fpPk []byte, chainID []byte, reqs []*eotstypes.EOTSSignRequest, sigs []*btcec.ModNScalar) error {
+	pk, err := schnorr.ParsePubKey(fpPk)
+	if err != nil {
+		return fmt.Errorf("invalid EOTS public key: %w", err)
+	}
+
+	for i, req := range reqs {
+		prList, err := kb.PubRandList(keyName, passphrase, chainID, req.Height, 1)
+		if err != nil {
+			return fmt.Errorf("failed to get the public randomness of height %d: %w", req.Height, err)
+		}
+		if len(prList) != 1 {
+			return fmt.Errorf("the key backend returned %d public randomness values for height %d", len(prList), req.Height)
+		}
+		if err := eots.Verify(pk, prList[0], req.Msg, sigs[i]); err != nil {
+			return fmt.Errorf("%w at height %d: %v", ErrInvalidEOTSSig, req.Height, err)
+		}
+	}
 
-	return eots.Sign(privKey, privRand, msg)
+	return nil
 }
 
 func (lm *LocalEOTSManager) SignSchnorrSig(fpPk []byte, msg []byte, passphrase string) (*schnorr.Signature, error) {
-	privKey, err := lm.getEOTSPrivKey(fpPk, passphrase)
+	keyName, err := lm.es.GetEOTSKeyName(fpPk)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get EOTS private key: %w", err)
+		return nil, err
 	}
 
-	return lm.signSchnorrSigFromPrivKey(privKey, fpPk, msg)
+	return lm.signSchnorrSig(lm.kb, keyName, passphrase, fpPk, msg)
 }
 
-// signSchnorrSigFromPrivKey signs a Schnorr signature using the private key and updates metrics by the fpPk
-func (lm *LocalEOTSManager) signSchnorrSigFromPrivKey(privKey *btcec.PrivateKey, fpPk []byte, msg []byte) (*schnorr.Signature, error) {
+// signSchnorrSig signs a Schnorr signature using the key with the given name and updates metrics by the fpPk
+func (lm *LocalEOTSManager) signSchnorrSig(kb KeyBackend, keyName, passphrase string, fpPk []byte, msg []byte) (*schnorr.Signature, error) {
 	// Update metrics
 	lm.metrics.IncrementEotsFpTotalSchnorrSignCounter(hex.EncodeToString(fpPk))
-	return schnorr.Sign(privKey, msg)
+	return kb.SignSchnorr(keyName, lm.keyPassphrase(keyName, passphrase), msg)
 }
 
 func (lm *LocalEOTSManager) SignSchnorrSigFromKeyname(keyName, passphrase string, msg []byte) (*schnorr.Signature, *bbntypes.BIP340PubKey, error) {
-	lm.input.Reset(passphrase)
-	k, err := lm.kr.Key(keyName)
+	eotsPk, err := lm.LoadBIP340PubKeyFromKeyName(keyName)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to load keyring record for key %s: %w", keyName, err)
+		return nil, nil, err
 	}
 
-	eotsPk, err := loadBIP340PubKeyFromKeyringRecord(k)
+	signature, err := lm.signSchnorrSig(lm.kb, keyName, passphrase, *eotsPk, msg)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, fmt.Errorf("failed to schnorr sign: %w", err)
 	}
+	return signature, eotsPk, nil
+}
 
-	privKey, err := eotsPrivKeyFromRecord(k)
+// LoadBIP340PubKeyFromKeyName returns the public key of the key with the given name
+func (lm *LocalEOTSManager) LoadBIP340PubKeyFromKeyName(keyName string) (*bbntypes.BIP340PubKey, error) {
+	pk, err := lm.kb.PubKey(keyName)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	signature, err := lm.signSchnorrSigFromPrivKey(privKey, *eotsPk, msg)
+	return bbntypes.NewBIP340PubKeyFromBTCPK(pk), nil
+}
+
+func (lm *LocalEOTSManager) ListKeys() ([]*eotstypes.KeyInfo, error) {
+	keys, err := lm.es.ListKeys()
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to schnorr sign: %w", err)
+		return nil, fmt.Errorf("failed to list keys: %w", err)
 	}
-	return signature, eotsPk, nil
+
+	return KeyInfoListFromProto(keys), nil
 }
 
-func (lm *LocalEOTSManager) Close() error {
-	return nil
+// KeyInfoListFromProto converts the key information from its proto format
+func KeyInfoListFromProto(keys []*proto.KeyInfo) []*eotstypes.KeyInfo {
+	infos := make([]*eotstypes.KeyInfo, 0, len(keys))
+	for _, k := range keys {
+		info := &eotstypes.KeyInfo{
+			Name:     k.Name,
+			PubKey:   k.Pk,
+			ChainIDs: k.ChainIds,
+		}
+		if k.CreatedAt != 0 {
+			info.CreatedAt = time.Unix(k.CreatedAt, 0)
+		}
+		infos = append(infos, info)
+	}
+
+	return infos
 }
 
-// getRandomnessPair returns a randomness pair generated based on the given finality provider key, chainID and height
-func (lm *LocalEOTSManager) getRandomnessPair(fpPk []byte, chainID []byte, height uint64, passphrase string) (*eots.PrivateRand, *eots.PublicRand, error) {
-	record, err := lm.KeyRecord(fpPk, passphrase)
-	if err != nil {
-		return nil, nil, err
+// Close wipes the keys of all the unlock sessions and closes the key backend
+// if it holds any resource, such as the connection to an external signer
+func (lm *LocalEOTSManager) Close() error {
+	lm.sessions.removeAll()
+
+	if c, ok := lm.kb.(io.Closer); ok {
+		return c.Close()
 	}
-	privRand, pubRand := randgenerator.GenerateRandomness(record.PrivKey.Serialize(), chainID, height)
-	return privRand, pubRand, nil
+
+	return nil
 }
 
+// KeyRecord returns the name and the private key of the key. It fails with
+// ErrPrivKeyNotExposed if the key backend does not hold the private keys
 // TODO: we ignore passPhrase in local implementation for now
 func (lm *LocalEOTSManager) KeyRecord(fpPk []byte, passphrase string) (*eotstypes.KeyRecord, error) {
 	name, err := lm.es.GetEOTSKeyName(fpPk)
@@ -275,36 +454,35 @@ func (lm *LocalEOTSManager) KeyRecord(fpPk []byte, passphrase string) (*eotstype
 }
 
 func (lm *LocalEOTSManager) getEOTSPrivKey(fpPk []byte, passphrase string) (*btcec.PrivateKey, error) {
-	lm.mu.Lock()
-	defer lm.mu.Unlock()
-	keyName, err := lm.es.GetEOTSKeyName(fpPk)
+	pkb, err := lm.privKeyBackend()
 	if err != nil {
 		return nil, err
 	}
 
-	lm.input.Reset(passphrase)
-	k, err := lm.kr.Key(keyName)
+	keyName, err := lm.es.GetEOTSKeyName(fpPk)
 	if err != nil {
 		return nil, err
 	}
 
-	return eotsPrivKeyFromRecord(k)
+	// the passphrases set for the keys only serve signing, so the private
+	// key is never exposed to a caller who does not know its passphrase
+	return pkb.PrivKey(keyName, passphrase)
 }
 
-func eotsPrivKeyFromRecord(k *keyring.Record) (*btcec.PrivateKey, error) {
-	privKeyCached := k.GetLocal().PrivKey.GetCachedValue()
+// SetKeyPassphrases sets the passphrases of the keys by name, which are used
+// to decrypt the keys when a signing or randomness request comes without a
+// passphrase. They are never used to expose or unlock the keys. It should be
+// called before the EOTS manager serves any request
+func (lm *LocalEOTSManager) SetKeyPassphrases(passphrases map[string]string) {
+	lm.passphrases = passphrases
+}
 
-	var privKey *btcec.PrivateKey
-	switch v := privKeyCached.(type) {
-	case *secp256k1.PrivKey:
-		privKey, _ = btcec.PrivKeyFromBytes(v.Key)
-		return privKey, nil
-	default:
-		return nil, fmt.Errorf("unsupported key type in keyring")
+// keyPassphrase returns the given passphrase, or the one set for the key if
+// it is empty
+func (lm *LocalEOTSManager) keyPassphrase(keyName, passphrase string) string {
+	if passphrase != "" {
+		return passphrase
 	}
-}
 
-func (lm *LocalEOTSManager) keyExists(name string) bool {
-	_, err := lm.kr.Key(name)
-	return err == nil
+	return lm.passphrases[keyName]
 }
@@ -1,17 +1,27 @@
 package eotsmanager_test
 
 import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"math/rand"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/babylonchain/babylon/crypto/eots"
 	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
 	"github.com/babylonchain/finality-provider/eotsmanager"
 	eotscfg "github.com/babylonchain/finality-provider/eotsmanager/config"
+	"github.com/babylonchain/finality-provider/eotsmanager/extsigner"
+	"github.com/babylonchain/finality-provider/eotsmanager/store"
 	"github.com/babylonchain/finality-provider/eotsmanager/types"
 	"github.com/babylonchain/finality-provider/testutil"
 )
@@ -92,3 +102,545 @@ func FuzzCreateRandomnessPairList(f *testing.F) {
 		}
 	})
 }
+
+// FuzzListKeys tests that the listed keys include the creation time and the
+// chains for which randomness has been generated
+func FuzzListKeys(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		homeDir := filepath.Join(t.TempDir(), "eots-home")
+		eotsCfg := eotscfg.DefaultConfigWithHomePath(homeDir)
+		dbBackend, err := eotsCfg.DatabaseConfig.GetDbBackend()
+		require.NoError(t, err)
+		defer dbBackend.Close()
+		lm, err := eotsmanager.NewLocalEOTSManager(homeDir, eotsCfg.KeyringBackend, dbBackend, zap.NewNop())
+		require.NoError(t, err)
+
+		keys, err := lm.ListKeys()
+		require.NoError(t, err)
+		require.Empty(t, keys)
+
+		num := r.Intn(3) + 1
+		expectedPks := make(map[string][]byte, num)
+		for i := 0; i < num; i++ {
+			fpName := testutil.GenRandomHexStr(r, 4)
+			fpPk, err := lm.CreateKey(fpName, passphrase, hdPath)
+			require.NoError(t, err)
+			expectedPks[fpName] = fpPk
+		}
+
+		// generating randomness twice for the same chain records it once
+		var randName string
+		for name := range expectedPks {
+			randName = name
+			break
+		}
+		chainID := datagen.GenRandomByteArray(r, 10)
+		for i := 0; i < 2; i++ {
+			_, err = lm.CreateRandomnessPairList(expectedPks[randName], chainID, 1, 1, passphrase)
+			require.NoError(t, err)
+		}
+
+		keys, err = lm.ListKeys()
+		require.NoError(t, err)
+		require.Len(t, keys, num)
+		for _, k := range keys {
+			require.Equal(t, expectedPks[k.Name], k.PubKey)
+			require.False(t, k.CreatedAt.IsZero())
+			if k.Name == randName {
+				require.Equal(t, [][]byte{chainID}, k.ChainIDs)
+			} else {
+				require.Empty(t, k.ChainIDs)
+			}
+		}
+	})
+}
+
+// FuzzSignEOTSDoubleSign tests that signing a conflicting message at a signed
+// height is refused while signing the same message is idempotent, even after
+// the EOTS manager is restarted
+func FuzzSignEOTSDoubleSign(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		fpName := testutil.GenRandomHexStr(r, 4)
+		homeDir := filepath.Join(t.TempDir(), "eots-home")
+		eotsCfg := eotscfg.DefaultConfigWithHomePath(homeDir)
+		dbBackend, err := eotsCfg.DatabaseConfig.GetDbBackend()
+		require.NoError(t, err)
+		defer func() {
+			err := os.RemoveAll(homeDir)
+			require.NoError(t, err)
+		}()
+
+		lm, err := eotsmanager.NewLocalEOTSManager(homeDir, eotsCfg.KeyringBackend, dbBackend, zap.NewNop())
+		require.NoError(t, err)
+
+		fpPk, err := lm.CreateKey(fpName, passphrase, hdPath)
+		require.NoError(t, err)
+
+		chainID := datagen.GenRandomByteArray(r, 10)
+		height := datagen.RandomInt(r, 100)
+		msg := datagen.GenRandomByteArray(r, 32)
+		conflictingMsg := datagen.GenRandomByteArray(r, 32)
+
+		sig, err := lm.SignEOTS(fpPk, chainID, msg, height, passphrase)
+		require.NoError(t, err)
+
+		sameSig, err := lm.SignEOTS(fpPk, chainID, msg, height, passphrase)
+		require.NoError(t, err)
+		require.True(t, sig.Equals(sameSig))
+
+		_, err = lm.SignEOTS(fpPk, chainID, conflictingMsg, height, passphrase)
+		require.ErrorIs(t, err, types.ErrDoubleSign)
+
+		// the signing records survive a restart
+		err = dbBackend.Close()
+		require.NoError(t, err)
+		dbBackend, err = eotsCfg.DatabaseConfig.GetDbBackend()
+		require.NoError(t, err)
+		defer dbBackend.Close()
+		lm, err = eotsmanager.NewLocalEOTSManager(homeDir, eotsCfg.KeyringBackend, dbBackend, zap.NewNop())
+		require.NoError(t, err)
+
+		_, err = lm.SignEOTS(fpPk, chainID, conflictingMsg, height, passphrase)
+		require.ErrorIs(t, err, types.ErrDoubleSign)
+
+		sameSig, err = lm.SignEOTS(fpPk, chainID, msg, height, passphrase)
+		require.NoError(t, err)
+		require.True(t, sig.Equals(sameSig))
+	})
+}
+
+// FuzzSignEOTSBatch tests that a batch is signed the same as one-by-one and
+// that a batch with any conflicting height is refused as a whole
+func FuzzSignEOTSBatch(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		fpName := testutil.GenRandomHexStr(r, 4)
+		homeDir := filepath.Join(t.TempDir(), "eots-home")
+		eotsCfg := eotscfg.DefaultConfigWithHomePath(homeDir)
+		dbBackend, err := eotsCfg.DatabaseConfig.GetDbBackend()
+		require.NoError(t, err)
+		defer func() {
+			dbBackend.Close()
+			err := os.RemoveAll(homeDir)
+			require.NoError(t, err)
+		}()
+
+		lm, err := eotsmanager.NewLocalEOTSManager(homeDir, eotsCfg.KeyringBackend, dbBackend, zap.NewNop())
+		require.NoError(t, err)
+
+		fpPk, err := lm.CreateKey(fpName, passphrase, hdPath)
+		require.NoError(t, err)
+
+		chainID := datagen.GenRandomByteArray(r, 10)
+		startHeight := datagen.RandomInt(r, 100)
+		num := int(datagen.RandomInt(r, 10)) + 2
+		reqs := make([]*types.EOTSSignRequest, 0, num)
+		for i := 0; i < num; i++ {
+			reqs = append(reqs, &types.EOTSSignRequest{
+				Height: startHeight + uint64(i),
+				Msg:    datagen.GenRandomByteArray(r, 32),
+			})
+		}
+
+		sigs, err := lm.SignEOTSBatch(fpPk, chainID, reqs, passphrase)
+		require.NoError(t, err)
+		require.Len(t, sigs, num)
+
+		// the batch is idempotent and agrees with signing one-by-one
+		sameSigs, err := lm.SignEOTSBatch(fpPk, chainID, reqs, passphrase)
+		require.NoError(t, err)
+		for i, req := range reqs {
+			require.True(t, sigs[i].Equals(sameSigs[i]))
+			sig, err := lm.SignEOTS(fpPk, chainID, req.Msg, req.Height, passphrase)
+			require.NoError(t, err)
+			require.True(t, sigs[i].Equals(sig))
+		}
+
+		// a new batch overlapping a signed height with a conflicting message
+		// is refused without signing any of its heights
+		newReqs := []*types.EOTSSignRequest{
+			{Height: startHeight + uint64(num), Msg: datagen.GenRandomByteArray(r, 32)},
+			{Height: startHeight, Msg: datagen.GenRandomByteArray(r, 32)},
+		}
+		_, err = lm.SignEOTSBatch(fpPk, chainID, newReqs, passphrase)
+		require.ErrorIs(t, err, types.ErrDoubleSign)
+		_, err = lm.SignEOTS(fpPk, chainID, datagen.GenRandomByteArray(r, 32), newReqs[0].Height, passphrase)
+		require.NoError(t, err)
+
+		// conflicting messages within a batch are refused
+		height := startHeight + uint64(num) + 1
+		_, err = lm.SignEOTSBatch(fpPk, chainID, []*types.EOTSSignRequest{
+			{Height: height, Msg: datagen.GenRandomByteArray(r, 32)},
+			{Height: height, Msg: datagen.GenRandomByteArray(r, 32)},
+		}, passphrase)
+		require.ErrorIs(t, err, types.ErrDoubleSign)
+	})
+}
+
+// FuzzExportImportKey tests that an exported key is imported along with its
+// metadata and signing history, so that double signing is still refused
+func FuzzExportImportKey(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 5)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		newManager := func() *eotsmanager.LocalEOTSManager {
+			homeDir := filepath.Join(t.TempDir(), "eots-home")
+			eotsCfg := eotscfg.DefaultConfigWithHomePath(homeDir)
+			dbBackend, err := eotsCfg.DatabaseConfig.GetDbBackend()
+			require.NoError(t, err)
+			t.Cleanup(func() { dbBackend.Close() })
+			lm, err := eotsmanager.NewLocalEOTSManager(homeDir, eotsCfg.KeyringBackend, dbBackend, zap.NewNop())
+			require.NoError(t, err)
+			return lm
+		}
+		lm := newManager()
+
+		fpName := testutil.GenRandomHexStr(r, 4)
+		fpPk, err := lm.CreateKey(fpName, passphrase, hdPath)
+		require.NoError(t, err)
+
+		chainID := datagen.GenRandomByteArray(r, 10)
+		height := datagen.RandomInt(r, 100)
+		_, err = lm.CreateRandomnessPairList(fpPk, chainID, height, 1, passphrase)
+		require.NoError(t, err)
+		msg := datagen.GenRandomByteArray(r, 32)
+		sig, err := lm.SignEOTS(fpPk, chainID, msg, height, passphrase)
+		require.NoError(t, err)
+
+		backupPassphrase := testutil.GenRandomHexStr(r, 8)
+		armor, err := lm.ExportKey(fpPk, passphrase, backupPassphrase)
+		require.NoError(t, err)
+
+		// the key already exists in the exporting manager
+		_, err = lm.ImportKey(armor, backupPassphrase, "", passphrase)
+		require.ErrorIs(t, err, store.ErrDuplicateEOTSKeyName)
+
+		lm2 := newManager()
+		_, err = lm2.ImportKey(armor, "wrong-passphrase", "", passphrase)
+		require.Error(t, err)
+
+		eotsPk, err := lm2.ImportKey(armor, backupPassphrase, "", passphrase)
+		require.NoError(t, err)
+		require.Equal(t, fpPk, eotsPk.MustMarshal())
+
+		keys, err := lm.ListKeys()
+		require.NoError(t, err)
+		importedKeys, err := lm2.ListKeys()
+		require.NoError(t, err)
+		require.Equal(t, keys, importedKeys)
+
+		// the signing history is imported along with the key
+		sig2, err := lm2.SignEOTS(fpPk, chainID, msg, height, passphrase)
+		require.NoError(t, err)
+		require.Equal(t, sig, sig2)
+		_, err = lm2.SignEOTS(fpPk, chainID, datagen.GenRandomByteArray(r, 32), height, passphrase)
+		require.ErrorIs(t, err, types.ErrDoubleSign)
+	})
+}
+
+// FuzzSplitRecoverKey tests that a key split into shares is recovered on a
+// fresh home from any threshold of them, while insufficient or tampered
+// shares are refused
+func FuzzSplitRecoverKey(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 5)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		newManager := func() (*eotsmanager.LocalEOTSManager, kvdb.Backend) {
+			homeDir := filepath.Join(t.TempDir(), "eots-home")
+			eotsCfg := eotscfg.DefaultConfigWithHomePath(homeDir)
+			dbBackend, err := eotsCfg.DatabaseConfig.GetDbBackend()
+			require.NoError(t, err)
+			t.Cleanup(func() { dbBackend.Close() })
+			lm, err := eotsmanager.NewLocalEOTSManager(homeDir, eotsCfg.KeyringBackend, dbBackend, zap.NewNop())
+			require.NoError(t, err)
+			return lm, dbBackend
+		}
+		lm, dbBackend := newManager()
+
+		fpName := testutil.GenRandomHexStr(r, 4)
+		fpPk, err := lm.CreateKey(fpName, passphrase, hdPath)
+		require.NoError(t, err)
+
+		total := uint32(r.Intn(5) + 2)
+		threshold := uint32(r.Intn(int(total)-1) + 2)
+		shares, err := lm.SplitKey(fpPk, passphrase, threshold, total)
+		require.NoError(t, err)
+		require.Len(t, shares, int(total))
+
+		lm2, _ := newManager()
+		r.Shuffle(len(shares), func(i, j int) { shares[i], shares[j] = shares[j], shares[i] })
+
+		// insufficient shares
+		_, err = lm2.RecoverKeyFromShares(shares[:threshold-1], "", passphrase, true)
+		require.Error(t, err)
+
+		// a share of another split of the same key
+		otherShares, err := lm.SplitKey(fpPk, passphrase, threshold, total)
+		require.NoError(t, err)
+		mixed := append([]string{otherShares[0]}, shares[1:threshold]...)
+		_, err = lm2.RecoverKeyFromShares(mixed, "", passphrase, true)
+		require.Error(t, err)
+
+		// the database has no signing history of the key
+		_, err = lm2.RecoverKeyFromShares(shares[:threshold], "", passphrase, false)
+		require.ErrorIs(t, err, eotsmanager.ErrNoSignHistory)
+
+		eotsPk, err := lm2.RecoverKeyFromShares(shares[:threshold], "", passphrase, true)
+		require.NoError(t, err)
+		require.Equal(t, fpPk, eotsPk.MustMarshal())
+
+		record, err := lm.KeyRecord(fpPk, passphrase)
+		require.NoError(t, err)
+		recovered, err := lm2.KeyRecord(fpPk, passphrase)
+		require.NoError(t, err)
+		require.Equal(t, record.Name, recovered.Name)
+		require.True(t, record.PrivKey.Key.Equals(&recovered.PrivKey.Key))
+
+		// the key already exists
+		_, err = lm2.RecoverKeyFromShares(shares, "", passphrase, true)
+		require.ErrorIs(t, err, store.ErrDuplicateEOTSKeyName)
+
+		// the key keeps its signing history once the signing records are
+		// restored in the database
+		chainID := []byte("test-chain")
+		height := uint64(r.Int63n(1000) + 1)
+		msg := testutil.GenRandomByteArray(r, 32)
+		_, err = lm.SignEOTS(fpPk, chainID, msg, height, passphrase)
+		require.NoError(t, err)
+		lm3, dbBackend3 := newManager()
+		_, err = store.CopySignRecords(dbBackend, dbBackend3)
+		require.NoError(t, err)
+		_, err = lm3.RecoverKeyFromShares(shares[:threshold], "", passphrase, false)
+		require.NoError(t, err)
+		_, err = lm3.SignEOTS(fpPk, chainID, testutil.GenRandomByteArray(r, 32), height, passphrase)
+		require.ErrorIs(t, err, types.ErrDoubleSign)
+	})
+}
+
+// FuzzExternalKeyBackend tests that an EOTS manager using an external signer
+// derives the same randomness and signatures as the keyring for the same key,
+// enforces the anti-slashing protection, and does not expose the private key
+func FuzzExternalKeyBackend(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		fpName := testutil.GenRandomHexStr(r, 4)
+		homeDir := filepath.Join(t.TempDir(), "eots-home")
+		eotsCfg := eotscfg.DefaultConfigWithHomePath(homeDir)
+		dbBackend, err := eotsCfg.DatabaseConfig.GetDbBackend()
+		require.NoError(t, err)
+		defer dbBackend.Close()
+		lm, err := eotsmanager.NewLocalEOTSManager(homeDir, eotsCfg.KeyringBackend, dbBackend, zap.NewNop())
+		require.NoError(t, err)
+		require.True(t, lm.HoldsPrivKeys())
+
+		fpPk, err := lm.CreateKey(fpName, passphrase, hdPath)
+		require.NoError(t, err)
+		record, err := lm.KeyRecord(fpPk, passphrase)
+		require.NoError(t, err)
+
+		// move the key into the key file of a file signer
+		keyFile := filepath.Join(t.TempDir(), "keys.json")
+		keysBytes, err := json.Marshal(map[string]string{fpName: hex.EncodeToString(record.PrivKey.Serialize())})
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(keyFile, keysBytes, 0600))
+		signer, err := extsigner.NewFileSigner(keyFile)
+		require.NoError(t, err)
+
+		extCfg := eotscfg.DefaultConfigWithHomePath(filepath.Join(t.TempDir(), "eots-home"))
+		extDbBackend, err := extCfg.DatabaseConfig.GetDbBackend()
+		require.NoError(t, err)
+		defer extDbBackend.Close()
+		extLm, err := eotsmanager.NewLocalEOTSManagerWithKeyBackend(signer, extDbBackend, zap.NewNop())
+		require.NoError(t, err)
+		require.False(t, extLm.HoldsPrivKeys())
+
+		// the moved key is found by its name, while new keys are generated
+		// by the signer
+		loadedPk, err := extLm.LoadBIP340PubKeyFromKeyName(fpName)
+		require.NoError(t, err)
+		require.Equal(t, fpPk, loadedPk.MustMarshal())
+		newPk, err := extLm.CreateKey(testutil.GenRandomHexStr(r, 5), passphrase, hdPath)
+		require.NoError(t, err)
+
+		chainID := datagen.GenRandomByteArray(r, 10)
+		startHeight := r.Uint64() % 1000
+		num := r.Uint32()%10 + 1
+		prList, err := extLm.CreateRandomnessPairList(newPk, chainID, startHeight, num, passphrase)
+		require.NoError(t, err)
+		require.Len(t, prList, int(num))
+
+		msg := datagen.GenRandomByteArray(r, 32)
+		sig, err := extLm.SignEOTS(newPk, chainID, msg, startHeight, passphrase)
+		require.NoError(t, err)
+		btcPk, err := schnorr.ParsePubKey(newPk)
+		require.NoError(t, err)
+		require.NoError(t, eots.Verify(btcPk, prList[0], msg, sig))
+		_, err = extLm.SignEOTS(newPk, chainID, datagen.GenRandomByteArray(r, 32), startHeight, passphrase)
+		require.ErrorIs(t, err, types.ErrDoubleSign)
+
+		_, err = extLm.KeyRecord(newPk, passphrase)
+		require.ErrorIs(t, err, eotsmanager.ErrPrivKeyNotExposed)
+		_, err = extLm.ExportKey(newPk, passphrase, "backup")
+		require.ErrorIs(t, err, eotsmanager.ErrPrivKeyNotExposed)
+		_, err = extLm.SplitKey(newPk, passphrase, 2, 3)
+		require.ErrorIs(t, err, eotsmanager.ErrPrivKeyNotExposed)
+
+		// the moved key produces the same randomness and signatures as in
+		// the keyring
+		expectedPrList, err := lm.CreateRandomnessPairList(fpPk, chainID, startHeight, num, passphrase)
+		require.NoError(t, err)
+		sigs, err := signer.SignEOTS(fpName, passphrase, chainID, []*types.EOTSSignRequest{{Height: startHeight, Msg: msg}})
+		require.NoError(t, err)
+		expectedSig, err := lm.SignEOTS(fpPk, chainID, msg, startHeight, passphrase)
+		require.NoError(t, err)
+		require.Equal(t, expectedSig, sigs[0])
+		movedPrList, err := signer.PubRandList(fpName, passphrase, chainID, startHeight, num)
+		require.NoError(t, err)
+		require.Equal(t, expectedPrList, movedPrList)
+	})
+}
+
+// faultySigner is a file signer returning corrupted EOTS signatures
+type faultySigner struct {
+	*extsigner.FileSigner
+}
+
+func (s *faultySigner) SignEOTS(name, passphrase string, chainID []byte, reqs []*types.EOTSSignRequest) ([]*btcec.ModNScalar, error) {
+	sigs, err := s.FileSigner.SignEOTS(name, passphrase, chainID, reqs)
+	if err != nil {
+		return nil, err
+	}
+	for _, sig := range sigs {
+		sig.Add(new(btcec.ModNScalar).SetInt(1))
+	}
+
+	return sigs, nil
+}
+
+// FuzzFaultyKeyBackend tests that the invalid EOTS signatures of an external
+// signer are rejected without being persisted
+func FuzzFaultyKeyBackend(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		signer, err := extsigner.NewFileSigner(filepath.Join(t.TempDir(), "keys.json"))
+		require.NoError(t, err)
+		eotsCfg := eotscfg.DefaultConfigWithHomePath(filepath.Join(t.TempDir(), "eots-home"))
+		dbBackend, err := eotsCfg.DatabaseConfig.GetDbBackend()
+		require.NoError(t, err)
+		defer dbBackend.Close()
+		faultyLm, err := eotsmanager.NewLocalEOTSManagerWithKeyBackend(&faultySigner{FileSigner: signer}, dbBackend, zap.NewNop())
+		require.NoError(t, err)
+
+		fpPk, err := faultyLm.CreateKey(testutil.GenRandomHexStr(r, 4), passphrase, hdPath)
+		require.NoError(t, err)
+
+		chainID := datagen.GenRandomByteArray(r, 10)
+		height := r.Uint64() % 1000
+		_, err = faultyLm.SignEOTSBatch(fpPk, chainID, []*types.EOTSSignRequest{
+			{Height: height, Msg: datagen.GenRandomByteArray(r, 32)},
+			{Height: height + 1, Msg: datagen.GenRandomByteArray(r, 32)},
+		}, passphrase)
+		require.ErrorIs(t, err, eotsmanager.ErrInvalidEOTSSig)
+		require.NoError(t, faultyLm.Close())
+
+		// nothing is persisted, so the same key signs a different message at
+		// the height once the signer is fixed
+		lm, err := eotsmanager.NewLocalEOTSManagerWithKeyBackend(signer, dbBackend, zap.NewNop())
+		require.NoError(t, err)
+		msg := datagen.GenRandomByteArray(r, 32)
+		sig, err := lm.SignEOTS(fpPk, chainID, msg, height, passphrase)
+		require.NoError(t, err)
+		prList, err := lm.CreateRandomnessPairList(fpPk, chainID, height, 1, passphrase)
+		require.NoError(t, err)
+		btcPk, err := schnorr.ParsePubKey(fpPk)
+		require.NoError(t, err)
+		require.NoError(t, eots.Verify(btcPk, prList[0], msg, sig))
+	})
+}
+
+// FuzzUnlockSession tests that the key of an unlock session signs the same as
+// the passphrase, is bound to its key, and is wiped when the session is
+// locked, expires, or the manager is closed
+func FuzzUnlockSession(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		homeDir := filepath.Join(t.TempDir(), "eots-home")
+		eotsCfg := eotscfg.DefaultConfigWithHomePath(homeDir)
+		dbBackend, err := eotsCfg.DatabaseConfig.GetDbBackend()
+		require.NoError(t, err)
+		defer dbBackend.Close()
+
+		lm, err := eotsmanager.NewLocalEOTSManager(homeDir, eotsCfg.KeyringBackend, dbBackend, zap.NewNop())
+		require.NoError(t, err)
+
+		fpPk, err := lm.CreateKey(testutil.GenRandomHexStr(r, 4), passphrase, hdPath)
+		require.NoError(t, err)
+		otherPk, err := lm.CreateKey(testutil.GenRandomHexStr(r, 4)+"x", passphrase, hdPath)
+		require.NoError(t, err)
+
+		session, expiresAt, err := lm.UnlockKey(fpPk, passphrase, time.Hour)
+		require.NoError(t, err)
+		require.True(t, expiresAt.After(time.Now()))
+
+		chainID := datagen.GenRandomByteArray(r, 10)
+		startHeight := datagen.RandomInt(r, 100)
+		num := r.Uint32()%10 + 1
+		prList, err := lm.CreateRandomnessPairListWithSession(session, fpPk, chainID, startHeight, num)
+		require.NoError(t, err)
+		expectedPrList, err := lm.CreateRandomnessPairList(fpPk, chainID, startHeight, num, passphrase)
+		require.NoError(t, err)
+		require.Equal(t, expectedPrList, prList)
+
+		msg := datagen.GenRandomByteArray(r, 32)
+		sigs, err := lm.SignEOTSBatchWithSession(session, fpPk, chainID, []*types.EOTSSignRequest{{Height: startHeight, Msg: msg}})
+		require.NoError(t, err)
+		pk, err := schnorr.ParsePubKey(fpPk)
+		require.NoError(t, err)
+		require.NoError(t, eots.Verify(pk, prList[0], msg, sigs[0]))
+		_, err = lm.SignEOTSBatchWithSession(session, fpPk, chainID,
+			[]*types.EOTSSignRequest{{Height: startHeight, Msg: datagen.GenRandomByteArray(r, 32)}})
+		require.ErrorIs(t, err, types.ErrDoubleSign)
+
+		schnorrSig, err := lm.SignSchnorrSigWithSession(session, fpPk, msg)
+		require.NoError(t, err)
+		require.True(t, schnorrSig.Verify(msg, pk))
+
+		// the session cannot be used with another key
+		_, err = lm.SignSchnorrSigWithSession(session, otherPk, msg)
+		require.ErrorIs(t, err, types.ErrSessionNotFound)
+
+		require.NoError(t, lm.LockKey(session))
+		_, err = lm.SignSchnorrSigWithSession(session, fpPk, msg)
+		require.ErrorIs(t, err, types.ErrSessionNotFound)
+		require.ErrorIs(t, lm.LockKey(session), types.ErrSessionNotFound)
+
+		session, _, err = lm.UnlockKey(fpPk, passphrase, 50*time.Millisecond)
+		require.NoError(t, err)
+		require.Eventually(t, func() bool {
+			err := lm.LockKey(session)
+			return errors.Is(err, types.ErrSessionNotFound)
+		}, 5*time.Second, 10*time.Millisecond)
+
+		session, _, err = lm.UnlockKey(fpPk, passphrase, time.Hour)
+		require.NoError(t, err)
+		require.NoError(t, lm.Close())
+		_, err = lm.SignSchnorrSigWithSession(session, fpPk, msg)
+		require.ErrorIs(t, err, types.ErrSessionNotFound)
+	})
+}
@@ -0,0 +1,70 @@
+// Package lockedmem keeps secrets in memory outside of the Go heap, so that
+// they are not copied around by the garbage collector, not swapped to disk
+// where the platform allows locking the memory, and wiped once released.
+package lockedmem
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrReleased is returned when accessing a buffer which is already released
+var ErrReleased = errors.New("the locked buffer is released")
+
+// Buffer is a fixed-size buffer of protected memory
+type Buffer struct {
+	mu     sync.RWMutex
+	mem    []byte
+	locked bool
+}
+
+// FromBytes copies b into a new buffer and wipes b
+func FromBytes(b []byte) (*Buffer, error) {
+	mem, locked, err := alloc(len(b))
+	if err != nil {
+		return nil, err
+	}
+	copy(mem, b)
+	wipe(b)
+
+	return &Buffer{mem: mem, locked: locked}, nil
+}
+
+// Locked tells whether the memory of the buffer is locked against swapping.
+// Locking fails without an error if the platform does not support it or the
+// limit of locked memory of the process is reached
+func (b *Buffer) Locked() bool {
+	return b.locked
+}
+
+// Use calls f with the content of the buffer, which must not be retained by f
+func (b *Buffer) Use(f func(secret []byte) error) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.mem == nil {
+		return ErrReleased
+	}
+
+	return f(b.mem)
+}
+
+// Release wipes the buffer and frees its memory. It is a no-op on a released
+// buffer
+func (b *Buffer) Release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.mem == nil {
+		return
+	}
+	wipe(b.mem)
+	free(b.mem, b.locked)
+	b.mem = nil
+}
+
+func wipe(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
@@ -0,0 +1,11 @@
+//go:build !unix
+
+package lockedmem
+
+// alloc falls back to the Go heap where memory cannot be mapped and locked,
+// so the buffer is only wiped on release
+func alloc(size int) ([]byte, bool, error) {
+	return make([]byte, size), false, nil
+}
+
+func free([]byte, bool) {}
@@ -0,0 +1,33 @@
+//go:build unix
+
+package lockedmem
+
+import (
+	"fmt"
+
+	"golang.org/x/sys/unix"
+)
+
+// alloc maps anonymous memory for the buffer and tries to lock it
+func alloc(size int) ([]byte, bool, error) {
+	if size == 0 {
+		return []byte{}, false, nil
+	}
+
+	mem, err := unix.Mmap(-1, 0, size, unix.PROT_READ|unix.PROT_WRITE, unix.MAP_ANON|unix.MAP_PRIVATE)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to map memory: %w", err)
+	}
+
+	return mem, unix.Mlock(mem) == nil, nil
+}
+
+func free(mem []byte, locked bool) {
+	if len(mem) == 0 {
+		return
+	}
+	if locked {
+		_ = unix.Munlock(mem)
+	}
+	_ = unix.Munmap(mem)
+}
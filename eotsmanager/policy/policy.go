@@ -0,0 +1,342 @@
+package policy
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	bbntypes "github.com/babylonchain/babylon/types"
+	"golang.org/x/time/rate"
+
+	"github.com/babylonchain/finality-provider/metrics"
+)
+
+// The rules of a signing policy, which are reported as the reason of the
+// violations and as the label of the violation metrics
+const (
+	RuleNoPolicy                = "NO_POLICY"
+	RuleChainIDNotAllowed       = "CHAIN_ID_NOT_ALLOWED"
+	RuleHeightOutOfRange        = "HEIGHT_OUT_OF_RANGE"
+	RuleRandomnessBatchTooLarge = "RANDOMNESS_BATCH_TOO_LARGE"
+	RuleSignRateExceeded        = "SIGN_RATE_EXCEEDED"
+	RuleSchnorrSigNotAllowed    = "SCHNORR_SIG_NOT_ALLOWED"
+
+	// ErrorDomain is the domain of the violations reported over gRPC
+	ErrorDomain = "eotsd.policy"
+)
+
+// KeyPolicy is the signing policy of an EOTS key. Zero values mean no limit,
+// except that Schnorr signatures are only allowed if AllowSchnorrSig is set
+type KeyPolicy struct {
+	// AllowedChainIDs are the chains the key can generate randomness and
+	// sign for. All chains are allowed if it is empty
+	AllowedChainIDs []string `json:"allowed_chain_ids,omitempty"`
+	// MinHeight is the lowest height the key can generate randomness and sign at
+	MinHeight uint64 `json:"min_height,omitempty"`
+	// MaxHeight is the highest height the key can generate randomness and sign at
+	MaxHeight uint64 `json:"max_height,omitempty"`
+	// MaxRandomnessBatch is the maximum number of randomness pairs generated
+	// by a single call
+	MaxRandomnessBatch uint32 `json:"max_randomness_batch,omitempty"`
+	// MaxSignRate is the maximum number of signatures per second, where
+	// each height of a batch counts as one signature
+	MaxSignRate float64 `json:"max_sign_rate,omitempty"`
+	// MaxSignBurst is the maximum number of signatures made at once under
+	// MaxSignRate, which defaults to the rate rounded up
+	MaxSignBurst int `json:"max_sign_burst,omitempty"`
+	// AllowSchnorrSig permits Schnorr signatures over arbitrary messages
+	AllowSchnorrSig bool `json:"allow_schnorr_sig,omitempty"`
+}
+
+// Policy is the content of a policy file. The policy of a key is looked up
+// by the hex of its BIP-340 public key, and falls back to the default one.
+// Calls using a key without any policy are refused
+type Policy struct {
+	Default *KeyPolicy            `json:"default,omitempty"`
+	Keys    map[string]*KeyPolicy `json:"keys,omitempty"`
+}
+
+// Violation is the error of a call refused by a signing policy
+type Violation struct {
+	Rule   string
+	Reason string
+}
+
+func (v *Violation) Error() string {
+	return fmt.Sprintf("signing policy violation %s: %s", v.Rule, v.Reason)
+}
+
+// LoadPolicy reads and validates the policy file at the given path
+func LoadPolicy(path string) (*Policy, error) {
+	policyBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the policy file %s: %w", path, err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(policyBytes))
+	dec.DisallowUnknownFields()
+	var p Policy
+	if err := dec.Decode(&p); err != nil {
+		return nil, fmt.Errorf("failed to parse the policy file %s: %w", path, err)
+	}
+
+	if err := p.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid policy file %s: %w", path, err)
+	}
+
+	return &p, nil
+}
+
+// Validate checks that the keys of the policy are valid public keys and that
+// every key policy is consistent. The keys are normalized to lower case
+func (p *Policy) Validate() error {
+	if p.Default != nil {
+		if err := p.Default.Validate(); err != nil {
+			return fmt.Errorf("invalid default policy: %w", err)
+		}
+	}
+
+	keys := make(map[string]*KeyPolicy, len(p.Keys))
+	for pkHex, kp := range p.Keys {
+		if _, err := bbntypes.NewBIP340PubKeyFromHex(pkHex); err != nil {
+			return fmt.Errorf("invalid EOTS public key %s: %w", pkHex, err)
+		}
+		if kp == nil {
+			return fmt.Errorf("empty policy of key %s", pkHex)
+		}
+		if err := kp.Validate(); err != nil {
+			return fmt.Errorf("invalid policy of key %s: %w", pkHex, err)
+		}
+		keys[strings.ToLower(pkHex)] = kp
+	}
+	p.Keys = keys
+
+	return nil
+}
+
+func (kp *KeyPolicy) Validate() error {
+	if kp.MaxHeight != 0 && kp.MinHeight > kp.MaxHeight {
+		return fmt.Errorf("min_height %d is above max_height %d", kp.MinHeight, kp.MaxHeight)
+	}
+
+	if kp.MaxSignRate < 0 || math.IsInf(kp.MaxSignRate, 0) || math.IsNaN(kp.MaxSignRate) {
+		return fmt.Errorf("invalid max_sign_rate %v", kp.MaxSignRate)
+	}
+
+	if kp.MaxSignBurst < 0 {
+		return fmt.Errorf("negative max_sign_burst %d", kp.MaxSignBurst)
+	}
+
+	return nil
+}
+
+// Engine enforces the policy loaded from a policy file. The file can be
+// reloaded at any time, after which the new policy applies to the next calls
+type Engine struct {
+	path    string
+	metrics *metrics.EotsMetrics
+
+	mu       sync.Mutex
+	policy   *Policy
+	limiters map[string]*rate.Limiter
+}
+
+// NewEngine creates an engine enforcing the policy of the given file
+func NewEngine(path string) (*Engine, error) {
+	p, err := LoadPolicy(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Engine{
+		path:     path,
+		metrics:  metrics.NewEotsMetrics(),
+		policy:   p,
+		limiters: make(map[string]*rate.Limiter),
+	}, nil
+}
+
+// Reload reads the policy file again. The current policy is kept if the
+// file is invalid
+func (e *Engine) Reload() error {
+	p, err := LoadPolicy(e.path)
+	if err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.policy = p
+
+	return nil
+}
+
+// CheckCreateRandomness checks that the key can generate num randomness
+// pairs from the start height for the given chain
+func (e *Engine) CheckCreateRandomness(pk, chainID []byte, startHeight uint64, num uint32) error {
+	return e.check(pk, 0, func(kp *KeyPolicy) *Violation {
+		if v := kp.checkChainID(chainID); v != nil {
+			return v
+		}
+		if kp.MaxRandomnessBatch != 0 && num > kp.MaxRandomnessBatch {
+			return &Violation{
+				Rule:   RuleRandomnessBatchTooLarge,
+				Reason: fmt.Sprintf("%d randomness pairs are requested while at most %d are allowed", num, kp.MaxRandomnessBatch),
+			}
+		}
+		if num == 0 {
+			return nil
+		}
+		return kp.checkHeights(startHeight, startHeight+uint64(num)-1)
+	})
+}
+
+// CheckSignEOTS checks that the key can sign at the given heights for the
+// given chain, and consumes the signing rate of the key
+func (e *Engine) CheckSignEOTS(pk, chainID []byte, heights ...uint64) error {
+	return e.check(pk, len(heights), func(kp *KeyPolicy) *Violation {
+		if v := kp.checkChainID(chainID); v != nil {
+			return v
+		}
+		for _, h := range heights {
+			if v := kp.checkHeights(h, h); v != nil {
+				return v
+			}
+		}
+		return nil
+	})
+}
+
+// CheckSignSchnorrSig checks that the key can sign arbitrary messages, and
+// consumes the signing rate of the key
+func (e *Engine) CheckSignSchnorrSig(pk []byte) error {
+	return e.check(pk, 1, func(kp *KeyPolicy) *Violation {
+		if !kp.AllowSchnorrSig {
+			return &Violation{
+				Rule:   RuleSchnorrSigNotAllowed,
+				Reason: "Schnorr signatures over arbitrary messages are not allowed",
+			}
+		}
+		return nil
+	})
+}
+
+// HasPolicy tells whether the key has a policy of its own or falls back to
+// the default one, without which all its calls are refused. A nil engine
+// covers all keys
+func (e *Engine) HasPolicy(pk []byte) bool {
+	if e == nil {
+		return true
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	_, ok := e.policy.Keys[hex.EncodeToString(pk)]
+	return ok || e.policy.Default != nil
+}
+
+// check applies the given rules to the policy of the key. The signing rate
+// is only consumed if the call passes all the other rules. A nil engine
+// allows all calls
+func (e *Engine) check(pk []byte, numSigs int, rules func(kp *KeyPolicy) *Violation) error {
+	if e == nil {
+		return nil
+	}
+	pkHex := hex.EncodeToString(pk)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	v := e.checkLocked(pkHex, numSigs, rules)
+	if v == nil {
+		return nil
+	}
+
+	e.metrics.IncrementEotsFpPolicyViolationsCounter(pkHex, v.Rule)
+	return v
+}
+
+func (e *Engine) checkLocked(pkHex string, numSigs int, rules func(kp *KeyPolicy) *Violation) *Violation {
+	kp, ok := e.policy.Keys[pkHex]
+	if !ok {
+		kp = e.policy.Default
+	}
+	if kp == nil {
+		return &Violation{
+			Rule:   RuleNoPolicy,
+			Reason: fmt.Sprintf("no policy is defined for the key %s", pkHex),
+		}
+	}
+
+	if v := rules(kp); v != nil {
+		return v
+	}
+
+	if numSigs == 0 || kp.MaxSignRate == 0 {
+		return nil
+	}
+	if !e.limiter(pkHex, kp).AllowN(time.Now(), numSigs) {
+		return &Violation{
+			Rule:   RuleSignRateExceeded,
+			Reason: fmt.Sprintf("%d signatures exceed the rate of %v per second", numSigs, kp.MaxSignRate),
+		}
+	}
+
+	return nil
+}
+
+// limiter returns the rate limiter of the key, which is kept across reloads
+// so that reloading does not refill it
+func (e *Engine) limiter(pkHex string, kp *KeyPolicy) *rate.Limiter {
+	limit := rate.Limit(kp.MaxSignRate)
+	burst := kp.MaxSignBurst
+	if burst == 0 {
+		burst = int(math.Ceil(kp.MaxSignRate))
+	}
+
+	l, ok := e.limiters[pkHex]
+	if !ok {
+		l = rate.NewLimiter(limit, burst)
+		e.limiters[pkHex] = l
+		return l
+	}
+
+	if l.Limit() != limit {
+		l.SetLimit(limit)
+	}
+	if l.Burst() != burst {
+		l.SetBurst(burst)
+	}
+
+	return l
+}
+
+func (kp *KeyPolicy) checkChainID(chainID []byte) *Violation {
+	if len(kp.AllowedChainIDs) == 0 || slices.Contains(kp.AllowedChainIDs, string(chainID)) {
+		return nil
+	}
+
+	return &Violation{
+		Rule:   RuleChainIDNotAllowed,
+		Reason: fmt.Sprintf("the chain %s is not allowed", chainID),
+	}
+}
+
+func (kp *KeyPolicy) checkHeights(start, end uint64) *Violation {
+	if start < kp.MinHeight || (kp.MaxHeight != 0 && end > kp.MaxHeight) {
+		return &Violation{
+			Rule:   RuleHeightOutOfRange,
+			Reason: fmt.Sprintf("the heights [%d, %d] are out of the allowed range [%d, %d]", start, end, kp.MinHeight, kp.MaxHeight),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package policy_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbntypes "github.com/babylonchain/babylon/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/policy"
+	"github.com/babylonchain/finality-provider/testutil"
+)
+
+// FuzzPolicyEngine tests that every rule of a key policy is enforced, that
+// keys without a policy fall back to the default one, and that reloading
+// applies the new policy
+func FuzzPolicyEngine(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		_, btcPk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		pk := bbntypes.NewBIP340PubKeyFromBTCPK(btcPk).MustMarshal()
+		otherPk := testutil.GenRandomByteArray(r, 32)
+
+		minHeight := uint64(r.Intn(100) + 1)
+		maxHeight := minHeight + uint64(r.Intn(100)) + 10
+		batch := uint32(r.Intn(10) + 2)
+		p := &policy.Policy{
+			Keys: map[string]*policy.KeyPolicy{
+				hex.EncodeToString(pk): {
+					AllowedChainIDs:    []string{"chain-a"},
+					MinHeight:          minHeight,
+					MaxHeight:          maxHeight,
+					MaxRandomnessBatch: batch,
+					MaxSignRate:        0.001,
+					MaxSignBurst:       3,
+				},
+			},
+		}
+		policyPath := filepath.Join(t.TempDir(), "policy.json")
+		writePolicy(t, policyPath, p)
+
+		e, err := policy.NewEngine(policyPath)
+		require.NoError(t, err)
+		requireRule := func(err error, rule string) {
+			var v *policy.Violation
+			require.ErrorAs(t, err, &v)
+			require.Equal(t, rule, v.Rule)
+		}
+
+		chainA, chainB := []byte("chain-a"), []byte("chain-b")
+
+		// randomness
+		err = e.CheckCreateRandomness(pk, chainA, minHeight, batch)
+		require.NoError(t, err)
+		requireRule(e.CheckCreateRandomness(pk, chainB, minHeight, batch), policy.RuleChainIDNotAllowed)
+		requireRule(e.CheckCreateRandomness(pk, chainA, minHeight, batch+1), policy.RuleRandomnessBatchTooLarge)
+		requireRule(e.CheckCreateRandomness(pk, chainA, minHeight-1, batch), policy.RuleHeightOutOfRange)
+		requireRule(e.CheckCreateRandomness(pk, chainA, maxHeight, batch), policy.RuleHeightOutOfRange)
+
+		// refused calls do not consume the signing rate
+		requireRule(e.CheckSignEOTS(pk, chainB, minHeight), policy.RuleChainIDNotAllowed)
+		requireRule(e.CheckSignEOTS(pk, chainA, minHeight, maxHeight+1), policy.RuleHeightOutOfRange)
+		requireRule(e.CheckSignSchnorrSig(pk), policy.RuleSchnorrSigNotAllowed)
+		err = e.CheckSignEOTS(pk, chainA, minHeight, minHeight+1)
+		require.NoError(t, err)
+		err = e.CheckSignEOTS(pk, chainA, maxHeight)
+		require.NoError(t, err)
+		requireRule(e.CheckSignEOTS(pk, chainA, maxHeight), policy.RuleSignRateExceeded)
+
+		// keys without a policy are refused unless there is a default one
+		requireRule(e.CheckSignEOTS(otherPk, chainA, minHeight), policy.RuleNoPolicy)
+		p.Default = &policy.KeyPolicy{AllowSchnorrSig: true}
+		p.Keys[hex.EncodeToString(pk)].AllowSchnorrSig = true
+		writePolicy(t, policyPath, p)
+		err = e.Reload()
+		require.NoError(t, err)
+		err = e.CheckSignEOTS(otherPk, chainB, 1)
+		require.NoError(t, err)
+		err = e.CheckSignSchnorrSig(otherPk)
+		require.NoError(t, err)
+
+		// the signing rate is not refilled by reloading
+		requireRule(e.CheckSignSchnorrSig(pk), policy.RuleSignRateExceeded)
+
+		// an invalid policy is not loaded
+		p.Default.MinHeight, p.Default.MaxHeight = 10, 1
+		writePolicy(t, policyPath, p)
+		err = e.Reload()
+		require.Error(t, err)
+		err = e.CheckSignEOTS(otherPk, chainB, 1)
+		require.NoError(t, err)
+	})
+}
+
+func writePolicy(t *testing.T, path string, p *policy.Policy) {
+	policyBytes, err := json.Marshal(p)
+	require.NoError(t, err)
+	err = os.WriteFile(path, policyBytes, 0600)
+	require.NoError(t, err)
+}
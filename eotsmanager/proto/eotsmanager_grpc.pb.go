@@ -20,18 +20,30 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	EOTSManager_Ping_FullMethodName                     = "/proto.EOTSManager/Ping"
+	EOTSManager_Health_FullMethodName                   = "/proto.EOTSManager/Health"
 	EOTSManager_CreateKey_FullMethodName                = "/proto.EOTSManager/CreateKey"
 	EOTSManager_CreateRandomnessPairList_FullMethodName = "/proto.EOTSManager/CreateRandomnessPairList"
 	EOTSManager_KeyRecord_FullMethodName                = "/proto.EOTSManager/KeyRecord"
 	EOTSManager_SignEOTS_FullMethodName                 = "/proto.EOTSManager/SignEOTS"
+	EOTSManager_SignEOTSBatch_FullMethodName            = "/proto.EOTSManager/SignEOTSBatch"
 	EOTSManager_SignSchnorrSig_FullMethodName           = "/proto.EOTSManager/SignSchnorrSig"
+	EOTSManager_ListKeys_FullMethodName                 = "/proto.EOTSManager/ListKeys"
+	EOTSManager_ListAuditRecords_FullMethodName         = "/proto.EOTSManager/ListAuditRecords"
+	EOTSManager_UnlockKey_FullMethodName                = "/proto.EOTSManager/UnlockKey"
+	EOTSManager_LockKey_FullMethodName                  = "/proto.EOTSManager/LockKey"
+	EOTSManager_BackupDB_FullMethodName                 = "/proto.EOTSManager/BackupDB"
 )
 
 // EOTSManagerClient is the client API for EOTSManager service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type EOTSManagerClient interface {
+	// Ping only tells that the daemon is up, and is kept for compatibility
 	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+	// Health returns the status of the daemon and whether signing would be
+	// blocked. If auth is enabled, the callers without a token only get the
+	// version, the database error, and whether the daemon is ready
+	Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error)
 	// CreateKey generates and saves an EOTS key
 	CreateKey(ctx context.Context, in *CreateKeyRequest, opts ...grpc.CallOption) (*CreateKeyResponse, error)
 	// CreateRandomnessPairList returns a list of Schnorr randomness pairs
@@ -40,8 +52,25 @@ type EOTSManagerClient interface {
 	KeyRecord(ctx context.Context, in *KeyRecordRequest, opts ...grpc.CallOption) (*KeyRecordResponse, error)
 	// SignEOTS signs an EOTS with the EOTS private key and the relevant randomness
 	SignEOTS(ctx context.Context, in *SignEOTSRequest, opts ...grpc.CallOption) (*SignEOTSResponse, error)
+	// SignEOTSBatch signs a batch of EOTS at different heights with the EOTS
+	// private key and the relevant randomness
+	SignEOTSBatch(ctx context.Context, in *SignEOTSBatchRequest, opts ...grpc.CallOption) (*SignEOTSBatchResponse, error)
 	// SignSchnorrSig signs a Schnorr sig with the EOTS private key
 	SignSchnorrSig(ctx context.Context, in *SignSchnorrSigRequest, opts ...grpc.CallOption) (*SignSchnorrSigResponse, error)
+	// ListKeys returns the information of all the EOTS keys
+	ListKeys(ctx context.Context, in *ListKeysRequest, opts ...grpc.CallOption) (*ListKeysResponse, error)
+	// ListAuditRecords returns the records of the signing audit log
+	// matching the given filters
+	ListAuditRecords(ctx context.Context, in *ListAuditRecordsRequest, opts ...grpc.CallOption) (*ListAuditRecordsResponse, error)
+	// UnlockKey decrypts an EOTS key and keeps it in the memory of the daemon
+	// for a limited time, returning the handle of the session which can be
+	// passed instead of the passphrase
+	UnlockKey(ctx context.Context, in *UnlockKeyRequest, opts ...grpc.CallOption) (*UnlockKeyResponse, error)
+	// LockKey wipes the key of a session before its expiry
+	LockKey(ctx context.Context, in *LockKeyRequest, opts ...grpc.CallOption) (*LockKeyResponse, error)
+	// BackupDB writes a consistent snapshot of the database to the backup
+	// directory of the daemon
+	BackupDB(ctx context.Context, in *BackupDBRequest, opts ...grpc.CallOption) (*BackupDBResponse, error)
 }
 
 type eOTSManagerClient struct {
@@ -61,6 +90,15 @@ func (c *eOTSManagerClient) Ping(ctx context.Context, in *PingRequest, opts ...g
 	return out, nil
 }
 
+func (c *eOTSManagerClient) Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error) {
+	out := new(HealthResponse)
+	err := c.cc.Invoke(ctx, EOTSManager_Health_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eOTSManagerClient) CreateKey(ctx context.Context, in *CreateKeyRequest, opts ...grpc.CallOption) (*CreateKeyResponse, error) {
 	out := new(CreateKeyResponse)
 	err := c.cc.Invoke(ctx, EOTSManager_CreateKey_FullMethodName, in, out, opts...)
@@ -97,6 +135,15 @@ func (c *eOTSManagerClient) SignEOTS(ctx context.Context, in *SignEOTSRequest, o
 	return out, nil
 }
 
+func (c *eOTSManagerClient) SignEOTSBatch(ctx context.Context, in *SignEOTSBatchRequest, opts ...grpc.CallOption) (*SignEOTSBatchResponse, error) {
+	out := new(SignEOTSBatchResponse)
+	err := c.cc.Invoke(ctx, EOTSManager_SignEOTSBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eOTSManagerClient) SignSchnorrSig(ctx context.Context, in *SignSchnorrSigRequest, opts ...grpc.CallOption) (*SignSchnorrSigResponse, error) {
 	out := new(SignSchnorrSigResponse)
 	err := c.cc.Invoke(ctx, EOTSManager_SignSchnorrSig_FullMethodName, in, out, opts...)
@@ -106,11 +153,61 @@ func (c *eOTSManagerClient) SignSchnorrSig(ctx context.Context, in *SignSchnorrS
 	return out, nil
 }
 
+func (c *eOTSManagerClient) ListKeys(ctx context.Context, in *ListKeysRequest, opts ...grpc.CallOption) (*ListKeysResponse, error) {
+	out := new(ListKeysResponse)
+	err := c.cc.Invoke(ctx, EOTSManager_ListKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eOTSManagerClient) ListAuditRecords(ctx context.Context, in *ListAuditRecordsRequest, opts ...grpc.CallOption) (*ListAuditRecordsResponse, error) {
+	out := new(ListAuditRecordsResponse)
+	err := c.cc.Invoke(ctx, EOTSManager_ListAuditRecords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eOTSManagerClient) UnlockKey(ctx context.Context, in *UnlockKeyRequest, opts ...grpc.CallOption) (*UnlockKeyResponse, error) {
+	out := new(UnlockKeyResponse)
+	err := c.cc.Invoke(ctx, EOTSManager_UnlockKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eOTSManagerClient) LockKey(ctx context.Context, in *LockKeyRequest, opts ...grpc.CallOption) (*LockKeyResponse, error) {
+	out := new(LockKeyResponse)
+	err := c.cc.Invoke(ctx, EOTSManager_LockKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eOTSManagerClient) BackupDB(ctx context.Context, in *BackupDBRequest, opts ...grpc.CallOption) (*BackupDBResponse, error) {
+	out := new(BackupDBResponse)
+	err := c.cc.Invoke(ctx, EOTSManager_BackupDB_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EOTSManagerServer is the server API for EOTSManager service.
 // All implementations must embed UnimplementedEOTSManagerServer
 // for forward compatibility
 type EOTSManagerServer interface {
+	// Ping only tells that the daemon is up, and is kept for compatibility
 	Ping(context.Context, *PingRequest) (*PingResponse, error)
+	// Health returns the status of the daemon and whether signing would be
+	// blocked. If auth is enabled, the callers without a token only get the
+	// version, the database error, and whether the daemon is ready
+	Health(context.Context, *HealthRequest) (*HealthResponse, error)
 	// CreateKey generates and saves an EOTS key
 	CreateKey(context.Context, *CreateKeyRequest) (*CreateKeyResponse, error)
 	// CreateRandomnessPairList returns a list of Schnorr randomness pairs
@@ -119,8 +216,25 @@ type EOTSManagerServer interface {
 	KeyRecord(context.Context, *KeyRecordRequest) (*KeyRecordResponse, error)
 	// SignEOTS signs an EOTS with the EOTS private key and the relevant randomness
 	SignEOTS(context.Context, *SignEOTSRequest) (*SignEOTSResponse, error)
+	// SignEOTSBatch signs a batch of EOTS at different heights with the EOTS
+	// private key and the relevant randomness
+	SignEOTSBatch(context.Context, *SignEOTSBatchRequest) (*SignEOTSBatchResponse, error)
 	// SignSchnorrSig signs a Schnorr sig with the EOTS private key
 	SignSchnorrSig(context.Context, *SignSchnorrSigRequest) (*SignSchnorrSigResponse, error)
+	// ListKeys returns the information of all the EOTS keys
+	ListKeys(context.Context, *ListKeysRequest) (*ListKeysResponse, error)
+	// ListAuditRecords returns the records of the signing audit log
+	// matching the given filters
+	ListAuditRecords(context.Context, *ListAuditRecordsRequest) (*ListAuditRecordsResponse, error)
+	// UnlockKey decrypts an EOTS key and keeps it in the memory of the daemon
+	// for a limited time, returning the handle of the session which can be
+	// passed instead of the passphrase
+	UnlockKey(context.Context, *UnlockKeyRequest) (*UnlockKeyResponse, error)
+	// LockKey wipes the key of a session before its expiry
+	LockKey(context.Context, *LockKeyRequest) (*LockKeyResponse, error)
+	// BackupDB writes a consistent snapshot of the database to the backup
+	// directory of the daemon
+	BackupDB(context.Context, *BackupDBRequest) (*BackupDBResponse, error)
 	mustEmbedUnimplementedEOTSManagerServer()
 }
 
@@ -131,6 +245,9 @@ type UnimplementedEOTSManagerServer struct {
 func (UnimplementedEOTSManagerServer) Ping(context.Context, *PingRequest) (*PingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
 }
+func (UnimplementedEOTSManagerServer) Health(context.Context, *HealthRequest) (*HealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+}
 func (UnimplementedEOTSManagerServer) CreateKey(context.Context, *CreateKeyRequest) (*CreateKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateKey not implemented")
 }
@@ -143,9 +260,27 @@ func (UnimplementedEOTSManagerServer) KeyRecord(context.Context, *KeyRecordReque
 func (UnimplementedEOTSManagerServer) SignEOTS(context.Context, *SignEOTSRequest) (*SignEOTSResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignEOTS not implemented")
 }
+func (UnimplementedEOTSManagerServer) SignEOTSBatch(context.Context, *SignEOTSBatchRequest) (*SignEOTSBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignEOTSBatch not implemented")
+}
 func (UnimplementedEOTSManagerServer) SignSchnorrSig(context.Context, *SignSchnorrSigRequest) (*SignSchnorrSigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignSchnorrSig not implemented")
 }
+func (UnimplementedEOTSManagerServer) ListKeys(context.Context, *ListKeysRequest) (*ListKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKeys not implemented")
+}
+func (UnimplementedEOTSManagerServer) ListAuditRecords(context.Context, *ListAuditRecordsRequest) (*ListAuditRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditRecords not implemented")
+}
+func (UnimplementedEOTSManagerServer) UnlockKey(context.Context, *UnlockKeyRequest) (*UnlockKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockKey not implemented")
+}
+func (UnimplementedEOTSManagerServer) LockKey(context.Context, *LockKeyRequest) (*LockKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockKey not implemented")
+}
+func (UnimplementedEOTSManagerServer) BackupDB(context.Context, *BackupDBRequest) (*BackupDBResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackupDB not implemented")
+}
 func (UnimplementedEOTSManagerServer) mustEmbedUnimplementedEOTSManagerServer() {}
 
 // UnsafeEOTSManagerServer may be embedded to opt out of forward compatibility for this service.
@@ -177,6 +312,24 @@ func _EOTSManager_Ping_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EOTSManager_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EOTSManager_Health_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).Health(ctx, req.(*HealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EOTSManager_CreateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateKeyRequest)
 	if err := dec(in); err != nil {
@@ -249,6 +402,24 @@ func _EOTSManager_SignEOTS_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EOTSManager_SignEOTSBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignEOTSBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).SignEOTSBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EOTSManager_SignEOTSBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).SignEOTSBatch(ctx, req.(*SignEOTSBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EOTSManager_SignSchnorrSig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SignSchnorrSigRequest)
 	if err := dec(in); err != nil {
@@ -267,6 +438,96 @@ func _EOTSManager_SignSchnorrSig_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EOTSManager_ListKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).ListKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EOTSManager_ListKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).ListKeys(ctx, req.(*ListKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EOTSManager_ListAuditRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).ListAuditRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EOTSManager_ListAuditRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).ListAuditRecords(ctx, req.(*ListAuditRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EOTSManager_UnlockKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).UnlockKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EOTSManager_UnlockKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).UnlockKey(ctx, req.(*UnlockKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EOTSManager_LockKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).LockKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EOTSManager_LockKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).LockKey(ctx, req.(*LockKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EOTSManager_BackupDB_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupDBRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).BackupDB(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EOTSManager_BackupDB_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).BackupDB(ctx, req.(*BackupDBRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EOTSManager_ServiceDesc is the grpc.ServiceDesc for EOTSManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -278,6 +539,10 @@ var EOTSManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Ping",
 			Handler:    _EOTSManager_Ping_Handler,
 		},
+		{
+			MethodName: "Health",
+			Handler:    _EOTSManager_Health_Handler,
+		},
 		{
 			MethodName: "CreateKey",
 			Handler:    _EOTSManager_CreateKey_Handler,
@@ -294,10 +559,34 @@ var EOTSManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SignEOTS",
 			Handler:    _EOTSManager_SignEOTS_Handler,
 		},
+		{
+			MethodName: "SignEOTSBatch",
+			Handler:    _EOTSManager_SignEOTSBatch_Handler,
+		},
 		{
 			MethodName: "SignSchnorrSig",
 			Handler:    _EOTSManager_SignSchnorrSig_Handler,
 		},
+		{
+			MethodName: "ListKeys",
+			Handler:    _EOTSManager_ListKeys_Handler,
+		},
+		{
+			MethodName: "ListAuditRecords",
+			Handler:    _EOTSManager_ListAuditRecords_Handler,
+		},
+		{
+			MethodName: "UnlockKey",
+			Handler:    _EOTSManager_UnlockKey_Handler,
+		},
+		{
+			MethodName: "LockKey",
+			Handler:    _EOTSManager_LockKey_Handler,
+		},
+		{
+			MethodName: "BackupDB",
+			Handler:    _EOTSManager_BackupDB_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "eotsmanager.proto",
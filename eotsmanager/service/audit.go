@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/peer"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/proto"
+)
+
+// newAuditRecord creates the audit record of a call made with the given
+// context. The message is only kept as its hash
+func newAuditRecord(ctx context.Context, method string, pk, chainID []byte, height uint64, msg []byte, callErr error) *proto.AuditRecord {
+	r := &proto.AuditRecord{
+		Timestamp: time.Now().UnixNano(),
+		Caller:    callerFromContext(ctx),
+		Method:    method,
+		Pk:        pk,
+		ChainId:   chainID,
+		Height:    height,
+	}
+	if msg != nil {
+		msgHash := sha256.Sum256(msg)
+		r.MsgHash = msgHash[:]
+	}
+	if callErr != nil {
+		r.Result = callErr.Error()
+	}
+
+	return r
+}
+
+// callerFromContext identifies the caller by the token it presented, if
+// any, and its network address
+func callerFromContext(ctx context.Context) string {
+	addr := "unknown"
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr = p.Addr.String()
+	}
+
+	if token, ok := ctx.Value(authTokenCtxKey{}).(*proto.AuthToken); ok {
+		return fmt.Sprintf("token:%s(%s)@%s", token.Id, token.Name, addr)
+	}
+
+	return addr
+}
@@ -0,0 +1,242 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/proto"
+	"github.com/babylonchain/finality-provider/eotsmanager/store"
+	"github.com/babylonchain/finality-provider/eotsmanager/types"
+)
+
+const (
+	authTokenIDSize     = 8
+	authTokenSecretSize = 32
+	// authTokenSeparator separates the id and the secret of a token
+	authTokenSeparator = "."
+)
+
+// publicMethods are the RPC methods that can be called without a token
+var publicMethods = map[string]bool{
+	"Ping":   true,
+	"Health": true,
+}
+
+// adminMethods are the RPC methods that can only be called with an admin token
+var adminMethods = map[string]bool{
+	"BackupDB": true,
+}
+
+// keylessMethods are the RPC methods which do not use an EOTS key but can still
+// be called with a token restricted to keys. A session of LockKey can only be
+// obtained through UnlockKey, which is subject to the key restriction
+var keylessMethods = map[string]bool{
+	"LockKey": true,
+}
+
+// authTokenCtxKey is the context key of the token presented by the caller
+type authTokenCtxKey struct{}
+
+// uidRequest is a request which uses the EOTS key identified by its uid
+type uidRequest interface {
+	GetUid() []byte
+}
+
+// NewAuthToken generates a token allowing to call the given methods with the
+// given EOTS keys, or with any key if pks is empty. Only an admin token, which
+// cannot be restricted to keys, is allowed to call the admin methods. It
+// returns the token to hand to the client and the record to be stored, which
+// only contains the hash of the token secret
+func NewAuthToken(name string, methods []string, pks [][]byte, admin bool) (string, *proto.AuthToken, error) {
+	if err := ValidateAuthMethods(methods); err != nil {
+		return "", nil, err
+	}
+	if admin && len(pks) != 0 {
+		return "", nil, errors.New("an admin token cannot be restricted to keys")
+	}
+	if !admin {
+		for _, m := range methods {
+			if adminMethods[m] {
+				return "", nil, fmt.Errorf("only an admin token can be allowed to call %s", m)
+			}
+		}
+	}
+
+	id := make([]byte, authTokenIDSize)
+	if _, err := rand.Read(id); err != nil {
+		return "", nil, fmt.Errorf("failed to generate token id: %w", err)
+	}
+	secret := make([]byte, authTokenSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", nil, fmt.Errorf("failed to generate token secret: %w", err)
+	}
+	secretHash := sha256.Sum256(secret)
+
+	record := &proto.AuthToken{
+		Id:         hex.EncodeToString(id),
+		Name:       name,
+		SecretHash: secretHash[:],
+		Methods:    methods,
+		Pks:        pks,
+		CreatedAt:  time.Now().Unix(),
+		Admin:      admin,
+	}
+	token := record.Id + authTokenSeparator + hex.EncodeToString(secret)
+
+	return token, record, nil
+}
+
+// ValidateAuthMethods checks that the given methods are non-empty and are
+// all RPC methods of the EOTS manager
+func ValidateAuthMethods(methods []string) error {
+	if len(methods) == 0 {
+		return errors.New("at least one method should be allowed")
+	}
+
+	known := make(map[string]bool, len(proto.EOTSManager_ServiceDesc.Methods))
+	for _, m := range proto.EOTSManager_ServiceDesc.Methods {
+		known[m.MethodName] = true
+	}
+	for _, m := range methods {
+		if !known[m] {
+			return fmt.Errorf("unknown RPC method %s", m)
+		}
+	}
+
+	return nil
+}
+
+func parseAuthToken(token string) (string, []byte, error) {
+	id, secretHex, found := strings.Cut(token, authTokenSeparator)
+	if !found || id == "" {
+		return "", nil, errors.New("malformed token")
+	}
+	secret, err := hex.DecodeString(secretHex)
+	if err != nil {
+		return "", nil, fmt.Errorf("malformed token secret: %w", err)
+	}
+
+	return id, secret, nil
+}
+
+// authenticator checks that every RPC call carries a token allowing the
+// called method and the EOTS key used by the request
+type authenticator struct {
+	tokenStore *store.TokenStore
+	logger     *zap.Logger
+}
+
+func newAuthenticator(tokenStore *store.TokenStore, logger *zap.Logger) *authenticator {
+	return &authenticator{
+		tokenStore: tokenStore,
+		logger:     logger,
+	}
+}
+
+func (a *authenticator) unaryServerInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	token, err := a.authorize(ctx, info.FullMethod, req)
+	if err != nil {
+		a.logger.Debug("rejected RPC call", zap.String("method", info.FullMethod), zap.Error(err))
+		return nil, err
+	}
+	if token != nil {
+		ctx = context.WithValue(ctx, authTokenCtxKey{}, token)
+	}
+
+	return handler(ctx, req)
+}
+
+// authorize returns the token presented by the caller, which is nil for
+// public methods called without a token. It returns an Unauthenticated error
+// if the caller does not present a valid token, or a PermissionDenied error if
+// the token does not allow the call. Any valid token authenticates the caller
+// of a public method, e.g., to get the details of the health report
+func (a *authenticator) authorize(ctx context.Context, fullMethod string, req interface{}) (*proto.AuthToken, error) {
+	method := path.Base(fullMethod)
+	md, ok := metadata.FromIncomingContext(ctx)
+	if publicMethods[method] && len(md.Get(types.AuthMetadataKey)) == 0 {
+		return nil, nil
+	}
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	values := md.Get(types.AuthMetadataKey)
+	if len(values) != 1 {
+		return nil, status.Error(codes.Unauthenticated, "missing auth token")
+	}
+	scheme, token, found := strings.Cut(values[0], " ")
+	if !found || !strings.EqualFold(scheme, types.AuthScheme) {
+		return nil, status.Errorf(codes.Unauthenticated, "the auth scheme should be %s", types.AuthScheme)
+	}
+
+	id, secret, err := parseAuthToken(token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	record, err := a.tokenStore.GetAuthToken(id)
+	if err != nil {
+		if errors.Is(err, store.ErrAuthTokenNotFound) {
+			return nil, status.Error(codes.Unauthenticated, "invalid auth token")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get auth token: %v", err)
+	}
+	secretHash := sha256.Sum256(secret)
+	if subtle.ConstantTimeCompare(secretHash[:], record.SecretHash) != 1 {
+		return nil, status.Error(codes.Unauthenticated, "invalid auth token")
+	}
+
+	if publicMethods[method] {
+		return record, nil
+	}
+	if !slices.Contains(record.Methods, method) {
+		return nil, status.Errorf(codes.PermissionDenied, "the token %s is not allowed to call %s", id, method)
+	}
+	if adminMethods[method] && !record.Admin {
+		return nil, status.Errorf(codes.PermissionDenied, "%s can only be called with an admin token", method)
+	}
+
+	if len(record.Pks) == 0 {
+		return record, nil
+	}
+
+	// a token restricted to keys cannot call a method which does not use a
+	// key, e.g., ListKeys, or which would otherwise use all of them
+	r, ok := req.(uidRequest)
+	if !ok || len(r.GetUid()) == 0 {
+		if keylessMethods[method] {
+			return record, nil
+		}
+		return nil, status.Errorf(codes.PermissionDenied,
+			"the token %s is restricted to keys and %s should be called with one of them", id, method)
+	}
+	if !slices.ContainsFunc(record.Pks, func(pk []byte) bool {
+		return bytes.Equal(pk, r.GetUid())
+	}) {
+		return nil, status.Errorf(codes.PermissionDenied, "the token %s is not allowed to use the key %s",
+			id, hex.EncodeToString(r.GetUid()))
+	}
+
+	return record, nil
+}
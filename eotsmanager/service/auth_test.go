@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/config"
+	"github.com/babylonchain/finality-provider/eotsmanager/proto"
+	"github.com/babylonchain/finality-provider/eotsmanager/store"
+	"github.com/babylonchain/finality-provider/eotsmanager/types"
+	"github.com/babylonchain/finality-provider/testutil"
+)
+
+// FuzzAuthorize tests that calls are only authorized for the methods and
+// keys allowed by the presented token
+func FuzzAuthorize(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		cfg := config.DefaultDBConfigWithHomePath(t.TempDir())
+		dbBackend, err := cfg.GetDbBackend()
+		require.NoError(t, err)
+		defer dbBackend.Close()
+		ts, err := store.NewTokenStore(dbBackend)
+		require.NoError(t, err)
+		a := newAuthenticator(ts, zap.NewNop())
+
+		ownedPk := testutil.GenRandomByteArray(r, 32)
+		otherPk := testutil.GenRandomByteArray(r, 32)
+		token, record, err := NewAuthToken("fpd", []string{"SignEOTS", "CreateRandomnessPairList", "ListKeys", "LockKey"},
+			[][]byte{ownedPk}, false)
+		require.NoError(t, err)
+		err = ts.AddAuthToken(record)
+		require.NoError(t, err)
+
+		withToken := func(tk string) context.Context {
+			return metadata.NewIncomingContext(context.Background(),
+				metadata.Pairs(types.AuthMetadataKey, types.AuthScheme+" "+tk))
+		}
+		requireCode := func(err error, code codes.Code) {
+			require.Error(t, err)
+			require.Equal(t, code, status.Code(err))
+		}
+
+		// Ping is public
+		_, err = a.authorize(context.Background(), "/proto.EOTSManager/Ping", &proto.PingRequest{})
+		require.NoError(t, err)
+
+		// a public method authenticates any valid token
+		authorized, err := a.authorize(withToken(token), "/proto.EOTSManager/Health", &proto.HealthRequest{})
+		require.NoError(t, err)
+		require.Equal(t, record.Id, authorized.Id)
+
+		// allowed method and key
+		authorized, err = a.authorize(withToken(token), "/proto.EOTSManager/SignEOTS", &proto.SignEOTSRequest{Uid: ownedPk})
+		require.NoError(t, err)
+		require.Equal(t, record.Id, authorized.Id)
+
+		// key not owned by the token
+		_, err = a.authorize(withToken(token), "/proto.EOTSManager/SignEOTS", &proto.SignEOTSRequest{Uid: otherPk})
+		requireCode(err, codes.PermissionDenied)
+
+		// a token restricted to keys cannot call the methods without a key
+		// unless they are allowlisted
+		_, err = a.authorize(withToken(token), "/proto.EOTSManager/ListKeys", &proto.ListKeysRequest{})
+		requireCode(err, codes.PermissionDenied)
+		_, err = a.authorize(withToken(token), "/proto.EOTSManager/SignEOTS", &proto.SignEOTSRequest{})
+		requireCode(err, codes.PermissionDenied)
+		_, err = a.authorize(withToken(token), "/proto.EOTSManager/LockKey", &proto.LockKeyRequest{})
+		require.NoError(t, err)
+
+		// method not allowed by the token
+		_, err = a.authorize(withToken(token), "/proto.EOTSManager/CreateKey", &proto.CreateKeyRequest{})
+		requireCode(err, codes.PermissionDenied)
+
+		// missing, forged, or revoked token
+		_, err = a.authorize(context.Background(), "/proto.EOTSManager/SignEOTS", &proto.SignEOTSRequest{Uid: ownedPk})
+		requireCode(err, codes.Unauthenticated)
+		forged := record.Id + authTokenSeparator + testutil.GenRandomHexStr(r, authTokenSecretSize)
+		_, err = a.authorize(withToken(forged), "/proto.EOTSManager/SignEOTS", &proto.SignEOTSRequest{Uid: ownedPk})
+		requireCode(err, codes.Unauthenticated)
+		_, err = a.authorize(withToken(forged), "/proto.EOTSManager/Health", &proto.HealthRequest{})
+		requireCode(err, codes.Unauthenticated)
+		err = ts.DeleteAuthToken(record.Id)
+		require.NoError(t, err)
+		_, err = a.authorize(withToken(token), "/proto.EOTSManager/SignEOTS", &proto.SignEOTSRequest{Uid: ownedPk})
+		requireCode(err, codes.Unauthenticated)
+
+		// admin methods are only allowed to admin tokens, which cannot be
+		// restricted to keys
+		_, _, err = NewAuthToken("fpd", []string{"BackupDB"}, nil, false)
+		require.Error(t, err)
+		_, _, err = NewAuthToken("admin", []string{"BackupDB"}, [][]byte{ownedPk}, true)
+		require.Error(t, err)
+		adminToken, adminRecord, err := NewAuthToken("admin", []string{"BackupDB", "ListKeys"}, nil, true)
+		require.NoError(t, err)
+		err = ts.AddAuthToken(adminRecord)
+		require.NoError(t, err)
+		_, err = a.authorize(withToken(adminToken), "/proto.EOTSManager/BackupDB", &proto.BackupDBRequest{})
+		require.NoError(t, err)
+		_, err = a.authorize(withToken(adminToken), "/proto.EOTSManager/ListKeys", &proto.ListKeysRequest{})
+		require.NoError(t, err)
+		// a stored record allowing an admin method without being admin is
+		// still refused
+		adminRecord.Admin = false
+		err = ts.DeleteAuthToken(adminRecord.Id)
+		require.NoError(t, err)
+		err = ts.AddAuthToken(adminRecord)
+		require.NoError(t, err)
+		_, err = a.authorize(withToken(adminToken), "/proto.EOTSManager/BackupDB", &proto.BackupDBRequest{})
+		requireCode(err, codes.PermissionDenied)
+
+		// unknown methods are refused at creation
+		_, _, err = NewAuthToken("fpd", []string{"UnknownMethod"}, nil, false)
+		require.Error(t, err)
+	})
+}
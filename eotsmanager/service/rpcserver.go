@@ -2,11 +2,26 @@ package service
 
 import (
 	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/babylonchain/finality-provider/dbbackup"
 	"github.com/babylonchain/finality-provider/eotsmanager"
+	"github.com/babylonchain/finality-provider/eotsmanager/lease"
+	"github.com/babylonchain/finality-provider/eotsmanager/policy"
 	"github.com/babylonchain/finality-provider/eotsmanager/proto"
+	"github.com/babylonchain/finality-provider/eotsmanager/store"
+	"github.com/babylonchain/finality-provider/eotsmanager/types"
+	"github.com/babylonchain/finality-provider/version"
 )
 
 // rpcServer is the main RPC server for the EOTS daemon that handles
@@ -14,16 +29,47 @@ import (
 type rpcServer struct {
 	proto.UnimplementedEOTSManagerServer
 
-	em eotsmanager.EOTSManager
+	em         eotsmanager.EOTSManager
+	auditStore *store.AuditStore
+	policy     *policy.Engine
+	lease      *lease.Keeper
+	// keyBackend describes where the keys are held, for the health report
+	keyBackend string
+	startTime  time.Time
+	// sessions is em if it supports unlock sessions, or nil otherwise
+	sessions      eotsmanager.SessionManager
+	maxSessionTTL time.Duration
+	backuper      *dbbackup.Backuper
+	// authEnabled tells whether the callers are authenticated, in which case
+	// the details of the health report are only given to them
+	authEnabled bool
 }
 
 // newRPCServer creates a new RPC sever from the set of input dependencies.
 func newRPCServer(
 	em eotsmanager.EOTSManager,
+	auditStore *store.AuditStore,
+	policyEngine *policy.Engine,
+	leaseKeeper *lease.Keeper,
+	keyBackend string,
+	maxSessionTTL time.Duration,
+	backuper *dbbackup.Backuper,
+	authEnabled bool,
 ) *rpcServer {
 
+	sessions, _ := em.(eotsmanager.SessionManager)
+
 	return &rpcServer{
-		em: em,
+		em:            em,
+		auditStore:    auditStore,
+		policy:        policyEngine,
+		lease:         leaseKeeper,
+		keyBackend:    keyBackend,
+		startTime:     time.Now(),
+		sessions:      sessions,
+		maxSessionTTL: maxSessionTTL,
+		backuper:      backuper,
+		authEnabled:   authEnabled,
 	}
 }
 
@@ -39,6 +85,54 @@ func (r *rpcServer) Ping(ctx context.Context, req *proto.PingRequest) (*proto.Pi
 	return &proto.PingResponse{}, nil
 }
 
+// Health reports the status of the daemon. A failure of the database is
+// reported in the response rather than as an error, so that the caller can
+// tell it from an unreachable daemon
+func (r *rpcServer) Health(ctx context.Context, req *proto.HealthRequest) (*proto.HealthResponse, error) {
+	res := &proto.HealthResponse{
+		Version:       version.Version(),
+		KeyBackend:    r.keyBackend,
+		UptimeSeconds: uint64(time.Since(r.startTime).Seconds()),
+		PolicyEnabled: r.policy != nil,
+		LeaseEnabled:  r.lease != nil,
+	}
+
+	keys, err := r.em.ListKeys()
+	if err != nil {
+		res.DbError = err.Error()
+	}
+	res.NumKeys = uint32(len(keys))
+
+	if r.lease != nil {
+		if _, err := r.lease.Check(); err != nil {
+			res.SigningBlockers = append(res.SigningBlockers, err.Error())
+		} else {
+			res.LeaseHeld = true
+		}
+	}
+
+	for _, k := range keys {
+		if !r.policy.HasPolicy(k.PubKey) {
+			res.SigningBlockers = append(res.SigningBlockers,
+				fmt.Sprintf("no signing policy applies to the key %s (%s)", k.Name, hex.EncodeToString(k.PubKey)))
+		}
+	}
+
+	res.Ready = res.DbError == "" && len(res.SigningBlockers) == 0
+
+	// the callers which are not authenticated only learn whether the
+	// daemon is ready, as the details describe its keys and setup
+	if r.authEnabled && ctx.Value(authTokenCtxKey{}) == nil {
+		return &proto.HealthResponse{
+			Version: res.Version,
+			DbError: res.DbError,
+			Ready:   res.Ready,
+		}, nil
+	}
+
+	return res, nil
+}
+
 // CreateKey generates and saves an EOTS key
 func (r *rpcServer) CreateKey(ctx context.Context, req *proto.CreateKeyRequest) (
 	*proto.CreateKeyResponse, error) {
@@ -56,10 +150,33 @@ func (r *rpcServer) CreateKey(ctx context.Context, req *proto.CreateKeyRequest)
 func (r *rpcServer) CreateRandomnessPairList(ctx context.Context, req *proto.CreateRandomnessPairListRequest) (
 	*proto.CreateRandomnessPairListResponse, error) {
 
-	pubRandList, err := r.em.CreateRandomnessPairList(req.Uid, req.ChainId, req.StartHeight, req.Num, req.Passphrase)
+	newRecord := func(err error) *proto.AuditRecord {
+		record := newAuditRecord(ctx, "CreateRandomnessPairList", req.Uid, req.ChainId, req.StartHeight, nil, err)
+		record.Num = req.Num
+		return record
+	}
+
+	if err := r.policy.CheckCreateRandomness(req.Uid, req.ChainId, req.StartHeight, req.Num); err != nil {
+		return nil, r.refuse(err, newRecord(err))
+	}
+
+	var (
+		pubRandList []*btcec.FieldVal
+		err         error
+	)
+	if req.Session != "" {
+		pubRandList, err = withSessions(r, func(sm eotsmanager.SessionManager) ([]*btcec.FieldVal, error) {
+			return sm.CreateRandomnessPairListWithSession(req.Session, req.Uid, req.ChainId, req.StartHeight, req.Num)
+		})
+	} else {
+		pubRandList, err = r.em.CreateRandomnessPairList(req.Uid, req.ChainId, req.StartHeight, req.Num, req.Passphrase)
+	}
 
+	if auditErr := r.audit(newRecord(err)); auditErr != nil {
+		return nil, auditErr
+	}
 	if err != nil {
-		return nil, err
+		return nil, toStatusError(err)
 	}
 
 	pubRandBytesList := make([][]byte, 0, len(pubRandList))
@@ -76,6 +193,10 @@ func (r *rpcServer) CreateRandomnessPairList(ctx context.Context, req *proto.Cre
 func (r *rpcServer) KeyRecord(ctx context.Context, req *proto.KeyRecordRequest) (
 	*proto.KeyRecordResponse, error) {
 
+	if req.Passphrase == "" {
+		return nil, status.Error(codes.InvalidArgument, "the passphrase of the key is required")
+	}
+
 	record, err := r.em.KeyRecord(req.Uid, req.Passphrase)
 	if err != nil {
 		return nil, err
@@ -93,9 +214,32 @@ func (r *rpcServer) KeyRecord(ctx context.Context, req *proto.KeyRecordRequest)
 func (r *rpcServer) SignEOTS(ctx context.Context, req *proto.SignEOTSRequest) (
 	*proto.SignEOTSResponse, error) {
 
-	sig, err := r.em.SignEOTS(req.Uid, req.ChainId, req.Msg, req.Height, req.Passphrase)
+	leaseToken, err := r.checkSignEOTS(req.Uid, req.ChainId, req.Height)
 	if err != nil {
-		return nil, err
+		return nil, r.refuse(err, newAuditRecord(ctx, "SignEOTS", req.Uid, req.ChainId, req.Height, req.Msg, err))
+	}
+
+	var sig *btcec.ModNScalar
+	if req.Session != "" {
+		var sigs []*btcec.ModNScalar
+		sigs, err = withSessions(r, func(sm eotsmanager.SessionManager) ([]*btcec.ModNScalar, error) {
+			return sm.SignEOTSBatchWithSession(req.Session, req.Uid, req.ChainId,
+				[]*types.EOTSSignRequest{{Height: req.Height, Msg: req.Msg}})
+		})
+		if err == nil {
+			sig = sigs[0]
+		}
+	} else {
+		sig, err = r.em.SignEOTS(req.Uid, req.ChainId, req.Msg, req.Height, req.Passphrase)
+	}
+	if err == nil {
+		err = r.lease.Confirm(leaseToken)
+	}
+	if auditErr := r.audit(newAuditRecord(ctx, "SignEOTS", req.Uid, req.ChainId, req.Height, req.Msg, err)); auditErr != nil {
+		return nil, auditErr
+	}
+	if err != nil {
+		return nil, toStatusError(err)
 	}
 
 	sigBytes := sig.Bytes()
@@ -103,14 +247,277 @@ func (r *rpcServer) SignEOTS(ctx context.Context, req *proto.SignEOTSRequest) (
 	return &proto.SignEOTSResponse{Sig: sigBytes[:]}, nil
 }
 
+// SignEOTSBatch signs a batch of EOTS with the EOTS private key and the relevant randomness
+func (r *rpcServer) SignEOTSBatch(ctx context.Context, req *proto.SignEOTSBatchRequest) (
+	*proto.SignEOTSBatchResponse, error) {
+
+	reqs := make([]*types.EOTSSignRequest, 0, len(req.Items))
+	heights := make([]uint64, 0, len(req.Items))
+	for _, item := range req.Items {
+		reqs = append(reqs, &types.EOTSSignRequest{Height: item.Height, Msg: item.Msg})
+		heights = append(heights, item.Height)
+	}
+
+	// each height of the batch is recorded as a separate call
+	newRecords := func(err error) []*proto.AuditRecord {
+		records := make([]*proto.AuditRecord, 0, len(req.Items))
+		for _, item := range req.Items {
+			records = append(records, newAuditRecord(ctx, "SignEOTSBatch", req.Uid, req.ChainId, item.Height, item.Msg, err))
+		}
+		return records
+	}
+
+	leaseToken, err := r.checkSignEOTS(req.Uid, req.ChainId, heights...)
+	if err != nil {
+		return nil, r.refuse(err, newRecords(err)...)
+	}
+
+	var sigs []*btcec.ModNScalar
+	if req.Session != "" {
+		sigs, err = withSessions(r, func(sm eotsmanager.SessionManager) ([]*btcec.ModNScalar, error) {
+			return sm.SignEOTSBatchWithSession(req.Session, req.Uid, req.ChainId, reqs)
+		})
+	} else {
+		sigs, err = r.em.SignEOTSBatch(req.Uid, req.ChainId, reqs, req.Passphrase)
+	}
+	if err == nil {
+		err = r.lease.Confirm(leaseToken)
+	}
+	if auditErr := r.audit(newRecords(err)...); auditErr != nil {
+		return nil, auditErr
+	}
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	res := &proto.SignEOTSBatchResponse{Sigs: make([][]byte, 0, len(sigs))}
+	for _, sig := range sigs {
+		sigBytes := sig.Bytes()
+		res.Sigs = append(res.Sigs, sigBytes[:])
+	}
+
+	return res, nil
+}
+
 // SignSchnorrSig signs a Schnorr sig with the EOTS private key
 func (r *rpcServer) SignSchnorrSig(ctx context.Context, req *proto.SignSchnorrSigRequest) (
 	*proto.SignSchnorrSigResponse, error) {
 
-	sig, err := r.em.SignSchnorrSig(req.Uid, req.Msg, req.Passphrase)
+	if err := r.policy.CheckSignSchnorrSig(req.Uid); err != nil {
+		return nil, r.refuse(err, newAuditRecord(ctx, "SignSchnorrSig", req.Uid, nil, 0, req.Msg, err))
+	}
+
+	var (
+		sig *schnorr.Signature
+		err error
+	)
+	if req.Session != "" {
+		sig, err = withSessions(r, func(sm eotsmanager.SessionManager) (*schnorr.Signature, error) {
+			return sm.SignSchnorrSigWithSession(req.Session, req.Uid, req.Msg)
+		})
+	} else {
+		sig, err = r.em.SignSchnorrSig(req.Uid, req.Msg, req.Passphrase)
+	}
+	if auditErr := r.audit(newAuditRecord(ctx, "SignSchnorrSig", req.Uid, nil, 0, req.Msg, err)); auditErr != nil {
+		return nil, auditErr
+	}
 	if err != nil {
-		return nil, err
+		return nil, toStatusError(err)
 	}
 
 	return &proto.SignSchnorrSigResponse{Sig: sig.Serialize()}, nil
 }
+
+// ListKeys returns the information of all the EOTS keys
+func (r *rpcServer) ListKeys(ctx context.Context, req *proto.ListKeysRequest) (
+	*proto.ListKeysResponse, error) {
+
+	keys, err := r.em.ListKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	res := &proto.ListKeysResponse{Keys: make([]*proto.KeyInfo, 0, len(keys))}
+	for _, k := range keys {
+		info := &proto.KeyInfo{
+			Name:     k.Name,
+			Pk:       k.PubKey,
+			ChainIds: k.ChainIDs,
+		}
+		if !k.CreatedAt.IsZero() {
+			info.CreatedAt = k.CreatedAt.Unix()
+		}
+		res.Keys = append(res.Keys, info)
+	}
+
+	return res, nil
+}
+
+// ListAuditRecords returns the records of the signing audit log matching
+// the given filters
+func (r *rpcServer) ListAuditRecords(ctx context.Context, req *proto.ListAuditRecordsRequest) (
+	*proto.ListAuditRecordsResponse, error) {
+
+	filter := &store.AuditFilter{
+		Pk:          req.Uid,
+		StartHeight: req.StartHeight,
+		EndHeight:   req.EndHeight,
+		Limit:       req.Limit,
+	}
+	if req.StartTime != 0 {
+		filter.StartTime = time.Unix(req.StartTime, 0)
+	}
+	if req.EndTime != 0 {
+		filter.EndTime = time.Unix(req.EndTime, 0)
+	}
+
+	records, err := r.auditStore.ListAuditRecords(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.ListAuditRecordsResponse{Records: records}, nil
+}
+
+// UnlockKey decrypts the key and keeps it in memory for the requested time,
+// bounded by the max session ttl of the daemon
+func (r *rpcServer) UnlockKey(ctx context.Context, req *proto.UnlockKeyRequest) (
+	*proto.UnlockKeyResponse, error) {
+
+	if r.sessions == nil || r.maxSessionTTL == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "unlocking keys is disabled")
+	}
+
+	if req.Passphrase == "" {
+		return nil, status.Error(codes.InvalidArgument, "the passphrase of the key is required")
+	}
+
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	if req.TtlSeconds == 0 {
+		ttl = r.maxSessionTTL
+	}
+	if ttl > r.maxSessionTTL || ttl < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "the session ttl exceeds the maximum of %v", r.maxSessionTTL)
+	}
+
+	session, expiresAt, err := r.sessions.UnlockKey(req.Uid, req.Passphrase, ttl)
+	if auditErr := r.audit(newAuditRecord(ctx, "UnlockKey", req.Uid, nil, 0, nil, err)); auditErr != nil {
+		if err == nil {
+			_ = r.sessions.LockKey(session)
+		}
+		return nil, auditErr
+	}
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &proto.UnlockKeyResponse{Session: session, ExpiresAt: expiresAt.Unix()}, nil
+}
+
+// LockKey wipes the key of the session
+func (r *rpcServer) LockKey(ctx context.Context, req *proto.LockKeyRequest) (
+	*proto.LockKeyResponse, error) {
+
+	if r.sessions == nil {
+		return nil, status.Error(codes.FailedPrecondition, "unlocking keys is disabled")
+	}
+
+	if err := r.sessions.LockKey(req.Session); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &proto.LockKeyResponse{}, nil
+}
+
+// BackupDB writes a snapshot of the database to the backup directory
+func (r *rpcServer) BackupDB(ctx context.Context, req *proto.BackupDBRequest) (
+	*proto.BackupDBResponse, error) {
+
+	if r.backuper == nil {
+		return nil, status.Error(codes.FailedPrecondition, "backups are not supported by the database backend")
+	}
+
+	path, err := r.backuper.Backup()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to back up the database: %v", err)
+	}
+
+	return &proto.BackupDBResponse{Path: path}, nil
+}
+
+// withSessions calls f with the session manager, failing if the EOTS manager
+// does not support unlock sessions
+func withSessions[T any](r *rpcServer, f func(sm eotsmanager.SessionManager) (T, error)) (T, error) {
+	if r.sessions == nil {
+		var zero T
+		return zero, status.Error(codes.FailedPrecondition, "unlocking keys is disabled")
+	}
+
+	return f(r.sessions)
+}
+
+// audit appends the records of a call to the audit log. The result of the
+// call is withheld if it cannot be recorded
+func (r *rpcServer) audit(records ...*proto.AuditRecord) error {
+	if err := r.auditStore.AppendAuditRecords(records); err != nil {
+		return status.Errorf(codes.Internal, "failed to record the call in the audit log: %v", err)
+	}
+
+	return nil
+}
+
+// checkSignEOTS checks that the instance holds the signing lease, if any,
+// and that the signing policy allows signing at the given heights. It returns
+// the fencing token of the lease, which is confirmed after signing
+func (r *rpcServer) checkSignEOTS(uid, chainID []byte, heights ...uint64) (uint64, error) {
+	token, err := r.lease.Check()
+	if err != nil {
+		return 0, err
+	}
+
+	return token, r.policy.CheckSignEOTS(uid, chainID, heights...)
+}
+
+// refuse records the calls refused by the signing policy or the lease in
+// the audit log and returns the refusal as a gRPC error
+func (r *rpcServer) refuse(err error, records ...*proto.AuditRecord) error {
+	if auditErr := r.audit(records...); auditErr != nil {
+		return auditErr
+	}
+
+	return toStatusError(err)
+}
+
+// toStatusError converts the errors which the client should be able to tell
+// apart into gRPC errors with dedicated codes
+func toStatusError(err error) error {
+	var violation *policy.Violation
+	switch {
+	case errors.Is(err, types.ErrDoubleSign):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, types.ErrSessionNotFound):
+		// the client can unlock the key again
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, eotsmanager.ErrPrivKeyNotExposed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, lease.ErrNotLeaseHolder):
+		// the client can fail over to the replica holding the lease
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.As(err, &violation):
+		code := codes.PermissionDenied
+		if violation.Rule == policy.RuleSignRateExceeded {
+			code = codes.ResourceExhausted
+		}
+		st, detailsErr := status.New(code, err.Error()).WithDetails(&errdetails.ErrorInfo{
+			Reason:   violation.Rule,
+			Domain:   policy.ErrorDomain,
+			Metadata: map[string]string{"reason": violation.Reason},
+		})
+		if detailsErr != nil {
+			return status.Error(code, err.Error())
+		}
+		return st.Err()
+	default:
+		return err
+	}
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/babylonchain/finality-provider/eotsmanager"
+	"github.com/babylonchain/finality-provider/eotsmanager/config"
+	"github.com/babylonchain/finality-provider/eotsmanager/lease"
+	"github.com/babylonchain/finality-provider/eotsmanager/policy"
+	"github.com/babylonchain/finality-provider/eotsmanager/proto"
+	"github.com/babylonchain/finality-provider/eotsmanager/store"
+	"github.com/babylonchain/finality-provider/eotsmanager/types"
+)
+
+// TestToStatusError tests that double signing, calls to a standby replica,
+// and policy violations are reported with dedicated codes and details
+func TestToStatusError(t *testing.T) {
+	err := toStatusError(fmt.Errorf("failed to sign: %w", types.ErrDoubleSign))
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	err = toStatusError(fmt.Errorf("%w: the session is not of the key", types.ErrSessionNotFound))
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	err = toStatusError(lease.ErrLeaseSuperseded)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	violation := &policy.Violation{Rule: policy.RuleChainIDNotAllowed, Reason: "the chain chain-b is not allowed"}
+	err = toStatusError(violation)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.PermissionDenied, st.Code())
+	require.Len(t, st.Details(), 1)
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	require.Equal(t, policy.RuleChainIDNotAllowed, info.Reason)
+	require.Equal(t, policy.ErrorDomain, info.Domain)
+	require.Equal(t, violation.Reason, info.Metadata["reason"])
+
+	err = toStatusError(&policy.Violation{Rule: policy.RuleSignRateExceeded})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+// TestHealth tests that the health report counts the keys, lists the keys
+// without any signing policy as blockers, and reports database failures
+func TestHealth(t *testing.T) {
+	homeDir := filepath.Join(t.TempDir(), "eots-home")
+	cfg := config.DefaultConfigWithHomePath(homeDir)
+	db, err := cfg.DatabaseConfig.GetDbBackend()
+	require.NoError(t, err)
+	em, err := eotsmanager.NewLocalEOTSManager(homeDir, cfg.KeyringBackend, db, zap.NewNop())
+	require.NoError(t, err)
+
+	coveredPk, err := em.CreateKey("covered", "", "")
+	require.NoError(t, err)
+	_, err = em.CreateKey("uncovered", "", "")
+	require.NoError(t, err)
+
+	policyFile := filepath.Join(t.TempDir(), "policy.json")
+	policyJSON := fmt.Sprintf(`{"keys": {"%s": {}}}`, hex.EncodeToString(coveredPk))
+	require.NoError(t, os.WriteFile(policyFile, []byte(policyJSON), 0600))
+	engine, err := policy.NewEngine(policyFile)
+	require.NoError(t, err)
+
+	r := newRPCServer(em, nil, nil, nil, "keyring:test", time.Hour, nil, false)
+	res, err := r.Health(context.Background(), &proto.HealthRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "keyring:test", res.KeyBackend)
+	require.Equal(t, uint32(2), res.NumKeys)
+	require.Empty(t, res.DbError)
+	require.Empty(t, res.SigningBlockers)
+	require.False(t, res.PolicyEnabled)
+	require.True(t, res.Ready)
+
+	r = newRPCServer(em, nil, engine, nil, "keyring:test", time.Hour, nil, false)
+	res, err = r.Health(context.Background(), &proto.HealthRequest{})
+	require.NoError(t, err)
+	require.True(t, res.PolicyEnabled)
+	require.Len(t, res.SigningBlockers, 1)
+	require.Contains(t, res.SigningBlockers[0], "uncovered")
+	require.False(t, res.Ready)
+
+	// only the authenticated callers get the details
+	r = newRPCServer(em, nil, engine, nil, "keyring:test", time.Hour, nil, true)
+	res, err = r.Health(context.Background(), &proto.HealthRequest{})
+	require.NoError(t, err)
+	require.False(t, res.Ready)
+	require.NotEmpty(t, res.Version)
+	require.Empty(t, res.KeyBackend)
+	require.Zero(t, res.NumKeys)
+	require.Empty(t, res.SigningBlockers)
+	authCtx := context.WithValue(context.Background(), authTokenCtxKey{}, &proto.AuthToken{Id: "fpd"})
+	res, err = r.Health(authCtx, &proto.HealthRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "keyring:test", res.KeyBackend)
+	require.Equal(t, uint32(2), res.NumKeys)
+	require.Len(t, res.SigningBlockers, 1)
+
+	require.NoError(t, db.Close())
+	res, err = r.Health(context.Background(), &proto.HealthRequest{})
+	require.NoError(t, err)
+	require.NotEmpty(t, res.DbError)
+	require.False(t, res.Ready)
+}
+
+// TestUnlockKey tests that the session ttl is bounded by the daemon, that
+// signing with a session is audited, and that unlocking can be disabled
+func TestUnlockKey(t *testing.T) {
+	homeDir := filepath.Join(t.TempDir(), "eots-home")
+	cfg := config.DefaultConfigWithHomePath(homeDir)
+	db, err := cfg.DatabaseConfig.GetDbBackend()
+	require.NoError(t, err)
+	defer db.Close()
+	em, err := eotsmanager.NewLocalEOTSManager(homeDir, cfg.KeyringBackend, db, zap.NewNop())
+	require.NoError(t, err)
+	auditStore, err := store.NewAuditStore(db, "")
+	require.NoError(t, err)
+
+	pk, err := em.CreateKey("fp", "testpass", "")
+	require.NoError(t, err)
+	// the passphrases held by the daemon do not unlock or expose the key
+	em.SetKeyPassphrases(map[string]string{"fp": "testpass"})
+
+	r := newRPCServer(em, auditStore, nil, nil, "keyring:test", time.Hour, nil, false)
+	_, err = r.UnlockKey(context.Background(), &proto.UnlockKeyRequest{Uid: pk, Passphrase: "testpass", TtlSeconds: 7200})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = r.UnlockKey(context.Background(), &proto.UnlockKeyRequest{Uid: pk})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = r.KeyRecord(context.Background(), &proto.KeyRecordRequest{Uid: pk})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	res, err := r.UnlockKey(context.Background(), &proto.UnlockKeyRequest{Uid: pk, Passphrase: "testpass"})
+	require.NoError(t, err)
+	require.InDelta(t, time.Now().Add(time.Hour).Unix(), res.ExpiresAt, 5)
+
+	msg := make([]byte, 32)
+	_, err = r.SignEOTS(context.Background(), &proto.SignEOTSRequest{
+		Uid: pk, ChainId: []byte("chain"), Msg: msg, Height: 1, Session: res.Session,
+	})
+	require.NoError(t, err)
+
+	records, err := auditStore.ListAuditRecords(&store.AuditFilter{})
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	require.Equal(t, "UnlockKey", records[0].Method)
+	require.Equal(t, "SignEOTS", records[1].Method)
+
+	_, err = r.LockKey(context.Background(), &proto.LockKeyRequest{Session: res.Session})
+	require.NoError(t, err)
+	_, err = r.SignEOTS(context.Background(), &proto.SignEOTSRequest{
+		Uid: pk, ChainId: []byte("chain"), Msg: msg, Height: 2, Session: res.Session,
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	r = newRPCServer(em, auditStore, nil, nil, "keyring:test", 0, nil, false)
+	_, err = r.UnlockKey(context.Background(), &proto.UnlockKeyRequest{Uid: pk, Passphrase: "testpass"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+// blockingEOTSManager blocks in SignEOTS until it is released
+type blockingEOTSManager struct {
+	eotsmanager.EOTSManager
+	signing chan struct{}
+	release chan struct{}
+}
+
+func (em *blockingEOTSManager) SignEOTS(uid []byte, chainID []byte, msg []byte, height uint64, passphrase string) (*btcec.ModNScalar, error) {
+	close(em.signing)
+	<-em.release
+
+	return em.EOTSManager.SignEOTS(uid, chainID, msg, height, passphrase)
+}
+
+// TestSignEOTSLeaseTakenOver tests that a signature made while the signing
+// lease is taken over by another replica is dropped
+func TestSignEOTSLeaseTakenOver(t *testing.T) {
+	homeDir := filepath.Join(t.TempDir(), "eots-home")
+	cfg := config.DefaultConfigWithHomePath(homeDir)
+	db, err := cfg.DatabaseConfig.GetDbBackend()
+	require.NoError(t, err)
+	defer db.Close()
+	localEm, err := eotsmanager.NewLocalEOTSManager(homeDir, cfg.KeyringBackend, db, zap.NewNop())
+	require.NoError(t, err)
+	auditStore, err := store.NewAuditStore(db, "")
+	require.NoError(t, err)
+	pk, err := localEm.CreateKey("fp", "", "")
+	require.NoError(t, err)
+
+	leasePath := filepath.Join(t.TempDir(), "lease.db")
+	keeper := lease.NewKeeper(lease.NewFileBackend(leasePath), "active", time.Hour, time.Hour, time.Minute, zap.NewNop())
+	keeper.Start()
+	defer keeper.Stop()
+
+	em := &blockingEOTSManager{
+		EOTSManager: localEm,
+		signing:     make(chan struct{}),
+		release:     make(chan struct{}),
+	}
+	r := newRPCServer(em, auditStore, nil, keeper, "keyring:test", time.Hour, nil, false)
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := r.SignEOTS(context.Background(), &proto.SignEOTSRequest{
+			Uid: pk, ChainId: []byte("chain"), Msg: make([]byte, 32), Height: 1,
+		})
+		errCh <- err
+	}()
+
+	// the standby takes the lease over while the active replica signs
+	<-em.signing
+	backend := lease.NewFileBackend(leasePath)
+	current, err := backend.Current()
+	require.NoError(t, err)
+	require.NoError(t, backend.Release("active", current.Token))
+	_, err = backend.Acquire("standby", time.Hour)
+	require.NoError(t, err)
+	close(em.release)
+
+	err = <-errCh
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	records, err := auditStore.ListAuditRecords(&store.AuditFilter{})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, "SignEOTS", records[0].Method)
+	require.Contains(t, records[0].Result, "superseded")
+}
@@ -2,10 +2,15 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
+	"os"
+	ossignal "os/signal"
 	"sync"
 	"sync/atomic"
+	"syscall"
+	"time"
 
 	"github.com/babylonchain/finality-provider/metrics"
 
@@ -13,9 +18,15 @@ import (
 	"github.com/lightningnetwork/lnd/signal"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 
+	"github.com/babylonchain/finality-provider/dbbackup"
 	"github.com/babylonchain/finality-provider/eotsmanager"
 	"github.com/babylonchain/finality-provider/eotsmanager/config"
+	"github.com/babylonchain/finality-provider/eotsmanager/lease"
+	"github.com/babylonchain/finality-provider/eotsmanager/policy"
+	"github.com/babylonchain/finality-provider/eotsmanager/store"
+	"github.com/babylonchain/finality-provider/util"
 )
 
 // Server is the main daemon construct for the EOTS manager server. It handles
@@ -35,15 +46,60 @@ type Server struct {
 }
 
 // NewEOTSManagerServer creates a new server with the given config.
-func NewEOTSManagerServer(cfg *config.Config, l *zap.Logger, em eotsmanager.EOTSManager, db kvdb.Backend, sig signal.Interceptor) *Server {
+func NewEOTSManagerServer(cfg *config.Config, l *zap.Logger, em eotsmanager.EOTSManager, db kvdb.Backend, sig signal.Interceptor) (*Server, error) {
+	auditStore, err := store.NewAuditStore(db, cfg.AuditAnchor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initiate audit store: %w", err)
+	}
+	// the records appended after the anchor, e.g., if the daemon stopped
+	// before writing it, are chained to the anchored one
+	if _, err := auditStore.CheckAuditAnchor(); err != nil {
+		if !errors.Is(err, store.ErrAuditAnchorNotFound) {
+			return nil, fmt.Errorf("failed to check the audit log against its anchor: %w", err)
+		}
+		l.Warn("the audit log is not anchored yet and is anchored at its last record",
+			zap.String("audit_anchor", cfg.AuditAnchor))
+	}
+	if err := auditStore.UpdateAuditAnchor(); err != nil {
+		return nil, fmt.Errorf("failed to anchor the audit log: %w", err)
+	}
+
+	var policyEngine *policy.Engine
+	if cfg.PolicyFile != "" {
+		policyEngine, err = policy.NewEngine(cfg.PolicyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load signing policy: %w", err)
+		}
+		l.Info("signing policy is enabled", zap.String("policy_file", cfg.PolicyFile))
+	}
+
+	var leaseKeeper *lease.Keeper
+	if cfg.Lease.Enable {
+		leaseKeeper = lease.NewKeeper(lease.NewFileBackend(cfg.Lease.Path), cfg.Lease.HolderID,
+			cfg.Lease.TTL, cfg.Lease.RenewInterval, cfg.Lease.SignMargin, l)
+		l.Info("signing lease is enabled", zap.String("lease_path", cfg.Lease.Path),
+			zap.String("holder", cfg.Lease.HolderID))
+	}
+
+	keyBackend := "keyring:" + cfg.KeyringBackend
+	if cfg.ExternalSigner.Enable {
+		keyBackend = "external-signer"
+	}
+
+	// the snapshots are copies of bolt files
+	var backuper *dbbackup.Backuper
+	if !cfg.DatabaseConfig.IsSqlite() {
+		backuper = dbbackup.NewBackuper(db, cfg.Backup, config.DBBackupPrefix, l)
+	}
+
 	return &Server{
 		cfg:         cfg,
 		logger:      l,
-		rpcServer:   newRPCServer(em),
+		rpcServer:   newRPCServer(em, auditStore, policyEngine, leaseKeeper, keyBackend, cfg.MaxSessionTTL, backuper, cfg.EnableAuth),
 		db:          db,
 		interceptor: sig,
 		quit:        make(chan struct{}, 1),
-	}
+	}, nil
 }
 
 // RunUntilShutdown runs the main EOTS manager server loop until a signal is
@@ -75,13 +131,18 @@ func (s *Server) RunUntilShutdown() error {
 	listenAddr := s.cfg.RpcListener
 	// we create listeners from the RPCListeners defined
 	// in the config.
-	lis, err := net.Listen("tcp", listenAddr)
+	lis, err := util.ListenRPC(listenAddr)
 	if err != nil {
 		return fmt.Errorf("failed to listen on %s: %w", listenAddr, err)
 	}
 	defer lis.Close()
 
-	grpcServer := grpc.NewServer()
+	serverOpts, err := s.grpcServerOpts()
+	if err != nil {
+		return err
+	}
+
+	grpcServer := grpc.NewServer(serverOpts...)
 	defer grpcServer.Stop()
 
 	if err := s.rpcServer.RegisterWithGrpcServer(grpcServer); err != nil {
@@ -94,6 +155,24 @@ func (s *Server) RunUntilShutdown() error {
 		return fmt.Errorf("failed to start gRPC listener: %v", err)
 	}
 
+	if s.rpcServer.backuper != nil {
+		s.rpcServer.backuper.Start()
+		defer s.rpcServer.backuper.Stop()
+	}
+
+	if s.rpcServer.lease != nil {
+		s.rpcServer.lease.Start()
+		defer s.rpcServer.lease.Stop()
+	}
+
+	if s.rpcServer.policy != nil {
+		// subscribe before serving so that SIGHUP never terminates the daemon
+		sighup := make(chan os.Signal, 1)
+		ossignal.Notify(sighup, syscall.SIGHUP)
+		defer ossignal.Stop(sighup)
+		go s.reloadPolicyOnSignal(sighup)
+	}
+
 	s.logger.Info("EOTS Manager Daemon is fully active!")
 
 	// Wait for shutdown signal from either a graceful server stop or from
@@ -103,6 +182,104 @@ func (s *Server) RunUntilShutdown() error {
 	return nil
 }
 
+// reloadPolicyOnSignal reloads the signing policy whenever a signal is
+// received until the server shuts down
+func (s *Server) reloadPolicyOnSignal(sigChan <-chan os.Signal) {
+	for {
+		select {
+		case <-sigChan:
+			if err := s.rpcServer.policy.Reload(); err != nil {
+				s.logger.Error("failed to reload the signing policy, keeping the current one", zap.Error(err))
+				continue
+			}
+			s.logger.Info("signing policy reloaded", zap.String("policy_file", s.cfg.PolicyFile))
+		case <-s.interceptor.ShutdownChannel():
+			return
+		}
+	}
+}
+
+// grpcServerOpts returns the options of the gRPC server, which serves over TLS
+// unless it is disabled in the config. A self-signed certificate is generated
+// if the configured one does not exist. Callers are authenticated by bearer
+// tokens if auth is enabled, and every call is recorded in the gRPC metrics
+func (s *Server) grpcServerOpts() ([]grpc.ServerOption, error) {
+	// the metrics interceptor comes first to also count the calls refused
+	// by authentication
+	interceptors := []grpc.UnaryServerInterceptor{metrics.NewGrpcMetrics().UnaryServerInterceptor}
+
+	if s.cfg.EnableAuth {
+		tokenStore, err := store.NewTokenStore(s.db)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initiate token store: %w", err)
+		}
+		interceptors = append(interceptors, newAuthenticator(tokenStore, s.logger).unaryServerInterceptor)
+		s.logger.Info("token authentication is enabled")
+	}
+
+	opts := []grpc.ServerOption{grpc.ChainUnaryInterceptor(interceptors...)}
+
+	tlsCfg := s.cfg.TLS
+	if tlsCfg.Disable {
+		s.logger.Warn("TLS is disabled, the RPC server is serving over plaintext TCP")
+		if s.cfg.EnableAuth {
+			s.logger.Warn("auth tokens are sent in plaintext as TLS is disabled")
+		}
+		return opts, nil
+	}
+
+	if err := s.ensureTLSCert(); err != nil {
+		return nil, err
+	}
+
+	serverTLSCfg, err := util.NewServerTLSConfig(tlsCfg.CertPath, tlsCfg.KeyPath, tlsCfg.ClientCAPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS config: %w", err)
+	}
+
+	if tlsCfg.ClientCAPath != "" {
+		s.logger.Info("mutual TLS is enabled", zap.String("client_ca_path", tlsCfg.ClientCAPath))
+	}
+
+	return append(opts, grpc.Creds(credentials.NewTLS(serverTLSCfg))), nil
+}
+
+// ensureTLSCert generates a self-signed TLS certificate if the configured one
+// does not exist. An existing certificate is never replaced as the clients
+// pinning it would fail their handshakes, so the daemon refuses to start with
+// an expired certificate and only warns about one which is due for renewal
+func (s *Server) ensureTLSCert() error {
+	tlsCfg := s.cfg.TLS
+	if !util.FileExists(tlsCfg.CertPath) {
+		s.logger.Info("generating a self-signed TLS certificate", zap.String("cert_path", tlsCfg.CertPath))
+		return util.GenerateSelfSignedCert(tlsCfg.CertPath, tlsCfg.KeyPath, nil)
+	}
+
+	expiry, autogenerated, err := util.CertExpiry(tlsCfg.CertPath)
+	if err != nil {
+		return err
+	}
+	remaining := time.Until(expiry)
+	if remaining > util.TLSCertRenewalWindow {
+		return nil
+	}
+
+	renewal := "run `eotsd tls rotate` and give the new certificate to the clients pinning it"
+	if !autogenerated {
+		renewal = "renew it with its CA"
+	}
+
+	if remaining <= 0 {
+		return fmt.Errorf("the TLS certificate at %s expired at %s, %s",
+			tlsCfg.CertPath, expiry.Format(time.RFC3339), renewal)
+	}
+
+	s.logger.Error("THE TLS CERTIFICATE EXPIRES SOON, "+renewal,
+		zap.String("cert_path", tlsCfg.CertPath), zap.Time("expiry", expiry))
+
+	return nil
+}
+
 // startGrpcListen starts the GRPC server on the passed listeners.
 func (s *Server) startGrpcListen(grpcServer *grpc.Server, listeners []net.Listener) error {
 
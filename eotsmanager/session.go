@@ -0,0 +1,262 @@
+package eotsmanager
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"go.uber.org/zap"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/lockedmem"
+	"github.com/babylonchain/finality-provider/eotsmanager/types"
+)
+
+// sessionHandleSize is the number of random bytes of a session handle
+const sessionHandleSize = 32
+
+// SessionManager is an EOTS manager which can unlock a key for a limited
+// time, so that the operations needing the private key are authorized by the
+// handle of the session instead of the passphrase
+type SessionManager interface {
+	// UnlockKey decrypts the key with the passphrase and keeps it in protected
+	// memory until the session is locked or ttl elapses. It returns the handle
+	// of the session and its expiry
+	UnlockKey(uid []byte, passphrase string, ttl time.Duration) (string, time.Time, error)
+
+	// LockKey wipes the key of the session. It fails with ErrSessionNotFound if
+	// the session does not exist or has expired
+	LockKey(session string) error
+
+	// CreateRandomnessPairListWithSession is CreateRandomnessPairList with the
+	// key of the session
+	CreateRandomnessPairListWithSession(session string, uid []byte, chainID []byte, startHeight uint64, num uint32) ([]*btcec.FieldVal, error)
+
+	// SignEOTSBatchWithSession is SignEOTSBatch with the key of the session
+	SignEOTSBatchWithSession(session string, uid []byte, chainID []byte, reqs []*types.EOTSSignRequest) ([]*btcec.ModNScalar, error)
+
+	// SignSchnorrSigWithSession is SignSchnorrSig with the key of the session
+	SignSchnorrSigWithSession(session string, uid []byte, msg []byte) (*schnorr.Signature, error)
+}
+
+var _ SessionManager = &LocalEOTSManager{}
+
+// unlockSession is a key unlocked until expiresAt
+type unlockSession struct {
+	fpPk      []byte
+	privKey   *lockedmem.Buffer
+	expiresAt time.Time
+	timer     *time.Timer
+}
+
+// sessionStore holds the unlock sessions by handle
+type sessionStore struct {
+	mu       sync.Mutex
+	sessions map[string]*unlockSession
+}
+
+func newSessionStore() *sessionStore {
+	return &sessionStore{sessions: make(map[string]*unlockSession)}
+}
+
+// add stores the session under a new random handle and schedules its wipe
+func (ss *sessionStore) add(s *unlockSession, ttl time.Duration) (string, error) {
+	handleBytes := make([]byte, sessionHandleSize)
+	if _, err := rand.Read(handleBytes); err != nil {
+		return "", fmt.Errorf("failed to generate the session handle: %w", err)
+	}
+	handle := hex.EncodeToString(handleBytes)
+
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+
+	s.expiresAt = time.Now().Add(ttl)
+	s.timer = time.AfterFunc(ttl, func() {
+		_ = ss.remove(handle)
+	})
+	ss.sessions[handle] = s
+
+	return handle, nil
+}
+
+// get returns the session of the handle if it is bound to the key
+func (ss *sessionStore) get(handle string, fpPk []byte) (*unlockSession, error) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+
+	s, ok := ss.sessions[handle]
+	if !ok || !time.Now().Before(s.expiresAt) {
+		return nil, types.ErrSessionNotFound
+	}
+	if !bytes.Equal(s.fpPk, fpPk) {
+		return nil, fmt.Errorf("%w: the session is not of the key %x", types.ErrSessionNotFound, fpPk)
+	}
+
+	return s, nil
+}
+
+// remove stops the session of the handle and wipes its key
+func (ss *sessionStore) remove(handle string) error {
+	ss.mu.Lock()
+	s, ok := ss.sessions[handle]
+	delete(ss.sessions, handle)
+	ss.mu.Unlock()
+
+	if !ok {
+		return types.ErrSessionNotFound
+	}
+	s.timer.Stop()
+	s.privKey.Release()
+
+	return nil
+}
+
+// removeAll stops all the sessions and wipes their keys
+func (ss *sessionStore) removeAll() {
+	ss.mu.Lock()
+	sessions := ss.sessions
+	ss.sessions = make(map[string]*unlockSession)
+	ss.mu.Unlock()
+
+	for _, s := range sessions {
+		s.timer.Stop()
+		s.privKey.Release()
+	}
+}
+
+// withPrivKey calls f with the private key of the session, which is wiped
+// from the Go heap once f returns
+func (s *unlockSession) withPrivKey(f func(privKey *btcec.PrivateKey) error) error {
+	err := s.privKey.Use(func(secret []byte) error {
+		privKey, _ := btcec.PrivKeyFromBytes(secret)
+		defer privKey.Zero()
+
+		return f(privKey)
+	})
+	if errors.Is(err, lockedmem.ErrReleased) {
+		return types.ErrSessionNotFound
+	}
+
+	return err
+}
+
+var _ KeyBackend = &sessionKeyBackend{}
+
+// sessionKeyBackend is a key backend holding the single key of a session,
+// which ignores the key names and the passphrases
+type sessionKeyBackend struct {
+	s *unlockSession
+}
+
+func (kb *sessionKeyBackend) CreateKey(string, string) (*btcec.PublicKey, error) {
+	return nil, fmt.Errorf("a session cannot create keys")
+}
+
+func (kb *sessionKeyBackend) PubKey(string) (*btcec.PublicKey, error) {
+	return schnorr.ParsePubKey(kb.s.fpPk)
+}
+
+func (kb *sessionKeyBackend) PubRandList(_, _ string, chainID []byte, startHeight uint64, num uint32) ([]*btcec.FieldVal, error) {
+	var prList []*btcec.FieldVal
+	err := kb.s.withPrivKey(func(privKey *btcec.PrivateKey) error {
+		prList = pubRandListFromPrivKey(privKey, chainID, startHeight, num)
+		return nil
+	})
+
+	return prList, err
+}
+
+func (kb *sessionKeyBackend) SignEOTS(_, _ string, chainID []byte, reqs []*types.EOTSSignRequest) ([]*btcec.ModNScalar, error) {
+	var sigs []*btcec.ModNScalar
+	err := kb.s.withPrivKey(func(privKey *btcec.PrivateKey) error {
+		var err error
+		sigs, err = signEOTSWithPrivKey(privKey, chainID, reqs)
+		return err
+	})
+
+	return sigs, err
+}
+
+func (kb *sessionKeyBackend) SignSchnorr(_, _ string, msg []byte) (*schnorr.Signature, error) {
+	var sig *schnorr.Signature
+	err := kb.s.withPrivKey(func(privKey *btcec.PrivateKey) error {
+		var err error
+		sig, err = schnorr.Sign(privKey, msg)
+		return err
+	})
+
+	return sig, err
+}
+
+// UnlockKey decrypts the key and keeps it in protected memory for ttl. It
+// fails with ErrPrivKeyNotExposed if the key backend does not hold the
+// private keys, as such a backend keeps its own keys unlocked
+func (lm *LocalEOTSManager) UnlockKey(fpPk []byte, passphrase string, ttl time.Duration) (string, time.Time, error) {
+	if ttl <= 0 {
+		return "", time.Time{}, fmt.Errorf("the session ttl must be positive")
+	}
+
+	privKey, err := lm.getEOTSPrivKey(fpPk, passphrase)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	buf, err := lockedmem.FromBytes(privKey.Serialize())
+	privKey.Zero()
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to protect the unlocked key: %w", err)
+	}
+	if !buf.Locked() {
+		lm.logger.Warn("the memory of the unlocked key could not be locked and may be swapped to disk",
+			zap.String("eots_pk", hex.EncodeToString(fpPk)))
+	}
+
+	s := &unlockSession{fpPk: fpPk, privKey: buf}
+	handle, err := lm.sessions.add(s, ttl)
+	if err != nil {
+		buf.Release()
+		return "", time.Time{}, err
+	}
+
+	lm.logger.Info("unlocked the EOTS key",
+		zap.String("eots_pk", hex.EncodeToString(fpPk)),
+		zap.Time("expires_at", s.expiresAt),
+	)
+
+	return handle, s.expiresAt, nil
+}
+
+func (lm *LocalEOTSManager) LockKey(session string) error {
+	return lm.sessions.remove(session)
+}
+
+func (lm *LocalEOTSManager) CreateRandomnessPairListWithSession(session string, fpPk []byte, chainID []byte, startHeight uint64, num uint32) ([]*btcec.FieldVal, error) {
+	s, err := lm.sessions.get(session, fpPk)
+	if err != nil {
+		return nil, err
+	}
+
+	return lm.createRandomnessPairList(&sessionKeyBackend{s: s}, fpPk, chainID, startHeight, num, "")
+}
+
+func (lm *LocalEOTSManager) SignEOTSBatchWithSession(session string, fpPk []byte, chainID []byte, reqs []*types.EOTSSignRequest) ([]*btcec.ModNScalar, error) {
+	s, err := lm.sessions.get(session, fpPk)
+	if err != nil {
+		return nil, err
+	}
+
+	return lm.signEOTSBatch(&sessionKeyBackend{s: s}, fpPk, chainID, reqs, "")
+}
+
+func (lm *LocalEOTSManager) SignSchnorrSigWithSession(session string, fpPk []byte, msg []byte) (*schnorr.Signature, error) {
+	s, err := lm.sessions.get(session, fpPk)
+	if err != nil {
+		return nil, err
+	}
+
+	return lm.signSchnorrSig(&sessionKeyBackend{s: s}, "", "", fpPk, msg)
+}
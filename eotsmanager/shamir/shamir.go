@@ -0,0 +1,172 @@
+// Package shamir implements Shamir's secret sharing of secp256k1 private keys
+// with Feldman commitments, which allow each share to be verified against the
+// public commitments of the split without revealing the secret
+package shamir
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+)
+
+// MaxShares is the maximum number of shares of a split, as share indices
+// are encoded in a byte
+const MaxShares = 255
+
+// Share is the evaluation of the sharing polynomial at Index
+type Share struct {
+	Index uint8
+	Value btcec.ModNScalar
+}
+
+// Split splits the secret into total shares so that any threshold of them
+// recover the secret. It returns the shares and the commitments to the
+// coefficients of the sharing polynomial, where the first commitment is the
+// public key of the secret
+func Split(secret *btcec.ModNScalar, threshold, total uint32) ([]*Share, []*btcec.PublicKey, error) {
+	if threshold < 1 || threshold > total {
+		return nil, nil, fmt.Errorf("the threshold %d should be in [1, %d]", threshold, total)
+	}
+	if total > MaxShares {
+		return nil, nil, fmt.Errorf("the number of shares %d exceeds the maximum %d", total, MaxShares)
+	}
+	if secret.IsZero() {
+		return nil, nil, errors.New("the secret should not be zero")
+	}
+
+	coeffs := make([]btcec.ModNScalar, threshold)
+	coeffs[0].Set(secret)
+	for i := 1; i < len(coeffs); i++ {
+		if err := randScalar(&coeffs[i]); err != nil {
+			return nil, nil, err
+		}
+	}
+	// the coefficients are secret material
+	defer func() {
+		for i := range coeffs {
+			coeffs[i].Zero()
+		}
+	}()
+
+	commitments := make([]*btcec.PublicKey, 0, threshold)
+	for i := range coeffs {
+		var p btcec.JacobianPoint
+		btcec.ScalarBaseMultNonConst(&coeffs[i], &p)
+		p.ToAffine()
+		commitments = append(commitments, btcec.NewPublicKey(&p.X, &p.Y))
+	}
+
+	shares := make([]*Share, 0, total)
+	for i := uint32(1); i <= total; i++ {
+		share := &Share{Index: uint8(i)}
+		evalPolynomial(coeffs, share.Index, &share.Value)
+		shares = append(shares, share)
+	}
+
+	return shares, commitments, nil
+}
+
+// VerifyShare checks that the share is an evaluation of the polynomial
+// committed to by the commitments
+func VerifyShare(share *Share, commitments []*btcec.PublicKey) error {
+	if share.Index == 0 {
+		return errors.New("the share index should not be zero")
+	}
+	if len(commitments) == 0 {
+		return errors.New("empty commitments")
+	}
+
+	// share.Value * G should be equal to sum_j commitments[j] * index^j
+	var expected, term, cj btcec.JacobianPoint
+	var power, x btcec.ModNScalar
+	power.SetInt(1)
+	x.SetInt(uint32(share.Index))
+	for _, c := range commitments {
+		c.AsJacobian(&cj)
+		btcec.ScalarMultNonConst(&power, &cj, &term)
+		btcec.AddNonConst(&expected, &term, &expected)
+		power.Mul(&x)
+	}
+	expected.ToAffine()
+
+	var actual btcec.JacobianPoint
+	btcec.ScalarBaseMultNonConst(&share.Value, &actual)
+	actual.ToAffine()
+
+	if !expected.X.Equals(&actual.X) || !expected.Y.Equals(&actual.Y) {
+		return fmt.Errorf("the share %d does not match the commitments", share.Index)
+	}
+
+	return nil
+}
+
+// Combine recovers the secret from the given shares by Lagrange interpolation.
+// The shares should have distinct indices, and the result is only the secret
+// if the number of shares is at least the threshold of the split
+func Combine(shares []*Share) (*btcec.ModNScalar, error) {
+	if len(shares) == 0 {
+		return nil, errors.New("no share is given")
+	}
+
+	seen := make(map[uint8]bool, len(shares))
+	for _, s := range shares {
+		if s.Index == 0 {
+			return nil, errors.New("the share index should not be zero")
+		}
+		if seen[s.Index] {
+			return nil, fmt.Errorf("duplicate share index %d", s.Index)
+		}
+		seen[s.Index] = true
+	}
+
+	// secret = sum_i y_i * prod_{j != i} x_j / (x_j - x_i)
+	secret := new(btcec.ModNScalar)
+	for i, si := range shares {
+		var num, den, xi btcec.ModNScalar
+		num.SetInt(1)
+		den.SetInt(1)
+		xi.SetInt(uint32(si.Index))
+		for j, sj := range shares {
+			if i == j {
+				continue
+			}
+			var xj, diff btcec.ModNScalar
+			xj.SetInt(uint32(sj.Index))
+			num.Mul(&xj)
+			diff.NegateVal(&xi).Add(&xj)
+			den.Mul(&diff)
+		}
+		var term btcec.ModNScalar
+		term.Mul2(&si.Value, &num).Mul(den.InverseNonConst())
+		secret.Add(&term)
+	}
+
+	return secret, nil
+}
+
+// evalPolynomial evaluates the polynomial with the given coefficients at x
+// using Horner's method
+func evalPolynomial(coeffs []btcec.ModNScalar, x uint8, result *btcec.ModNScalar) {
+	var xs btcec.ModNScalar
+	xs.SetInt(uint32(x))
+	result.Zero()
+	for i := len(coeffs) - 1; i >= 0; i-- {
+		result.Mul(&xs).Add(&coeffs[i])
+	}
+}
+
+func randScalar(s *btcec.ModNScalar) error {
+	var b [32]byte
+	for {
+		if _, err := rand.Read(b[:]); err != nil {
+			return fmt.Errorf("failed to generate random coefficient: %w", err)
+		}
+		// reject values which overflow the group order to keep the
+		// distribution uniform
+		if overflow := s.SetBytes(&b); overflow == 0 && !s.IsZero() {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package shamir_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/shamir"
+	"github.com/babylonchain/finality-provider/testutil"
+)
+
+// FuzzSplitCombine tests that any threshold of shares recovers the secret
+// while tampered shares are detected by the commitments
+func FuzzSplitCombine(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		sk, pk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+
+		total := uint32(r.Intn(10) + 1)
+		threshold := uint32(r.Intn(int(total)) + 1)
+		shares, commitments, err := shamir.Split(&sk.Key, threshold, total)
+		require.NoError(t, err)
+		require.Len(t, shares, int(total))
+		require.Len(t, commitments, int(threshold))
+		require.True(t, commitments[0].IsEqual(pk))
+
+		for _, s := range shares {
+			require.NoError(t, shamir.VerifyShare(s, commitments))
+		}
+
+		// a random subset of threshold shares recovers the secret
+		r.Shuffle(len(shares), func(i, j int) { shares[i], shares[j] = shares[j], shares[i] })
+		secret, err := shamir.Combine(shares[:threshold])
+		require.NoError(t, err)
+		require.True(t, secret.Equals(&sk.Key))
+
+		// fewer shares do not recover the secret
+		if threshold > 1 {
+			secret, err = shamir.Combine(shares[:threshold-1])
+			require.NoError(t, err)
+			require.False(t, secret.Equals(&sk.Key))
+		}
+
+		// duplicate shares are refused
+		_, err = shamir.Combine([]*shamir.Share{shares[0], shares[0]})
+		require.Error(t, err)
+
+		// a tampered share does not match the commitments
+		tampered := *shares[0]
+		var one btcec.ModNScalar
+		one.SetInt(1)
+		tampered.Value.Add(&one)
+		require.Error(t, shamir.VerifyShare(&tampered, commitments))
+	})
+}
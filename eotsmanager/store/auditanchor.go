@@ -0,0 +1,49 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/babylonchain/finality-provider/util"
+)
+
+// AuditAnchor is the head of the audit log kept outside of the database, so
+// that the log cannot be truncated or rewritten by modifying the database alone
+type AuditAnchor struct {
+	NumRecords uint64 `json:"num_records"`
+	LastHash   string `json:"last_hash"`
+}
+
+// ReadAuditAnchor reads the anchor at the given path. It returns
+// ErrAuditAnchorNotFound if the file does not exist
+func ReadAuditAnchor(path string) (*AuditAnchor, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrAuditAnchorNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the audit anchor %s: %w", path, err)
+	}
+
+	anchor := new(AuditAnchor)
+	if err := json.Unmarshal(data, anchor); err != nil {
+		return nil, fmt.Errorf("invalid audit anchor %s: %w", path, err)
+	}
+
+	return anchor, nil
+}
+
+// WriteAuditAnchor replaces the anchor at the given path atomically
+func WriteAuditAnchor(path string, anchor *AuditAnchor) error {
+	data, err := json.Marshal(anchor)
+	if err != nil {
+		return err
+	}
+	if err := util.WriteFileAtomic(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write the audit anchor %s: %w", path, err)
+	}
+
+	return nil
+}
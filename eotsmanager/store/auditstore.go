@@ -0,0 +1,377 @@
+package store
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	pm "google.golang.org/protobuf/proto"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/proto"
+)
+
+var (
+	// mapping: sequence number (BigEndian) -> audit record
+	auditLogBucketName = []byte("auditLog")
+)
+
+// AuditFilter selects the audit records to list. Zero values mean no bound
+type AuditFilter struct {
+	Pk          []byte
+	StartHeight uint64
+	EndHeight   uint64
+	StartTime   time.Time
+	EndTime     time.Time
+	Limit       uint32
+}
+
+// AuditStore stores the append-only, hash-chained audit log of the signing
+// calls made to the EOTS manager. If the path of an anchor file is given, the
+// number of records and the hash of the last one are also written to it after
+// every append, so that the log cannot be truncated or rewritten without
+// access to the file
+type AuditStore struct {
+	db         kvdb.Backend
+	anchorPath string
+
+	// mu orders the appends so that the anchor never moves backwards
+	mu sync.Mutex
+}
+
+func NewAuditStore(db kvdb.Backend, anchorPath string) (*AuditStore, error) {
+	s := &AuditStore{db: db, anchorPath: anchorPath}
+	if _, err := MigrateDB(db, false); err != nil {
+		return nil, err
+	}
+	if err := s.initBuckets(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *AuditStore) initBuckets() error {
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		_, err := tx.CreateTopLevelBucket(auditLogBucketName)
+		return err
+	})
+}
+
+// AppendAuditRecords appends the given records to the log atomically. The
+// sequence numbers and the hashes of the records are filled in, so that each
+// record is chained to the last one in the log
+func (s *AuditStore) AppendAuditRecords(records []*proto.AuditRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var (
+		seq      uint64
+		prevHash []byte
+	)
+	err := kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(auditLogBucketName)
+		if bucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		seq, prevHash = 0, nil
+		k, v := bucket.ReadWriteCursor().Last()
+		if k != nil {
+			last := new(proto.AuditRecord)
+			if err := pm.Unmarshal(v, last); err != nil {
+				return err
+			}
+			seq, prevHash = last.Seq, last.Hash
+		}
+
+		for _, r := range records {
+			seq++
+			r.Seq = seq
+			r.PrevHash = prevHash
+			r.Hash = auditRecordHash(r)
+			prevHash = r.Hash
+
+			recordBytes, err := pm.Marshal(r)
+			if err != nil {
+				return fmt.Errorf("failed to marshal the audit record: %w", err)
+			}
+			if err := bucket.Put(getAuditRecordKey(seq), recordBytes); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.writeAnchor(seq, prevHash)
+}
+
+// ListAuditRecords returns the records matching the given filter ordered by
+// their sequence numbers. A record matches the height bounds if any of the
+// heights it covers is within them
+func (s *AuditStore) ListAuditRecords(filter *AuditFilter) ([]*proto.AuditRecord, error) {
+	var records []*proto.AuditRecord
+	err := s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(auditLogBucketName)
+		if bucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		c := bucket.ReadCursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			r := new(proto.AuditRecord)
+			if err := pm.Unmarshal(v, r); err != nil {
+				return err
+			}
+			if !filter.matches(r) {
+				continue
+			}
+			records = append(records, r)
+			if filter.Limit != 0 && len(records) == int(filter.Limit) {
+				break
+			}
+		}
+
+		return nil
+	}, func() {
+		records = nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// VerifyAuditLog checks that the sequence numbers of the records are
+// contiguous and that each record is chained to its predecessor with a valid
+// hash. It returns the number of records and the hash of the last one, which
+// can be kept elsewhere to also detect the truncation of the log
+func (s *AuditStore) VerifyAuditLog() (uint64, []byte, error) {
+	var (
+		count    uint64
+		lastHash []byte
+	)
+	err := s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(auditLogBucketName)
+		if bucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		c := bucket.ReadCursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			expectedSeq := count + 1
+			if len(k) != 8 {
+				return fmt.Errorf("%w: invalid key %x after record %d", ErrCorruptedAuditLog, k, count)
+			}
+			if seq := binary.BigEndian.Uint64(k); seq != expectedSeq {
+				return fmt.Errorf("%w: expected record %d, got record %d", ErrCorruptedAuditLog, expectedSeq, seq)
+			}
+
+			r := new(proto.AuditRecord)
+			if err := pm.Unmarshal(v, r); err != nil {
+				return fmt.Errorf("%w: failed to decode record %d: %v", ErrCorruptedAuditLog, expectedSeq, err)
+			}
+			if r.Seq != expectedSeq {
+				return fmt.Errorf("%w: record %d is stored as record %d", ErrCorruptedAuditLog, r.Seq, expectedSeq)
+			}
+			if !bytes.Equal(r.PrevHash, lastHash) {
+				return fmt.Errorf("%w: record %d is not chained to its predecessor", ErrCorruptedAuditLog, expectedSeq)
+			}
+			if !bytes.Equal(r.Hash, auditRecordHash(r)) {
+				return fmt.Errorf("%w: the hash of record %d does not match its content", ErrCorruptedAuditLog, expectedSeq)
+			}
+
+			count = expectedSeq
+			lastHash = r.Hash
+		}
+
+		return nil
+	}, func() {
+		count = 0
+		lastHash = nil
+	})
+
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return count, lastHash, nil
+}
+
+// CheckAuditAnchor checks that the record the anchor points to is still in
+// the log with the anchored hash, i.e., that the log was neither truncated nor
+// rewritten up to it. It returns the anchor, or ErrAuditAnchorNotFound if the
+// log has not been anchored yet
+func (s *AuditStore) CheckAuditAnchor() (*AuditAnchor, error) {
+	if s.anchorPath == "" {
+		return nil, ErrAuditAnchorNotFound
+	}
+	anchor, err := ReadAuditAnchor(s.anchorPath)
+	if err != nil {
+		return nil, err
+	}
+	anchoredHash, err := hex.DecodeString(anchor.LastHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hash in the audit anchor %s: %w", s.anchorPath, err)
+	}
+	if anchor.NumRecords == 0 {
+		return anchor, nil
+	}
+
+	err = s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(auditLogBucketName)
+		if bucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		v := bucket.Get(getAuditRecordKey(anchor.NumRecords))
+		if v == nil {
+			return fmt.Errorf("%w: the anchored record %d is missing, the log was truncated",
+				ErrCorruptedAuditLog, anchor.NumRecords)
+		}
+		r := new(proto.AuditRecord)
+		if err := pm.Unmarshal(v, r); err != nil {
+			return fmt.Errorf("%w: failed to decode record %d: %v", ErrCorruptedAuditLog, anchor.NumRecords, err)
+		}
+		if !bytes.Equal(r.Hash, anchoredHash) {
+			return fmt.Errorf("%w: the hash of record %d does not match the anchor, the log was rewritten",
+				ErrCorruptedAuditLog, anchor.NumRecords)
+		}
+
+		return nil
+	}, func() {})
+	if err != nil {
+		return nil, err
+	}
+
+	return anchor, nil
+}
+
+// UpdateAuditAnchor anchors the log at its last record, e.g., once it is
+// checked against the previous anchor on start
+func (s *AuditStore) UpdateAuditAnchor() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var (
+		seq      uint64
+		lastHash []byte
+	)
+	err := s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(auditLogBucketName)
+		if bucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		k, v := bucket.ReadCursor().Last()
+		if k == nil {
+			return nil
+		}
+		last := new(proto.AuditRecord)
+		if err := pm.Unmarshal(v, last); err != nil {
+			return err
+		}
+		seq, lastHash = last.Seq, last.Hash
+
+		return nil
+	}, func() {
+		seq, lastHash = 0, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.writeAnchor(seq, lastHash)
+}
+
+func (s *AuditStore) writeAnchor(numRecords uint64, lastHash []byte) error {
+	if s.anchorPath == "" {
+		return nil
+	}
+
+	return WriteAuditAnchor(s.anchorPath, &AuditAnchor{
+		NumRecords: numRecords,
+		LastHash:   hex.EncodeToString(lastHash),
+	})
+}
+
+// CopyAuditLog replaces the audit log of the database to with the one of the
+// database from, e.g., so that restoring a snapshot does not truncate the log
+// to the calls made before it was taken. The number of records is returned
+func CopyAuditLog(from, to kvdb.Backend) (int, error) {
+	return replaceBucket(from, to, auditLogBucketName)
+}
+
+func (f *AuditFilter) matches(r *proto.AuditRecord) bool {
+	if len(f.Pk) != 0 && !bytes.Equal(f.Pk, r.Pk) {
+		return false
+	}
+
+	lastHeight := r.Height
+	if r.Num > 1 {
+		lastHeight += uint64(r.Num) - 1
+	}
+	if f.StartHeight != 0 && lastHeight < f.StartHeight {
+		return false
+	}
+	if f.EndHeight != 0 && r.Height > f.EndHeight {
+		return false
+	}
+
+	ts := time.Unix(0, r.Timestamp)
+	if !f.StartTime.IsZero() && ts.Before(f.StartTime) {
+		return false
+	}
+	if !f.EndTime.IsZero() && ts.After(f.EndTime) {
+		return false
+	}
+
+	return true
+}
+
+// auditRecordHash computes the hash of a record over an explicit encoding of
+// all of its fields but the hash itself, so that it does not depend on how
+// the record is serialized in db
+func auditRecordHash(r *proto.AuditRecord) []byte {
+	h := sha256.New()
+
+	writeUint64 := func(v uint64) {
+		var buf [8]byte
+		binary.BigEndian.PutUint64(buf[:], v)
+		h.Write(buf[:])
+	}
+	writeBytes := func(b []byte) {
+		writeUint64(uint64(len(b)))
+		h.Write(b)
+	}
+
+	writeUint64(r.Seq)
+	writeUint64(uint64(r.Timestamp))
+	writeBytes([]byte(r.Caller))
+	writeBytes([]byte(r.Method))
+	writeBytes(r.Pk)
+	writeBytes(r.ChainId)
+	writeUint64(r.Height)
+	writeUint64(uint64(r.Num))
+	writeBytes(r.MsgHash)
+	writeBytes([]byte(r.Result))
+	writeBytes(r.PrevHash)
+
+	return h.Sum(nil)
+}
+
+func getAuditRecordKey(seq uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, seq)
+	return key
+}
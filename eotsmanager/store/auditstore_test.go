@@ -0,0 +1,236 @@
+package store_test
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"math/rand"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/stretchr/testify/require"
+	pm "google.golang.org/protobuf/proto"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/config"
+	"github.com/babylonchain/finality-provider/eotsmanager/proto"
+	"github.com/babylonchain/finality-provider/eotsmanager/store"
+	"github.com/babylonchain/finality-provider/testutil"
+)
+
+// FuzzAuditStore tests appending, filtering, and verifying the audit log,
+// and that modified, removed, or truncated records are detected
+func FuzzAuditStore(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		cfg := config.DefaultDBConfigWithHomePath(t.TempDir())
+		dbBackend, err := cfg.GetDbBackend()
+		require.NoError(t, err)
+		defer dbBackend.Close()
+
+		anchorPath := filepath.Join(t.TempDir(), "audit.anchor")
+		as, err := store.NewAuditStore(dbBackend, anchorPath)
+		require.NoError(t, err)
+		_, err = as.CheckAuditAnchor()
+		require.ErrorIs(t, err, store.ErrAuditAnchorNotFound)
+
+		num, lastHash, err := as.VerifyAuditLog()
+		require.NoError(t, err)
+		require.Zero(t, num)
+		require.Empty(t, lastHash)
+
+		pk := testutil.GenRandomByteArray(r, 32)
+		otherPk := testutil.GenRandomByteArray(r, 32)
+		startTime := time.Now()
+		numRecords := r.Intn(10) + 3
+		for i := 0; i < numRecords; i++ {
+			record := &proto.AuditRecord{
+				Timestamp: startTime.Add(time.Duration(i) * time.Second).UnixNano(),
+				Caller:    testutil.GenRandomHexStr(r, 10),
+				Method:    "SignEOTS",
+				Pk:        pk,
+				ChainId:   []byte("chain-test"),
+				Height:    uint64(i + 1),
+				MsgHash:   testutil.GenRandomByteArray(r, 32),
+			}
+			if i%2 == 1 {
+				record.Pk = otherPk
+			}
+			err = as.AppendAuditRecords([]*proto.AuditRecord{record})
+			require.NoError(t, err)
+		}
+		// records of a batch are chained in order
+		batchHeight := uint64(numRecords + 1)
+		err = as.AppendAuditRecords([]*proto.AuditRecord{
+			{Timestamp: time.Now().UnixNano(), Method: "CreateRandomnessPairList", Pk: pk, Height: batchHeight, Num: 10},
+			{Timestamp: time.Now().UnixNano(), Method: "SignSchnorrSig", Pk: pk, Result: "failed"},
+		})
+		require.NoError(t, err)
+		numRecords += 2
+
+		num, lastHash, err = as.VerifyAuditLog()
+		require.NoError(t, err)
+		require.Equal(t, uint64(numRecords), num)
+
+		all, err := as.ListAuditRecords(&store.AuditFilter{})
+		require.NoError(t, err)
+		require.Len(t, all, numRecords)
+		require.Equal(t, lastHash, all[numRecords-1].Hash)
+		for i, record := range all {
+			require.Equal(t, uint64(i+1), record.Seq)
+		}
+
+		// filters
+		records, err := as.ListAuditRecords(&store.AuditFilter{Pk: otherPk})
+		require.NoError(t, err)
+		for _, record := range records {
+			require.Equal(t, otherPk, record.Pk)
+		}
+		records, err = as.ListAuditRecords(&store.AuditFilter{StartHeight: 2, EndHeight: 3})
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		// the randomness record covers the heights after its start height
+		records, err = as.ListAuditRecords(&store.AuditFilter{StartHeight: batchHeight + 5})
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		require.Equal(t, "CreateRandomnessPairList", records[0].Method)
+		records, err = as.ListAuditRecords(&store.AuditFilter{
+			StartTime: startTime.Add(time.Second),
+			EndTime:   startTime.Add(2 * time.Second),
+		})
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		records, err = as.ListAuditRecords(&store.AuditFilter{Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+
+		// the log is anchored at its last record, so removing the latest
+		// records is detected although the rest of the log is valid
+		anchor, err := as.CheckAuditAnchor()
+		require.NoError(t, err)
+		require.Equal(t, uint64(numRecords), anchor.NumRecords)
+		require.Equal(t, hex.EncodeToString(lastHash), anchor.LastHash)
+		last := all[numRecords-1]
+		err = kvdb.Update(dbBackend, func(tx kvdb.RwTx) error {
+			return tx.ReadWriteBucket([]byte("auditLog")).Delete(auditRecordKey(last.Seq))
+		}, func() {})
+		require.NoError(t, err)
+		_, _, err = as.VerifyAuditLog()
+		require.NoError(t, err)
+		_, err = as.CheckAuditAnchor()
+		require.ErrorIs(t, err, store.ErrCorruptedAuditLog)
+		putAuditRecord(t, dbBackend, last)
+		_, err = as.CheckAuditAnchor()
+		require.NoError(t, err)
+
+		// tamper with a random record
+		original := all[r.Intn(numRecords)]
+		tampered := pm.Clone(original).(*proto.AuditRecord)
+		tampered.Height = datagen.RandomInt(r, 1000) + 1000
+		putAuditRecord(t, dbBackend, tampered)
+		_, _, err = as.VerifyAuditLog()
+		require.ErrorIs(t, err, store.ErrCorruptedAuditLog)
+
+		// removing a record leaves a gap
+		putAuditRecord(t, dbBackend, original)
+		_, _, err = as.VerifyAuditLog()
+		require.NoError(t, err)
+		err = kvdb.Update(dbBackend, func(tx kvdb.RwTx) error {
+			return tx.ReadWriteBucket([]byte("auditLog")).Delete(auditRecordKey(uint64(r.Intn(numRecords-1) + 1)))
+		}, func() {})
+		require.NoError(t, err)
+		_, _, err = as.VerifyAuditLog()
+		require.ErrorIs(t, err, store.ErrCorruptedAuditLog)
+	})
+}
+
+func putAuditRecord(t *testing.T, db kvdb.Backend, record *proto.AuditRecord) {
+	recordBytes, err := pm.Marshal(record)
+	require.NoError(t, err)
+	err = kvdb.Update(db, func(tx kvdb.RwTx) error {
+		return tx.ReadWriteBucket([]byte("auditLog")).Put(auditRecordKey(record.Seq), recordBytes)
+	}, func() {})
+	require.NoError(t, err)
+}
+
+func auditRecordKey(seq uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, seq)
+	return key
+}
+
+// FuzzCopyAuditLogAndTokens tests that the audit log and the tokens of a
+// database replace the ones of another, e.g., a restored snapshot
+func FuzzCopyAuditLogAndTokens(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		fromBackend, err := config.DefaultDBConfigWithHomePath(t.TempDir()).GetDbBackend()
+		require.NoError(t, err)
+		defer fromBackend.Close()
+		toBackend, err := config.DefaultDBConfigWithHomePath(t.TempDir()).GetDbBackend()
+		require.NoError(t, err)
+		defer toBackend.Close()
+
+		fromAudit, err := store.NewAuditStore(fromBackend, "")
+		require.NoError(t, err)
+		toAudit, err := store.NewAuditStore(toBackend, "")
+		require.NoError(t, err)
+		fromTokens, err := store.NewTokenStore(fromBackend)
+		require.NoError(t, err)
+		toTokens, err := store.NewTokenStore(toBackend)
+		require.NoError(t, err)
+
+		// the destination has a prefix of the audit log of the source
+		newRecord := func() *proto.AuditRecord {
+			return &proto.AuditRecord{
+				Timestamp: time.Now().UnixNano(),
+				Method:    "SignEOTS",
+				Pk:        testutil.GenRandomByteArray(r, 32),
+				Height:    uint64(r.Int63n(1000) + 1),
+			}
+		}
+		numPrefix := r.Intn(5) + 1
+		for i := 0; i < numPrefix; i++ {
+			record := newRecord()
+			require.NoError(t, fromAudit.AppendAuditRecords([]*proto.AuditRecord{record}))
+			require.NoError(t, toAudit.AppendAuditRecords([]*proto.AuditRecord{pm.Clone(record).(*proto.AuditRecord)}))
+		}
+		numRecords := numPrefix + r.Intn(5) + 1
+		for i := numPrefix; i < numRecords; i++ {
+			require.NoError(t, fromAudit.AppendAuditRecords([]*proto.AuditRecord{newRecord()}))
+		}
+
+		// the destination has a token revoked in the source, which has a
+		// token created since
+		revoked := &proto.AuthToken{Id: testutil.GenRandomHexStr(r, 8), Methods: []string{"SignEOTS"}}
+		kept := &proto.AuthToken{Id: testutil.GenRandomHexStr(r, 8), Methods: []string{"SignEOTS"}}
+		created := &proto.AuthToken{Id: testutil.GenRandomHexStr(r, 8), Methods: []string{"SignEOTS"}}
+		require.NoError(t, toTokens.AddAuthToken(revoked))
+		require.NoError(t, toTokens.AddAuthToken(kept))
+		require.NoError(t, fromTokens.AddAuthToken(kept))
+		require.NoError(t, fromTokens.AddAuthToken(created))
+
+		copiedRecords, err := store.CopyAuditLog(fromBackend, toBackend)
+		require.NoError(t, err)
+		require.Equal(t, numRecords, copiedRecords)
+		fromNum, fromHash, err := fromAudit.VerifyAuditLog()
+		require.NoError(t, err)
+		toNum, toHash, err := toAudit.VerifyAuditLog()
+		require.NoError(t, err)
+		require.Equal(t, fromNum, toNum)
+		require.Equal(t, fromHash, toHash)
+
+		copiedTokens, err := store.CopyAuthTokens(fromBackend, toBackend)
+		require.NoError(t, err)
+		require.Equal(t, 2, copiedTokens)
+		_, err = toTokens.GetAuthToken(revoked.Id)
+		require.ErrorIs(t, err, store.ErrAuthTokenNotFound)
+		_, err = toTokens.GetAuthToken(created.Id)
+		require.NoError(t, err)
+	})
+}
@@ -1,16 +1,27 @@
 package store
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcwallet/walletdb"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lightningnetwork/lnd/kvdb"
+	pm "google.golang.org/protobuf/proto"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/proto"
 )
 
 var (
 	eotsBucketName = []byte("fpKeyNames")
+	// mapping: pk -> key metadata
+	keyMetadataBucketName = []byte("keyMetadata")
+	// mapping: pk || chain_id || height -> signing record
+	signRecordsBucketName = []byte("signRecords")
 )
 
 type EOTSStore struct {
@@ -19,6 +30,9 @@ type EOTSStore struct {
 
 func NewEOTSStore(db kvdb.Backend) (*EOTSStore, error) {
 	s := &EOTSStore{db}
+	if _, err := MigrateDB(db, false); err != nil {
+		return nil, err
+	}
 	if err := s.initBuckets(); err != nil {
 		return nil, err
 	}
@@ -33,6 +47,16 @@ func (s *EOTSStore) initBuckets() error {
 			return err
 		}
 
+		_, err = tx.CreateTopLevelBucket(keyMetadataBucketName)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateTopLevelBucket(signRecordsBucketName)
+		if err != nil {
+			return err
+		}
+
 		return nil
 	})
 }
@@ -54,10 +78,47 @@ func (s *EOTSStore) AddEOTSKeyName(
 			return ErrDuplicateEOTSKeyName
 		}
 
-		return saveEOTSKeyName(eotsBucket, pkBytes, keyName)
+		if err := saveEOTSKeyName(eotsBucket, pkBytes, keyName); err != nil {
+			return err
+		}
+
+		metadataBucket := tx.ReadWriteBucket(keyMetadataBucketName)
+		if metadataBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		return saveKeyMetadata(metadataBucket, pkBytes, &proto.KeyMetadata{CreatedAt: time.Now().Unix()})
 	})
 }
 
+func saveKeyMetadata(
+	metadataBucket walletdb.ReadWriteBucket,
+	btcPk []byte,
+	metadata *proto.KeyMetadata,
+) error {
+	metadataBytes, err := pm.Marshal(metadata)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the key metadata: %w", err)
+	}
+
+	return metadataBucket.Put(btcPk, metadataBytes)
+}
+
+func getKeyMetadata(metadataBucket walletdb.ReadBucket, btcPk []byte) (*proto.KeyMetadata, error) {
+	metadata := new(proto.KeyMetadata)
+	metadataBytes := metadataBucket.Get(btcPk)
+	if metadataBytes == nil {
+		// keys created before the metadata was introduced
+		return metadata, nil
+	}
+
+	if err := pm.Unmarshal(metadataBytes, metadata); err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
 func saveEOTSKeyName(
 	eotsBucket walletdb.ReadWriteBucket,
 	btcPk []byte,
@@ -93,3 +154,447 @@ func (s *EOTSStore) GetEOTSKeyName(pk []byte) (string, error) {
 
 	return keyName, nil
 }
+
+// GetKeyMetadata returns the metadata of the key pk, which is empty if
+// the key was created before the metadata was recorded
+func (s *EOTSStore) GetKeyMetadata(pk []byte) (*proto.KeyMetadata, error) {
+	var metadata *proto.KeyMetadata
+	err := s.db.View(func(tx kvdb.RTx) error {
+		eotsBucket := tx.ReadBucket(eotsBucketName)
+		if eotsBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+		if eotsBucket.Get(pk) == nil {
+			return ErrEOTSKeyNameNotFound
+		}
+
+		metadataBucket := tx.ReadBucket(keyMetadataBucketName)
+		if metadataBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		var err error
+		metadata, err = getKeyMetadata(metadataBucket, pk)
+		return err
+	}, func() {})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+// ImportKey saves the name, the metadata, and the signing records of an
+// imported key in a single transaction. It returns ErrDuplicateEOTSKeyName
+// if the key pk already exists
+func (s *EOTSStore) ImportKey(
+	pk []byte,
+	keyName string,
+	metadata *proto.KeyMetadata,
+	records []*proto.SigningRecordEntry,
+) error {
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		eotsBucket := tx.ReadWriteBucket(eotsBucketName)
+		if eotsBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		if eotsBucket.Get(pk) != nil {
+			return ErrDuplicateEOTSKeyName
+		}
+
+		if err := saveEOTSKeyName(eotsBucket, pk, keyName); err != nil {
+			return err
+		}
+
+		metadataBucket := tx.ReadWriteBucket(keyMetadataBucketName)
+		if metadataBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		if err := saveKeyMetadata(metadataBucket, pk, metadata); err != nil {
+			return err
+		}
+
+		signRecordsBucket := tx.ReadWriteBucket(signRecordsBucketName)
+		if signRecordsBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		for _, r := range records {
+			recordBytes, err := pm.Marshal(r.Record)
+			if err != nil {
+				return fmt.Errorf("failed to marshal the signing record: %w", err)
+			}
+			key := getSignRecordKey(pk, r.ChainId, r.Height)
+			if err := signRecordsBucket.Put(key, recordBytes); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// AddRandChainID records that randomness of the key pk has been generated
+// for the given chain. It is a no-op if the chain is already recorded
+func (s *EOTSStore) AddRandChainID(pk []byte, chainID []byte) error {
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		eotsBucket := tx.ReadBucket(eotsBucketName)
+		if eotsBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+		if eotsBucket.Get(pk) == nil {
+			return ErrEOTSKeyNameNotFound
+		}
+
+		metadataBucket := tx.ReadWriteBucket(keyMetadataBucketName)
+		if metadataBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		metadata, err := getKeyMetadata(metadataBucket, pk)
+		if err != nil {
+			return err
+		}
+
+		for _, id := range metadata.ChainIds {
+			if bytes.Equal(id, chainID) {
+				return nil
+			}
+		}
+		metadata.ChainIds = append(metadata.ChainIds, chainID)
+
+		return saveKeyMetadata(metadataBucket, pk, metadata)
+	})
+}
+
+// ListKeys returns the information of all the EOTS keys in the store
+func (s *EOTSStore) ListKeys() ([]*proto.KeyInfo, error) {
+	var keys []*proto.KeyInfo
+	err := s.db.View(func(tx kvdb.RTx) error {
+		eotsBucket := tx.ReadBucket(eotsBucketName)
+		if eotsBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		metadataBucket := tx.ReadBucket(keyMetadataBucketName)
+		if metadataBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		return eotsBucket.ForEach(func(pk, name []byte) error {
+			metadata, err := getKeyMetadata(metadataBucket, pk)
+			if err != nil {
+				return err
+			}
+
+			keys = append(keys, &proto.KeyInfo{
+				Name: string(name),
+				// the slice is only valid within the transaction
+				Pk:        bytes.Clone(pk),
+				CreatedAt: metadata.CreatedAt,
+				ChainIds:  metadata.ChainIds,
+			})
+			return nil
+		})
+	}, func() {
+		keys = nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// SaveSignRecord saves the EOTS signature produced over the given message
+// by the key pk for the given chain and height. It returns
+// ErrDuplicateSignRecord if a record already exists at the same position
+func (s *EOTSStore) SaveSignRecord(
+	pk []byte,
+	chainID []byte,
+	height uint64,
+	msg []byte,
+	eotsSig []byte,
+) error {
+	return s.SaveSignRecords(pk, []*proto.SigningRecordEntry{{
+		ChainId: chainID,
+		Height:  height,
+		Record: &proto.SigningRecord{
+			Msg:       msg,
+			EotsSig:   eotsSig,
+			Timestamp: time.Now().Unix(),
+		},
+	}})
+}
+
+// SaveSignRecords saves the given signing records of the key pk in a single
+// transaction. Nothing is saved and ErrDuplicateSignRecord is returned if a
+// record already exists at the position of any of them
+func (s *EOTSStore) SaveSignRecords(pk []byte, entries []*proto.SigningRecordEntry) error {
+	keys := make([][]byte, 0, len(entries))
+	values := make([][]byte, 0, len(entries))
+	for _, e := range entries {
+		recordBytes, err := pm.Marshal(e.Record)
+		if err != nil {
+			return fmt.Errorf("failed to marshal the signing record: %w", err)
+		}
+		keys = append(keys, getSignRecordKey(pk, e.ChainId, e.Height))
+		values = append(values, recordBytes)
+	}
+
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(signRecordsBucketName)
+		if bucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		for i, key := range keys {
+			if bucket.Get(key) != nil {
+				return fmt.Errorf("%w: height %d", ErrDuplicateSignRecord, entries[i].Height)
+			}
+			if err := bucket.Put(key, values[i]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetSignRecord returns the signing record of the key pk for the given chain
+// and height. The returned bool indicates whether the record is found
+func (s *EOTSStore) GetSignRecord(pk []byte, chainID []byte, height uint64) (*proto.SigningRecord, bool, error) {
+	key := getSignRecordKey(pk, chainID, height)
+	record := new(proto.SigningRecord)
+	found := false
+
+	err := s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(signRecordsBucketName)
+		if bucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		recordBytes := bucket.Get(key)
+		if recordBytes == nil {
+			return nil
+		}
+
+		found = true
+		return pm.Unmarshal(recordBytes, record)
+	}, func() {})
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !found {
+		return nil, false, nil
+	}
+
+	return record, true, nil
+}
+
+// ListSignRecords returns all the signing records of the key pk
+func (s *EOTSStore) ListSignRecords(pk []byte) ([]*proto.SigningRecordEntry, error) {
+	var entries []*proto.SigningRecordEntry
+	err := s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(signRecordsBucketName)
+		if bucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		c := bucket.ReadCursor()
+		for k, v := c.Seek(pk); k != nil && bytes.HasPrefix(k, pk); k, v = c.Next() {
+			chainID, height, err := parseSignRecordKey(pk, k)
+			if err != nil {
+				return err
+			}
+
+			record := new(proto.SigningRecord)
+			if err := pm.Unmarshal(v, record); err != nil {
+				return err
+			}
+
+			entries = append(entries, &proto.SigningRecordEntry{
+				ChainId: chainID,
+				Height:  height,
+				Record:  record,
+			})
+		}
+
+		return nil
+	}, func() {
+		entries = nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// CopySignRecords copies the signing records of the database from into the
+// database to, e.g., so that restoring a snapshot does not forget what was
+// signed since it was taken. The records of from take precedence. The keys of
+// the records which are unknown to to are copied with their names and metadata.
+// The number of records which were missing or different in to is returned
+func CopySignRecords(from, to kvdb.Backend) (int, error) {
+	var (
+		keys     [][]byte
+		values   [][]byte
+		keyNames = make(map[string][]byte)
+		metadata = make(map[string][]byte)
+	)
+	err := from.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(signRecordsBucketName)
+		if bucket == nil {
+			return nil
+		}
+		keyNamesBucket := tx.ReadBucket(eotsBucketName)
+		metadataBucket := tx.ReadBucket(keyMetadataBucketName)
+		if keyNamesBucket == nil || metadataBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			if len(k) < schnorr.PubKeyBytesLen+8 {
+				return fmt.Errorf("%w: invalid signing record key length %d", ErrCorruptedEOTSDb, len(k))
+			}
+			keys = append(keys, bytes.Clone(k))
+			values = append(values, bytes.Clone(v))
+
+			pk := k[:schnorr.PubKeyBytesLen]
+			if _, ok := keyNames[string(pk)]; !ok {
+				keyNames[string(pk)] = bytes.Clone(keyNamesBucket.Get(pk))
+				metadata[string(pk)] = bytes.Clone(metadataBucket.Get(pk))
+			}
+			return nil
+		})
+	}, func() {
+		keys, values = nil, nil
+		keyNames = make(map[string][]byte)
+		metadata = make(map[string][]byte)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	copied := 0
+	err = kvdb.Update(to, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(signRecordsBucketName)
+		keyNamesBucket := tx.ReadWriteBucket(eotsBucketName)
+		metadataBucket := tx.ReadWriteBucket(keyMetadataBucketName)
+		if bucket == nil || keyNamesBucket == nil || metadataBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		for pk, name := range keyNames {
+			if keyNamesBucket.Get([]byte(pk)) != nil || name == nil {
+				continue
+			}
+			if err := keyNamesBucket.Put([]byte(pk), name); err != nil {
+				return err
+			}
+			if metadata[pk] == nil {
+				continue
+			}
+			if err := metadataBucket.Put([]byte(pk), metadata[pk]); err != nil {
+				return err
+			}
+		}
+
+		for i, key := range keys {
+			if bytes.Equal(bucket.Get(key), values[i]) {
+				continue
+			}
+			if err := bucket.Put(key, values[i]); err != nil {
+				return err
+			}
+			copied++
+		}
+
+		return nil
+	}, func() {
+		copied = 0
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return copied, nil
+}
+
+// replaceBucket replaces the entries of the top-level bucket name of the
+// database to with the ones of the database from, and returns their number
+func replaceBucket(from, to kvdb.Backend, name []byte) (int, error) {
+	var keys, values [][]byte
+	err := from.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(name)
+		if bucket == nil {
+			return fmt.Errorf("%w: missing bucket %s", ErrCorruptedEOTSDb, name)
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			keys = append(keys, bytes.Clone(k))
+			values = append(values, bytes.Clone(v))
+			return nil
+		})
+	}, func() {
+		keys, values = nil, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	err = kvdb.Update(to, func(tx kvdb.RwTx) error {
+		if err := tx.DeleteTopLevelBucket(name); err != nil && !errors.Is(err, walletdb.ErrBucketNotFound) {
+			return err
+		}
+		bucket, err := tx.CreateTopLevelBucket(name)
+		if err != nil {
+			return err
+		}
+
+		for i, key := range keys {
+			if err := bucket.Put(key, values[i]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func() {})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(keys), nil
+}
+
+// getSignRecordKey builds the key of a signing record. The key is
+// unambiguous as both the BIP-340 public key and the height are of fixed size
+func getSignRecordKey(pk []byte, chainID []byte, height uint64) []byte {
+	key := make([]byte, 0, len(pk)+len(chainID)+8)
+	key = append(key, pk...)
+	key = append(key, chainID...)
+	key = append(key, sdk.Uint64ToBigEndian(height)...)
+
+	return key
+}
+
+// parseSignRecordKey returns the chain id and the height encoded in the key
+// of a signing record of the key pk
+func parseSignRecordKey(pk []byte, key []byte) ([]byte, uint64, error) {
+	if len(key) < len(pk)+8 {
+		return nil, 0, fmt.Errorf("%w: invalid signing record key length %d", ErrCorruptedEOTSDb, len(key))
+	}
+
+	chainID := bytes.Clone(key[len(pk) : len(key)-8])
+	height := sdk.BigEndianToUint64(key[len(key)-8:])
+
+	return chainID, height, nil
+}
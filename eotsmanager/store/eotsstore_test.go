@@ -63,3 +63,125 @@ func FuzzEOTSStore(f *testing.F) {
 		require.ErrorIs(t, err, store.ErrEOTSKeyNameNotFound)
 	})
 }
+
+// FuzzSignRecord tests save and get signing records properly
+func FuzzSignRecord(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		homePath := t.TempDir()
+		cfg := config.DefaultDBConfigWithHomePath(homePath)
+
+		dbBackend, err := cfg.GetDbBackend()
+		require.NoError(t, err)
+
+		vs, err := store.NewEOTSStore(dbBackend)
+		require.NoError(t, err)
+
+		defer func() {
+			dbBackend.Close()
+			err := os.RemoveAll(homePath)
+			require.NoError(t, err)
+		}()
+
+		_, btcPk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		pk := schnorr.SerializePubKey(btcPk)
+		chainID := datagen.GenRandomByteArray(r, 10)
+		height := datagen.RandomInt(r, 1000)
+		msg := datagen.GenRandomByteArray(r, 32)
+		sig := datagen.GenRandomByteArray(r, 32)
+
+		// no record before saving
+		_, found, err := vs.GetSignRecord(pk, chainID, height)
+		require.NoError(t, err)
+		require.False(t, found)
+
+		err = vs.SaveSignRecord(pk, chainID, height, msg, sig)
+		require.NoError(t, err)
+
+		// add duplicate record
+		err = vs.SaveSignRecord(pk, chainID, height, datagen.GenRandomByteArray(r, 32), sig)
+		require.ErrorIs(t, err, store.ErrDuplicateSignRecord)
+
+		record, found, err := vs.GetSignRecord(pk, chainID, height)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, msg, record.Msg)
+		require.Equal(t, sig, record.EotsSig)
+
+		// records are separated by height
+		_, found, err = vs.GetSignRecord(pk, chainID, height+1)
+		require.NoError(t, err)
+		require.False(t, found)
+	})
+}
+
+// FuzzCopySignRecords tests that the signing records missing from a database
+// are copied into it
+func FuzzCopySignRecords(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		fromBackend, err := config.DefaultDBConfigWithHomePath(t.TempDir()).GetDbBackend()
+		require.NoError(t, err)
+		defer fromBackend.Close()
+		toBackend, err := config.DefaultDBConfigWithHomePath(t.TempDir()).GetDbBackend()
+		require.NoError(t, err)
+		defer toBackend.Close()
+
+		from, err := store.NewEOTSStore(fromBackend)
+		require.NoError(t, err)
+		to, err := store.NewEOTSStore(toBackend)
+		require.NoError(t, err)
+
+		chainID := datagen.GenRandomByteArray(r, 10)
+		height := datagen.RandomInt(r, 1000)
+		msg := datagen.GenRandomByteArray(r, 32)
+		sig := datagen.GenRandomByteArray(r, 32)
+
+		// both databases have the key and its record at the first height,
+		// only the source has the record at the next one
+		_, btcPk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		pk := schnorr.SerializePubKey(btcPk)
+		keyName := testutil.GenRandomHexStr(r, 10)
+		require.NoError(t, from.AddEOTSKeyName(btcPk, keyName))
+		require.NoError(t, to.AddEOTSKeyName(btcPk, keyName))
+		require.NoError(t, from.SaveSignRecord(pk, chainID, height, msg, sig))
+		require.NoError(t, to.SaveSignRecord(pk, chainID, height, msg, sig))
+		nextMsg := datagen.GenRandomByteArray(r, 32)
+		require.NoError(t, from.SaveSignRecord(pk, chainID, height+1, nextMsg, sig))
+
+		// the other key is only known by the source
+		_, otherBtcPk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		otherPk := schnorr.SerializePubKey(otherBtcPk)
+		otherKeyName := testutil.GenRandomHexStr(r, 10)
+		require.NoError(t, from.AddEOTSKeyName(otherBtcPk, otherKeyName))
+		require.NoError(t, from.SaveSignRecord(otherPk, chainID, height, msg, sig))
+
+		copied, err := store.CopySignRecords(fromBackend, toBackend)
+		require.NoError(t, err)
+		require.Equal(t, 2, copied)
+		require.NoError(t, store.ValidateDB(toBackend))
+
+		record, found, err := to.GetSignRecord(pk, chainID, height+1)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, nextMsg, record.Msg)
+		_, found, err = to.GetSignRecord(otherPk, chainID, height)
+		require.NoError(t, err)
+		require.True(t, found)
+		name, err := to.GetEOTSKeyName(otherPk)
+		require.NoError(t, err)
+		require.Equal(t, otherKeyName, name)
+
+		// copying again changes nothing
+		copied, err = store.CopySignRecords(fromBackend, toBackend)
+		require.NoError(t, err)
+		require.Zero(t, copied)
+	})
+}
@@ -11,4 +11,19 @@ var (
 
 	// ErrEOTSKeyNameNotFound The EOTS key name we try to fetch is not found in db
 	ErrEOTSKeyNameNotFound = errors.New("EOTS key name not found")
+
+	// ErrDuplicateSignRecord The signing record we try to add already exists in db
+	ErrDuplicateSignRecord = errors.New("signing record already exists")
+
+	// ErrDuplicateAuthToken The auth token we try to add already exists in db
+	ErrDuplicateAuthToken = errors.New("auth token already exists")
+
+	// ErrAuthTokenNotFound The auth token we try to fetch is not found in db
+	ErrAuthTokenNotFound = errors.New("auth token not found")
+
+	// ErrCorruptedAuditLog The audit log has been tampered with or has gaps
+	ErrCorruptedAuditLog = errors.New("audit log is corrupted")
+
+	// ErrAuditAnchorNotFound The audit log has not been anchored yet
+	ErrAuditAnchorNotFound = errors.New("audit anchor not found")
 )
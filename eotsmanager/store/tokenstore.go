@@ -0,0 +1,143 @@
+package store
+
+import (
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	pm "google.golang.org/protobuf/proto"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/proto"
+)
+
+var (
+	// mapping: token id -> auth token
+	authTokensBucketName = []byte("authTokens")
+)
+
+// TokenStore stores the bearer tokens used to authenticate the callers of
+// the EOTS manager
+type TokenStore struct {
+	db kvdb.Backend
+}
+
+func NewTokenStore(db kvdb.Backend) (*TokenStore, error) {
+	s := &TokenStore{db}
+	if _, err := MigrateDB(db, false); err != nil {
+		return nil, err
+	}
+	if err := s.initBuckets(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *TokenStore) initBuckets() error {
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		_, err := tx.CreateTopLevelBucket(authTokensBucketName)
+		return err
+	})
+}
+
+// AddAuthToken saves the given token. It returns ErrDuplicateAuthToken if
+// a token with the same id already exists
+func (s *TokenStore) AddAuthToken(token *proto.AuthToken) error {
+	if token.Id == "" {
+		return fmt.Errorf("cannot save token with empty id")
+	}
+
+	tokenBytes, err := pm.Marshal(token)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the auth token: %w", err)
+	}
+
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(authTokensBucketName)
+		if bucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		key := []byte(token.Id)
+		if bucket.Get(key) != nil {
+			return ErrDuplicateAuthToken
+		}
+
+		return bucket.Put(key, tokenBytes)
+	})
+}
+
+func (s *TokenStore) GetAuthToken(id string) (*proto.AuthToken, error) {
+	token := new(proto.AuthToken)
+	err := s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(authTokensBucketName)
+		if bucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		tokenBytes := bucket.Get([]byte(id))
+		if tokenBytes == nil {
+			return ErrAuthTokenNotFound
+		}
+
+		return pm.Unmarshal(tokenBytes, token)
+	}, func() {})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// DeleteAuthToken removes the token with the given id, after which the
+// token can no longer be used
+func (s *TokenStore) DeleteAuthToken(id string) error {
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(authTokensBucketName)
+		if bucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		key := []byte(id)
+		if bucket.Get(key) == nil {
+			return ErrAuthTokenNotFound
+		}
+
+		return bucket.Delete(key)
+	})
+}
+
+func (s *TokenStore) ListAuthTokens() ([]*proto.AuthToken, error) {
+	var tokens []*proto.AuthToken
+	err := s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(authTokensBucketName)
+		if bucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		return bucket.ForEach(func(_, v []byte) error {
+			token := new(proto.AuthToken)
+			if err := pm.Unmarshal(v, token); err != nil {
+				return err
+			}
+			tokens = append(tokens, token)
+			return nil
+		})
+	}, func() {
+		tokens = nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// CopyAuthTokens replaces the tokens of the database to with the ones of the
+// database from, e.g., so that restoring a snapshot neither brings back the
+// tokens revoked since it was taken nor forgets the ones created since. The
+// number of tokens is returned
+func CopyAuthTokens(from, to kvdb.Backend) (int, error) {
+	return replaceBucket(from, to, authTokensBucketName)
+}
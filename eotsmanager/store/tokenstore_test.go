@@ -0,0 +1,64 @@
+package store_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/config"
+	"github.com/babylonchain/finality-provider/eotsmanager/proto"
+	"github.com/babylonchain/finality-provider/eotsmanager/store"
+	"github.com/babylonchain/finality-provider/testutil"
+)
+
+// FuzzTokenStore tests saving, listing, and deleting auth tokens
+func FuzzTokenStore(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		cfg := config.DefaultDBConfigWithHomePath(t.TempDir())
+		dbBackend, err := cfg.GetDbBackend()
+		require.NoError(t, err)
+		defer dbBackend.Close()
+
+		ts, err := store.NewTokenStore(dbBackend)
+		require.NoError(t, err)
+
+		num := r.Intn(10) + 1
+		for i := 0; i < num; i++ {
+			token := &proto.AuthToken{
+				Id:         testutil.GenRandomHexStr(r, 8),
+				Name:       testutil.GenRandomHexStr(r, 10),
+				SecretHash: testutil.GenRandomByteArray(r, 32),
+				Methods:    []string{"SignEOTS"},
+			}
+			err = ts.AddAuthToken(token)
+			require.NoError(t, err)
+
+			err = ts.AddAuthToken(token)
+			require.ErrorIs(t, err, store.ErrDuplicateAuthToken)
+
+			tokenFromDb, err := ts.GetAuthToken(token.Id)
+			require.NoError(t, err)
+			require.Equal(t, token.Name, tokenFromDb.Name)
+			require.Equal(t, token.SecretHash, tokenFromDb.SecretHash)
+		}
+
+		tokens, err := ts.ListAuthTokens()
+		require.NoError(t, err)
+		require.Len(t, tokens, num)
+
+		err = ts.DeleteAuthToken(tokens[0].Id)
+		require.NoError(t, err)
+		_, err = ts.GetAuthToken(tokens[0].Id)
+		require.ErrorIs(t, err, store.ErrAuthTokenNotFound)
+		err = ts.DeleteAuthToken(tokens[0].Id)
+		require.ErrorIs(t, err, store.ErrAuthTokenNotFound)
+
+		tokens, err = ts.ListAuthTokens()
+		require.NoError(t, err)
+		require.Len(t, tokens, num-1)
+	})
+}
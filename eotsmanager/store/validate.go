@@ -0,0 +1,106 @@
+package store
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcwallet/walletdb"
+	"github.com/lightningnetwork/lnd/kvdb"
+	pm "google.golang.org/protobuf/proto"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/proto"
+)
+
+// ValidateDB checks that db holds the buckets of the EOTS store and that all
+// their entries are well-formed, e.g., before restoring db from a snapshot.
+// The buckets of the audit log and the auth tokens are checked if present, as
+// they are only created by a running daemon
+func ValidateDB(db kvdb.Backend) error {
+	return db.View(func(tx kvdb.RTx) error {
+		keyNames := tx.ReadBucket(eotsBucketName)
+		metadata := tx.ReadBucket(keyMetadataBucketName)
+		signRecords := tx.ReadBucket(signRecordsBucketName)
+		if keyNames == nil || metadata == nil || signRecords == nil {
+			return fmt.Errorf("%w: missing buckets", ErrCorruptedEOTSDb)
+		}
+
+		pkLen := schnorr.PubKeyBytesLen
+		err := keyNames.ForEach(func(k, v []byte) error {
+			if _, err := schnorr.ParsePubKey(k); err != nil {
+				return fmt.Errorf("invalid key %x: %w", k, err)
+			}
+			if len(v) == 0 {
+				return fmt.Errorf("empty name of the key %x", k)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("%w: %s: %v", ErrCorruptedEOTSDb, eotsBucketName, err)
+		}
+
+		err = forEachProto(metadata, func() pm.Message { return new(proto.KeyMetadata) }, func(k []byte, _ pm.Message) error {
+			if keyNames.Get(k) == nil {
+				return fmt.Errorf("metadata of the unknown key %x", k)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("%w: %s: %v", ErrCorruptedEOTSDb, keyMetadataBucketName, err)
+		}
+
+		err = forEachProto(signRecords, func() pm.Message { return new(proto.SigningRecord) }, func(k []byte, _ pm.Message) error {
+			if len(k) < pkLen+8 {
+				return fmt.Errorf("invalid key length %d", len(k))
+			}
+			if keyNames.Get(k[:pkLen]) == nil {
+				return fmt.Errorf("signing record of the unknown key %x", k[:pkLen])
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("%w: %s: %v", ErrCorruptedEOTSDb, signRecordsBucketName, err)
+		}
+
+		if auditLog := tx.ReadBucket(auditLogBucketName); auditLog != nil {
+			err = forEachProto(auditLog, func() pm.Message { return new(proto.AuditRecord) }, func(k []byte, m pm.Message) error {
+				if len(k) != 8 {
+					return fmt.Errorf("invalid key length %d", len(k))
+				}
+				if r := m.(*proto.AuditRecord); !bytes.Equal(getAuditRecordKey(r.Seq), k) {
+					return fmt.Errorf("record %d stored under the key %x", r.Seq, k)
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("%w: %s: %v", ErrCorruptedEOTSDb, auditLogBucketName, err)
+			}
+		}
+
+		if authTokens := tx.ReadBucket(authTokensBucketName); authTokens != nil {
+			err = forEachProto(authTokens, func() pm.Message { return new(proto.AuthToken) }, func(k []byte, m pm.Message) error {
+				if m.(*proto.AuthToken).Id != string(k) {
+					return fmt.Errorf("token %s stored under the key %s", m.(*proto.AuthToken).Id, k)
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("%w: %s: %v", ErrCorruptedEOTSDb, authTokensBucketName, err)
+			}
+		}
+
+		return nil
+	}, func() {})
+}
+
+// forEachProto unmarshals each value of the bucket into a new message and
+// passes it to check along with its key
+func forEachProto(bucket walletdb.ReadBucket, newMsg func() pm.Message, check func(k []byte, m pm.Message) error) error {
+	return bucket.ForEach(func(k, v []byte) error {
+		m := newMsg()
+		if err := pm.Unmarshal(v, m); err != nil {
+			return fmt.Errorf("invalid value of the key %x: %w", k, err)
+		}
+		return check(k, m)
+	})
+}
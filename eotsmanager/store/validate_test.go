@@ -0,0 +1,46 @@
+package store_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/config"
+	"github.com/babylonchain/finality-provider/eotsmanager/store"
+	"github.com/babylonchain/finality-provider/testutil"
+)
+
+// FuzzValidateDB tests that a database written by the EOTS store is valid
+// and that malformed entries are detected
+func FuzzValidateDB(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		cfg := config.DefaultDBConfigWithHomePath(t.TempDir())
+		dbBackend, err := cfg.GetDbBackend()
+		require.NoError(t, err)
+		defer dbBackend.Close()
+
+		// the buckets are not created yet
+		require.ErrorIs(t, store.ValidateDB(dbBackend), store.ErrCorruptedEOTSDb)
+
+		vs, err := store.NewEOTSStore(dbBackend)
+		require.NoError(t, err)
+		_, btcPk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		err = vs.AddEOTSKeyName(btcPk, testutil.GenRandomHexStr(r, 10))
+		require.NoError(t, err)
+		require.NoError(t, store.ValidateDB(dbBackend))
+
+		// store a key name under a key which is not a public key
+		err = kvdb.Batch(dbBackend, func(tx kvdb.RwTx) error {
+			return tx.ReadWriteBucket([]byte("fpKeyNames")).Put(testutil.GenRandomByteArray(r, 5), []byte("name"))
+		})
+		require.NoError(t, err)
+		require.ErrorIs(t, store.ValidateDB(dbBackend), store.ErrCorruptedEOTSDb)
+	})
+}
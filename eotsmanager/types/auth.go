@@ -0,0 +1,9 @@
+package types
+
+const (
+	// AuthMetadataKey is the gRPC metadata key carrying the bearer token
+	// of the caller
+	AuthMetadataKey = "authorization"
+	// AuthScheme is the scheme prefixed to the bearer token in the metadata
+	AuthScheme = "Bearer"
+)
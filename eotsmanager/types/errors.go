@@ -4,4 +4,6 @@ import "errors"
 
 var (
 	ErrFinalityProviderAlreadyExisted = errors.New("the finality provider has already existed")
+	ErrDoubleSign                     = errors.New("the EOTS key has already signed a different message at the same height")
+	ErrSessionNotFound                = errors.New("the unlock session does not exist or has expired")
 )
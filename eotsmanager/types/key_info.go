@@ -0,0 +1,16 @@
+package types
+
+import "time"
+
+// KeyInfo is the public information of an EOTS key
+type KeyInfo struct {
+	Name string
+	// PubKey is the BIP-340 public key
+	PubKey []byte
+	// CreatedAt is zero if the key was created before the creation
+	// time was recorded
+	CreatedAt time.Time
+	// ChainIDs are the ids of the chains for which randomness has
+	// been generated
+	ChainIDs [][]byte
+}
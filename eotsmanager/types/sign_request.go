@@ -0,0 +1,7 @@
+package types
+
+// EOTSSignRequest is a message to be signed by EOTS at the given height
+type EOTSSignRequest struct {
+	Height uint64
+	Msg    []byte
+}
@@ -76,7 +76,7 @@ func CommandCreateFP() *cobra.Command {
 	f.String(keyNameFlag, "", "The unique name of the finality provider key")
 	f.String(sdkflags.FlagHome, fpcfg.DefaultFpdDir, "The application home directory")
 	f.String(chainIdFlag, "", "The identifier of the consumer chain")
-	f.String(passphraseFlag, "", "The pass phrase used to encrypt the keys")
+	addPassphraseFlags(f, "The pass phrase used to encrypt the keys")
 	f.String(hdPathFlag, "", "The hd path used to derive the private key")
 	f.String(commissionRateFlag, "0.05", "The commission rate for the finality provider, e.g., 0.05")
 	f.String(monikerFlag, "", "A human-readable name for the finality provider")
@@ -125,9 +125,9 @@ func runCommandCreateFP(ctx client.Context, cmd *cobra.Command, _ []string) erro
 		return fmt.Errorf("failed to read flag %s: %w", chainIdFlag, err)
 	}
 
-	passphrase, err := flags.GetString(passphraseFlag)
+	passphrase, err := getPassphrase(flags)
 	if err != nil {
-		return fmt.Errorf("failed to read flag %s: %w", passphraseFlag, err)
+		return fmt.Errorf("failed to read the passphrase: %w", err)
 	}
 
 	hdPath, err := flags.GetString(hdPathFlag)
@@ -266,7 +266,7 @@ func CommandRegisterFP() *cobra.Command {
 	}
 	f := cmd.Flags()
 	f.String(fpdDaemonAddressFlag, defaultFpdDaemonAddress, "The RPC server address of fpd")
-	f.String(passphraseFlag, "", "The pass phrase used to encrypt the keys")
+	addPassphraseFlags(f, "The pass phrase used to encrypt the keys")
 	return cmd
 }
 
@@ -288,9 +288,9 @@ func runCommandRegisterFP(cmd *cobra.Command, args []string) error {
 	}
 	defer cleanUp()
 
-	passphrase, err := flags.GetString(passphraseFlag)
+	passphrase, err := getPassphrase(flags)
 	if err != nil {
-		return fmt.Errorf("failed to read flag %s: %w", passphraseFlag, err)
+		return fmt.Errorf("failed to read the passphrase: %w", err)
 	}
 
 	res, err := client.RegisterFinalityProvider(context.Background(), fpPk, passphrase)
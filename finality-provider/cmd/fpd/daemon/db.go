@@ -0,0 +1,309 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdkflags "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/spf13/cobra"
+
+	"github.com/babylonchain/finality-provider/dbbackup"
+	fpcmd "github.com/babylonchain/finality-provider/finality-provider/cmd"
+	fpcfg "github.com/babylonchain/finality-provider/finality-provider/config"
+	dc "github.com/babylonchain/finality-provider/finality-provider/service/client"
+	"github.com/babylonchain/finality-provider/finality-provider/store"
+	"github.com/babylonchain/finality-provider/sqlitedb"
+)
+
+type dbBackupOutput struct {
+	Path   string `json:"path"`
+	Online bool   `json:"online"`
+}
+
+type dbRestoreOutput struct {
+	Restored          string `json:"restored"`
+	Previous          string `json:"previous,omitempty"`
+	PubRandProofsKept int    `json:"pub_rand_proofs_kept"`
+}
+
+type migrationOutput struct {
+	Version     uint32 `json:"version"`
+	Description string `json:"description"`
+}
+
+type dbConvertOutput struct {
+	Source      string            `json:"source"`
+	Destination string            `json:"destination"`
+	Records     map[string]uint64 `json:"records"`
+}
+
+type dbMigrateOutput struct {
+	FromVersion uint32            `json:"from_version"`
+	ToVersion   uint32            `json:"to_version"`
+	Applied     []migrationOutput `json:"applied"`
+	DryRun      bool              `json:"dry_run"`
+}
+
+// CommandDB returns the db group command to back up, restore, migrate, and convert the database.
+func CommandDB() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "db",
+		Short:                      "Back up, restore, migrate, and convert the database of fpd.",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CommandBackupDB(), CommandRestoreDB(), CommandMigrateDB(), CommandConvertDB())
+
+	return cmd
+}
+
+// CommandBackupDB returns the db backup command which takes the snapshot
+// through the daemon if it is running, or from the database file otherwise.
+func CommandBackupDB() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "backup",
+		Short: "Write a snapshot of the database to the backup directory.",
+		Long: `The snapshot is taken by the daemon through its RPC server if it is running,
+or directly from the database file otherwise. The oldest snapshots are removed
+according to the configured number of snapshots to keep.`,
+		Example: fmt.Sprintf(`fpd db backup --daemon-address %s`, defaultFpdDaemonAddress),
+		Args:    cobra.NoArgs,
+		RunE:    fpcmd.RunEWithClientCtx(runCommandBackupDB),
+	}
+
+	f := cmd.Flags()
+	f.String(fpdDaemonAddressFlag, defaultFpdDaemonAddress, "The RPC server address of fpd")
+	f.String(sdkflags.FlagHome, fpcfg.DefaultFpdDir, "The application home directory")
+
+	return cmd
+}
+
+func runCommandBackupDB(ctx client.Context, cmd *cobra.Command, _ []string) error {
+	daemonAddress, err := cmd.Flags().GetString(fpdDaemonAddressFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", fpdDaemonAddressFlag, err)
+	}
+
+	cfg, err := fpcfg.LoadConfig(ctx.HomeDir)
+	if err != nil {
+		return fmt.Errorf("failed to load config from %s: %w", fpcfg.ConfigFile(ctx.HomeDir), err)
+	}
+	if cfg.DatabaseConfig.IsSqlite() {
+		return fmt.Errorf("the sqlite backend should be backed up with the SQLite tools, e.g., sqlite3 %s \".backup <path>\"",
+			filepath.Join(cfg.DatabaseConfig.DBPath, cfg.DatabaseConfig.SqliteFileName))
+	}
+
+	db, err := dbbackup.OpenExisting(cfg.DatabaseConfig.DBPath, cfg.DatabaseConfig.DBFileName)
+	if errors.Is(err, dbbackup.ErrDBInUse) {
+		// the database file is locked by the running daemon
+		client, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress)
+		if err != nil {
+			return err
+		}
+		defer cleanUp()
+
+		path, err := client.BackupDB(context.Background())
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(dbBackupOutput{Path: path, Online: true})
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open the database: %w", err)
+	}
+	defer db.Close()
+
+	path, err := dbbackup.Snapshot(db, cfg.Backup.Dir, fpcfg.DBBackupPrefix)
+	if err != nil {
+		return err
+	}
+	if err := dbbackup.Prune(cfg.Backup.Dir, fpcfg.DBBackupPrefix, cfg.Backup.Keep); err != nil {
+		return err
+	}
+
+	printRespJSON(dbBackupOutput{Path: path})
+
+	return nil
+}
+
+// CommandRestoreDB returns the db restore command which replaces the database
+// with a snapshot once it is validated.
+func CommandRestoreDB() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "restore [snapshot-path]",
+		Short: "Replace the database with a snapshot once it is validated.",
+		Long: `The daemon should be stopped. The public randomness proofs of the current
+database are carried over to the restored one, as they are needed to submit finality
+signatures with the randomness committed since the snapshot was taken. The replaced
+database is kept next to the restored one with a .pre-restore suffix.`,
+		Example: `fpd db restore ~/.fpd/backups/finality-provider-20240102T150405.000000000Z.db`,
+		Args:    cobra.ExactArgs(1),
+		RunE:    fpcmd.RunEWithClientCtx(runCommandRestoreDB),
+	}
+
+	cmd.Flags().String(sdkflags.FlagHome, fpcfg.DefaultFpdDir, "The application home directory")
+	cmd.Flags().Bool(forceFlag, false, "Restore the snapshot without the public randomness proofs of the current database, e.g., if it is corrupted")
+
+	return cmd
+}
+
+func runCommandRestoreDB(ctx client.Context, cmd *cobra.Command, args []string) error {
+	force, err := cmd.Flags().GetBool(forceFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", forceFlag, err)
+	}
+
+	cfg, err := fpcfg.LoadConfig(ctx.HomeDir)
+	if err != nil {
+		return fmt.Errorf("failed to load config from %s: %w", fpcfg.ConfigFile(ctx.HomeDir), err)
+	}
+	if cfg.DatabaseConfig.IsSqlite() {
+		return fmt.Errorf("the sqlite backend should be restored with the SQLite tools while the daemon is stopped")
+	}
+
+	// the proofs of the randomness committed since the snapshot was taken
+	// are still needed to submit finality signatures with it
+	var (
+		kept  int
+		merge dbbackup.Merger
+	)
+	if !force {
+		merge = func(live, restored kvdb.Backend) error {
+			var err error
+			kept, err = store.CopyPubRandProofs(live, restored)
+			if err != nil {
+				return fmt.Errorf("%w (use --%s to restore the snapshot without them)", err, forceFlag)
+			}
+			return nil
+		}
+	}
+
+	previous, err := dbbackup.Restore(args[0], cfg.DatabaseConfig.DBPath, cfg.DatabaseConfig.DBFileName, store.ValidateDB, merge)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(dbRestoreOutput{Restored: args[0], Previous: previous, PubRandProofsKept: kept})
+
+	return nil
+}
+
+// CommandMigrateDB returns the db migrate command which upgrades the database
+// to the schema of this version of fpd.
+func CommandMigrateDB() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "migrate",
+		Short: "Upgrade the database to the schema of this version of fpd.",
+		Long: `The daemon applies the pending migrations itself when it starts, so this
+command is mostly useful with --dry-run to check them beforehand. The daemon
+should be stopped.`,
+		Example: `fpd db migrate --dry-run --home ~/.fpd`,
+		Args:    cobra.NoArgs,
+		RunE:    fpcmd.RunEWithClientCtx(runCommandMigrateDB),
+	}
+
+	f := cmd.Flags()
+	f.Bool(dryRunFlag, false, "Apply the migrations in a transaction which is rolled back")
+	f.String(sdkflags.FlagHome, fpcfg.DefaultFpdDir, "The application home directory")
+
+	return cmd
+}
+
+func runCommandMigrateDB(ctx client.Context, cmd *cobra.Command, _ []string) error {
+	dryRun, err := cmd.Flags().GetBool(dryRunFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", dryRunFlag, err)
+	}
+
+	cfg, err := fpcfg.LoadConfig(ctx.HomeDir)
+	if err != nil {
+		return fmt.Errorf("failed to load config from %s: %w", fpcfg.ConfigFile(ctx.HomeDir), err)
+	}
+
+	db, err := openExistingDB(cfg.DatabaseConfig)
+	if err != nil {
+		return fmt.Errorf("failed to open the database: %w", err)
+	}
+	defer db.Close()
+
+	res, err := store.MigrateDB(db, dryRun)
+	if err != nil {
+		return err
+	}
+
+	out := dbMigrateOutput{
+		FromVersion: res.FromVersion,
+		ToVersion:   res.ToVersion,
+		Applied:     make([]migrationOutput, 0, len(res.Applied)),
+		DryRun:      res.DryRun,
+	}
+	for _, m := range res.Applied {
+		out.Applied = append(out.Applied, migrationOutput{Version: m.Version, Description: m.Description})
+	}
+	printRespJSON(out)
+
+	return nil
+}
+
+// CommandConvertDB returns the db convert command which copies the bolt
+// database into a new SQLite database.
+func CommandConvertDB() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "convert",
+		Short: "Copy the bolt database into a new SQLite database.",
+		Long: `The records of each bucket are counted in both databases once copied, and
+the conversion fails if they differ. The daemon should be stopped. Set backend to
+sqlite in the [dbconfig] section of fpd.conf afterwards to use the SQLite
+database, which requires fpd to be built with the kvdb_sqlite tag.`,
+		Example: `fpd db convert --home ~/.fpd`,
+		Args:    cobra.NoArgs,
+		RunE:    fpcmd.RunEWithClientCtx(runCommandConvertDB),
+	}
+
+	cmd.Flags().String(sdkflags.FlagHome, fpcfg.DefaultFpdDir, "The application home directory")
+
+	return cmd
+}
+
+func runCommandConvertDB(ctx client.Context, _ *cobra.Command, _ []string) error {
+	cfg, err := fpcfg.LoadConfig(ctx.HomeDir)
+	if err != nil {
+		return fmt.Errorf("failed to load config from %s: %w", fpcfg.ConfigFile(ctx.HomeDir), err)
+	}
+
+	dbCfg := cfg.DatabaseConfig
+	records, err := sqlitedb.ConvertBolt(dbCfg.DBPath, dbCfg.DBFileName, dbCfg.DBConfigToSqliteConfig())
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(dbConvertOutput{
+		Source:      filepath.Join(dbCfg.DBPath, dbCfg.DBFileName),
+		Destination: filepath.Join(dbCfg.DBPath, dbCfg.SqliteFileName),
+		Records:     records,
+	})
+
+	return nil
+}
+
+// openExistingDB opens the existing database of the configured backend,
+// failing fast if a bolt database is in use by the daemon
+func openExistingDB(dbCfg *fpcfg.DBConfig) (kvdb.Backend, error) {
+	if !dbCfg.IsSqlite() {
+		return dbbackup.OpenExisting(dbCfg.DBPath, dbCfg.DBFileName)
+	}
+
+	if _, err := os.Stat(filepath.Join(dbCfg.DBPath, dbCfg.SqliteFileName)); err != nil {
+		return nil, err
+	}
+
+	return dbCfg.GetDbBackend()
+}
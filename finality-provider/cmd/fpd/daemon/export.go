@@ -47,7 +47,7 @@ func CommandExportFP() *cobra.Command {
 	)
 	f.String(keyNameFlag, "", "The unique name of the finality provider key")
 	f.String(sdkflags.FlagHome, fpcfg.DefaultFpdDir, "The application home directory")
-	f.String(passphraseFlag, "", "The pass phrase used to encrypt the keys")
+	addPassphraseFlags(f, "The pass phrase used to encrypt the keys")
 	f.String(hdPathFlag, "", "The hd path used to derive the private key")
 
 	return cmd
@@ -118,9 +118,9 @@ func runCommandExportFP(ctx client.Context, cmd *cobra.Command, args []string) e
 		return fmt.Errorf("failed to marshal finality provider %+v: %w", fp, err)
 	}
 
-	passphrase, err := flags.GetString(passphraseFlag)
+	passphrase, err := getPassphrase(flags)
 	if err != nil {
-		return fmt.Errorf("failed to read flag %s: %w", passphraseFlag, err)
+		return fmt.Errorf("failed to read the passphrase: %w", err)
 	}
 
 	hdPath, err := flags.GetString(hdPathFlag)
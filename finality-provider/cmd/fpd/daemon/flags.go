@@ -8,9 +8,13 @@ const (
 	keyNameFlag          = "key-name"
 	appHashFlag          = "app-hash"
 	passphraseFlag       = "passphrase"
+	passphraseFileFlag   = "passphrase-file"
+	passphrasePromptFlag = "passphrase-prompt"
+	secretsFileFlag      = "secrets-file"
 	hdPathFlag           = "hd-path"
 	chainIdFlag          = "chain-id"
 	signedFlag           = "signed"
+	dryRunFlag           = "dry-run"
 
 	// flags for description
 	monikerFlag         = "moniker"
@@ -0,0 +1,43 @@
+package daemon
+
+import (
+	"github.com/spf13/pflag"
+
+	"github.com/babylonchain/finality-provider/util"
+)
+
+// passphraseEnvVar holds the passphrase of the keys if it is given by none
+// of the passphrase flags
+const passphraseEnvVar = "FPD_PASSPHRASE"
+
+// addPassphraseFlags adds the flags of the sources of the passphrase
+func addPassphraseFlags(f *pflag.FlagSet, usage string) {
+	f.String(passphraseFlag, "", usage)
+	f.String(passphraseFileFlag, "", "The file holding the passphrase on its first line, instead of --"+passphraseFlag)
+	f.Bool(passphrasePromptFlag, false, "Prompt for the passphrase on the terminal, instead of --"+passphraseFlag)
+}
+
+// getPassphrase reads the passphrase from --passphrase, --passphrase-file,
+// --passphrase-prompt, or the FPD_PASSPHRASE environment variable
+func getPassphrase(flags *pflag.FlagSet) (string, error) {
+	passphrase, err := flags.GetString(passphraseFlag)
+	if err != nil {
+		return "", err
+	}
+	passphraseFile, err := flags.GetString(passphraseFileFlag)
+	if err != nil {
+		return "", err
+	}
+	prompt, err := flags.GetBool(passphrasePromptFlag)
+	if err != nil {
+		return "", err
+	}
+
+	return util.ReadPassphrase(util.PassphraseSource{
+		Value:  passphrase,
+		IsSet:  flags.Changed(passphraseFlag),
+		File:   passphraseFile,
+		EnvVar: passphraseEnvVar,
+		Prompt: prompt,
+	})
+}
@@ -30,7 +30,8 @@ func CommandStart() *cobra.Command {
 		RunE:    fpcmd.RunEWithClientCtx(runStartCmd),
 	}
 	cmd.Flags().String(fpPkFlag, "", "The public key of the finality-provider to start")
-	cmd.Flags().String(passphraseFlag, "", "The pass phrase used to decrypt the private key")
+	addPassphraseFlags(cmd.Flags(), "The pass phrase used to decrypt the private key")
+	cmd.Flags().String(secretsFileFlag, "", "The JSON file mapping the hex public keys of finality providers to their passphrases")
 	cmd.Flags().String(rpcListenerFlag, "", "The address that the RPC server listens to")
 	return cmd
 }
@@ -53,9 +54,21 @@ func runStartCmd(ctx client.Context, cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to read flag %s: %w", rpcListenerFlag, err)
 	}
 
-	passphrase, err := flags.GetString(passphraseFlag)
+	passphrase, err := getPassphrase(flags)
 	if err != nil {
-		return fmt.Errorf("failed to read flag %s: %w", passphraseFlag, err)
+		return fmt.Errorf("failed to read the passphrase: %w", err)
+	}
+
+	secretsFile, err := flags.GetString(secretsFileFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", secretsFileFlag, err)
+	}
+	var passphrases map[string]string
+	if secretsFile != "" {
+		passphrases, err = util.ReadSecretsFile(secretsFile)
+		if err != nil {
+			return fmt.Errorf("failed to read the secrets file: %w", err)
+		}
 	}
 
 	cfg, err := fpcfg.LoadConfig(homePath)
@@ -86,6 +99,8 @@ func runStartCmd(ctx client.Context, cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to load app: %w", err)
 	}
 
+	fpApp.SetFinalityProviderPassphrases(passphrases)
+
 	if err := startApp(fpApp, fpStr, passphrase); err != nil {
 		return fmt.Errorf("failed to start app: %w", err)
 	}
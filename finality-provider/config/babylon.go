@@ -1,26 +1,38 @@
 package config
 
 import (
+	"slices"
 	"time"
 
 	bbncfg "github.com/babylonchain/babylon/client/config"
 )
 
+const (
+	defaultHealthCheckInterval  = 10 * time.Second
+	defaultBlockConfirmAttempts = 5
+	defaultBlockConfirmDelay    = 400 * time.Millisecond
+)
+
 type BBNConfig struct {
-	Key            string        `long:"key" description:"name of the key to sign transactions with"`
-	ChainID        string        `long:"chain-id" description:"chain id of the chain to connect to"`
-	RPCAddr        string        `long:"rpc-address" description:"address of the rpc server to connect to"`
-	GRPCAddr       string        `long:"grpc-address" description:"address of the grpc server to connect to"`
-	AccountPrefix  string        `long:"acc-prefix" description:"account prefix to use for addresses"`
-	KeyringBackend string        `long:"keyring-type" description:"type of keyring to use"`
-	GasAdjustment  float64       `long:"gas-adjustment" description:"adjustment factor when using gas estimation"`
-	GasPrices      string        `long:"gas-prices" description:"comma separated minimum gas prices to accept for transactions"`
-	KeyDirectory   string        `long:"key-dir" description:"directory to store keys in"`
-	Debug          bool          `long:"debug" description:"flag to print debug output"`
-	Timeout        time.Duration `long:"timeout" description:"client timeout when doing queries"`
-	BlockTimeout   time.Duration `long:"block-timeout" description:"block timeout when waiting for block events"`
-	OutputFormat   string        `long:"output-format" description:"default output when printint responses"`
-	SignModeStr    string        `long:"sign-mode" description:"sign mode to use"`
+	Key                  string        `long:"key" description:"name of the key to sign transactions with"`
+	ChainID              string        `long:"chain-id" description:"chain id of the chain to connect to"`
+	RPCAddr              string        `long:"rpc-address" description:"address of the rpc server to connect to"`
+	ExtraRPCAddrs        []string      `long:"extra-rpc-address" description:"address of another rpc server of the same chain, which can be repeated; queries and transactions are routed to the healthiest rpc server"`
+	BlockQuorum          uint32        `long:"block-quorum" description:"number of rpc servers which must return the same block hash before the block is voted for; 0 or 1 trusts the rpc server queried"`
+	HealthCheckInterval  time.Duration `long:"health-check-interval" description:"interval of querying the status of every rpc server to learn its tip height and whether it responds"`
+	BlockConfirmAttempts uint32        `long:"block-confirm-attempts" description:"number of attempts to have the quorum confirm a block received from the new block subscription, as the other rpc servers may not have it yet"`
+	BlockConfirmDelay    time.Duration `long:"block-confirm-delay" description:"delay between the attempts to have the quorum confirm a block received from the new block subscription"`
+	GRPCAddr             string        `long:"grpc-address" description:"address of the grpc server to connect to"`
+	AccountPrefix        string        `long:"acc-prefix" description:"account prefix to use for addresses"`
+	KeyringBackend       string        `long:"keyring-type" description:"type of keyring to use"`
+	GasAdjustment        float64       `long:"gas-adjustment" description:"adjustment factor when using gas estimation"`
+	GasPrices            string        `long:"gas-prices" description:"comma separated minimum gas prices to accept for transactions"`
+	KeyDirectory         string        `long:"key-dir" description:"directory to store keys in"`
+	Debug                bool          `long:"debug" description:"flag to print debug output"`
+	Timeout              time.Duration `long:"timeout" description:"client timeout when doing queries"`
+	BlockTimeout         time.Duration `long:"block-timeout" description:"block timeout when waiting for block events"`
+	OutputFormat         string        `long:"output-format" description:"default output when printint responses"`
+	SignModeStr          string        `long:"sign-mode" description:"sign mode to use"`
 }
 
 func DefaultBBNConfig() BBNConfig {
@@ -39,10 +51,26 @@ func DefaultBBNConfig() BBNConfig {
 		Timeout:        dc.Timeout,
 		// Setting this to relatively low value, out current babylon client (lens) will
 		// block for this amout of time to wait for transaction inclusion in block
-		BlockTimeout: 1 * time.Minute,
-		OutputFormat: dc.OutputFormat,
-		SignModeStr:  dc.SignModeStr,
+		BlockTimeout:         1 * time.Minute,
+		OutputFormat:         dc.OutputFormat,
+		SignModeStr:          dc.SignModeStr,
+		HealthCheckInterval:  defaultHealthCheckInterval,
+		BlockConfirmAttempts: defaultBlockConfirmAttempts,
+		BlockConfirmDelay:    defaultBlockConfirmDelay,
+	}
+}
+
+// RPCAddrs returns the addresses of all the rpc servers to connect to, the
+// one of rpc-address first, without duplicates
+func (bc *BBNConfig) RPCAddrs() []string {
+	addrs := []string{bc.RPCAddr}
+	for _, addr := range bc.ExtraRPCAddrs {
+		if addr != "" && !slices.Contains(addrs, addr) {
+			addrs = append(addrs, addr)
+		}
 	}
+
+	return addrs
 }
 
 func BBNConfigToBabylonConfig(bc *BBNConfig) bbncfg.BabylonConfig {
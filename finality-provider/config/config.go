@@ -12,6 +12,7 @@ import (
 	"github.com/jessevdk/go-flags"
 	"go.uber.org/zap/zapcore"
 
+	"github.com/babylonchain/finality-provider/dbbackup"
 	eotscfg "github.com/babylonchain/finality-provider/eotsmanager/config"
 	"github.com/babylonchain/finality-provider/metrics"
 	"github.com/babylonchain/finality-provider/util"
@@ -37,6 +38,7 @@ const (
 	defaultMaxSubmissionRetries    = 20
 	defaultBitcoinNetwork          = "signet"
 	defaultDataDirname             = "data"
+	defaultBackupDirname           = "backups"
 	defaultMaxNumFinalityProviders = 3
 )
 
@@ -67,7 +69,9 @@ type Config struct {
 	FastSyncInterval         time.Duration `long:"fastsyncinterval" description:"The interval between each try of fast sync, which is disabled if the value is 0"`
 	FastSyncLimit            uint64        `long:"fastsynclimit" description:"The maximum number of blocks to catch up for each fast sync"`
 	FastSyncGap              uint64        `long:"fastsyncgap" description:"The block gap that will trigger the fast sync"`
-	EOTSManagerAddress       string        `long:"eotsmanageraddress" description:"The address of the remote EOTS manager; Empty if the EOTS manager is running locally"`
+	EOTSManagerAddress       string        `long:"eotsmanageraddress" description:"The address of the remote EOTS manager, or unix:///path/to.sock for a Unix domain socket; Empty if the EOTS manager is running locally"`
+	EOTSManagerAuthToken     string        `long:"eotsmanagerauthtoken" description:"The bearer token to authenticate to the EOTS manager; Empty if the EOTS manager does not enforce authentication"`
+	EOTSManagerSessionTTL    time.Duration `long:"eotsmanagersessionttl" description:"The time for which each EOTS key is unlocked in the EOTS manager so that the passphrase is not sent on every request; The passphrase is sent on every request if the value is 0"`
 	MaxNumFinalityProviders  uint32        `long:"maxnumfinalityproviders" description:"The maximum number of finality-provider instances running concurrently within the daemon"`
 
 	BitcoinNetwork string `long:"bitcoinnetwork" description:"Bitcoin network to run on" choise:"mainnet" choice:"regtest" choice:"testnet" choice:"simnet" choice:"signet"`
@@ -80,9 +84,13 @@ type Config struct {
 
 	BabylonConfig *BBNConfig `group:"babylon" namespace:"babylon"`
 
+	EOTSManagerTLS *EOTSManagerTLSConfig `group:"eotsmanagertls" namespace:"eotsmanagertls"`
+
 	RpcListener string `long:"rpclistener" description:"the listener for RPC connections, e.g., 127.0.0.1:1234"`
 
 	Metrics *metrics.Config `group:"metrics" namespace:"metrics"`
+
+	Backup *dbbackup.Config `group:"dbbackup" namespace:"dbbackup"`
 }
 
 func DefaultConfigWithHome(homePath string) Config {
@@ -90,6 +98,7 @@ func DefaultConfigWithHome(homePath string) Config {
 	bbnCfg.Key = defaultFinalityProviderKeyName
 	bbnCfg.KeyDirectory = homePath
 	pollerCfg := DefaultChainPollerConfig()
+	eotsTLSCfg := DefaultEOTSManagerTLSConfig()
 	cfg := Config{
 		ChainName:                defaultChainName,
 		LogLevel:                 defaultLogLevel.String(),
@@ -109,9 +118,11 @@ func DefaultConfigWithHome(homePath string) Config {
 		BitcoinNetwork:           defaultBitcoinNetwork,
 		BTCNetParams:             defaultBTCNetParams,
 		EOTSManagerAddress:       defaultEOTSManagerAddress,
+		EOTSManagerTLS:           &eotsTLSCfg,
 		RpcListener:              DefaultRpcListener,
 		MaxNumFinalityProviders:  defaultMaxNumFinalityProviders,
 		Metrics:                  metrics.DefaultFpConfig(),
+		Backup:                   dbbackup.DefaultConfig(BackupDir(homePath)),
 	}
 
 	if err := cfg.Validate(); err != nil {
@@ -141,6 +152,10 @@ func DataDir(homePath string) string {
 	return filepath.Join(homePath, defaultDataDirname)
 }
 
+func BackupDir(homePath string) string {
+	return filepath.Join(homePath, defaultBackupDirname)
+}
+
 // LoadConfig initializes and parses the config using a config file and command
 // line options.
 //
@@ -181,6 +196,22 @@ func (cfg *Config) Validate() error {
 	if cfg.EOTSManagerAddress == "" {
 		return fmt.Errorf("EOTS manager address not specified")
 	}
+
+	if err := util.ValidateUnixSocketAddr(cfg.EOTSManagerAddress); err != nil {
+		return fmt.Errorf("invalid EOTS manager address %s: %w", cfg.EOTSManagerAddress, err)
+	}
+
+	if cfg.EOTSManagerSessionTTL < 0 {
+		return fmt.Errorf("the EOTS manager session ttl should not be negative")
+	}
+
+	if cfg.EOTSManagerTLS == nil {
+		return fmt.Errorf("empty EOTS manager TLS config")
+	}
+
+	if err := cfg.EOTSManagerTLS.Validate(); err != nil {
+		return fmt.Errorf("invalid EOTS manager TLS config: %w", err)
+	}
 	// Multiple networks can't be selected simultaneously.  Count number of
 	// network flags passed; assign active network params
 	// while we're at it.
@@ -204,6 +235,14 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("invalid RPC listener address %s, %w", cfg.RpcListener, err)
 	}
 
+	if cfg.PollerConfig == nil {
+		return fmt.Errorf("empty chain poller config")
+	}
+
+	if err := cfg.PollerConfig.Validate(); err != nil {
+		return fmt.Errorf("invalid chain poller config: %w", err)
+	}
+
 	if cfg.Metrics == nil {
 		return fmt.Errorf("empty metrics config")
 	}
@@ -212,6 +251,28 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("invalid metrics config")
 	}
 
+	if cfg.Backup == nil {
+		return fmt.Errorf("empty backup config")
+	}
+
+	if err := cfg.Backup.Validate(); err != nil {
+		return fmt.Errorf("invalid backup config: %w", err)
+	}
+
+	if cfg.DatabaseConfig == nil {
+		return fmt.Errorf("empty database config")
+	}
+
+	if err := cfg.DatabaseConfig.Validate(); err != nil {
+		return fmt.Errorf("invalid database config: %w", err)
+	}
+
+	// the snapshots are copies of bolt files, while SQLite databases are
+	// backed up with the SQLite tools
+	if cfg.DatabaseConfig.IsSqlite() && cfg.Backup.Interval > 0 {
+		return fmt.Errorf("periodic backups are not supported by the sqlite backend")
+	}
+
 	// All good, return the sanitized result.
 	return nil
 }
@@ -0,0 +1,50 @@
+package config
+
+import (
+	"crypto/tls"
+	"fmt"
+
+	eotscfg "github.com/babylonchain/finality-provider/eotsmanager/config"
+	"github.com/babylonchain/finality-provider/util"
+)
+
+// EOTSManagerTLSConfig defines the TLS settings used to connect to the EOTS manager
+type EOTSManagerTLSConfig struct {
+	Disable        bool   `long:"disable" description:"Connect to the EOTS manager over plaintext TCP as before TLS became the default, which is required if the EOTS manager disables TLS and should only be used if it is running on the same host"`
+	CertPath       string `long:"certpath" description:"The path to the TLS certificate of the EOTS manager, which is pinned so that no other certificate is accepted"`
+	ClientCertPath string `long:"clientcertpath" description:"The path to the client TLS certificate presented to the EOTS manager for mutual TLS"`
+	ClientKeyPath  string `long:"clientkeypath" description:"The path to the client TLS key presented to the EOTS manager for mutual TLS"`
+}
+
+func DefaultEOTSManagerTLSConfig() EOTSManagerTLSConfig {
+	return EOTSManagerTLSConfig{
+		Disable:  false,
+		CertPath: eotscfg.DefaultTLSCertPath,
+	}
+}
+
+func (cfg *EOTSManagerTLSConfig) Validate() error {
+	if cfg.Disable {
+		return nil
+	}
+
+	if cfg.CertPath == "" {
+		return fmt.Errorf("the TLS certificate path of the EOTS manager should not be empty unless TLS is disabled")
+	}
+
+	if (cfg.ClientCertPath == "") != (cfg.ClientKeyPath == "") {
+		return fmt.Errorf("the client TLS certificate and key should be both set or both empty")
+	}
+
+	return nil
+}
+
+// ToTLSConfig returns the TLS config to connect to the EOTS manager,
+// which is nil if TLS is disabled
+func (cfg *EOTSManagerTLSConfig) ToTLSConfig() (*tls.Config, error) {
+	if cfg.Disable {
+		return nil, nil
+	}
+
+	return util.NewPinnedClientTLSConfig(cfg.CertPath, cfg.ClientCertPath, cfg.ClientKeyPath)
+}
@@ -1,11 +1,15 @@
 package config
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 var (
 	defaultBufferSize        = uint32(1000)
 	defaultPollingInterval   = 20 * time.Second
 	defaultStaticStartHeight = uint64(1)
+	defaultBlockHashWindow   = uint64(1000)
 )
 
 type ChainPollerConfig struct {
@@ -13,6 +17,8 @@ type ChainPollerConfig struct {
 	PollInterval                   time.Duration `long:"pollinterval" description:"The interval between each polling of Babylon blocks"`
 	StaticChainScanningStartHeight uint64        `long:"staticchainscanningstartheight" description:"The static height from which we start polling the chain"`
 	AutoChainScanningMode          bool          `long:"autochainscanningmode" description:"Automatically discover the height from which to start polling the chain"`
+	SubscriptionMode               bool          `long:"subscriptionmode" description:"Receive the new blocks from the CometBFT NewBlock events of the chain instead of polling them, falling back to polling if the subscription drops"`
+	BlockHashWindow                uint64        `long:"blockhashwindow" description:"The number of recent heights whose block hashes are remembered to detect a block changing at a height"`
 }
 
 func DefaultChainPollerConfig() ChainPollerConfig {
@@ -21,5 +27,14 @@ func DefaultChainPollerConfig() ChainPollerConfig {
 		PollInterval:                   defaultPollingInterval,
 		StaticChainScanningStartHeight: defaultStaticStartHeight,
 		AutoChainScanningMode:          true,
+		BlockHashWindow:                defaultBlockHashWindow,
 	}
 }
+
+func (cfg *ChainPollerConfig) Validate() error {
+	if cfg.BlockHashWindow == 0 {
+		return fmt.Errorf("the block hash window should be positive")
+	}
+
+	return nil
+}
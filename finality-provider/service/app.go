@@ -65,12 +65,24 @@ func NewFinalityProviderAppFromConfig(
 
 	// if the EOTSManagerAddress is empty, run a local EOTS manager;
 	// otherwise connect a remote one with a gRPC client
-	em, err := client.NewEOTSManagerGRpcClient(cfg.EOTSManagerAddress)
+	eotsTLSCfg, err := cfg.EOTSManagerTLS.ToTLSConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the TLS config of the EOTS manager: %w", err)
+	}
+	em, err := client.NewEOTSManagerGRpcClient(cfg.EOTSManagerAddress, eotsTLSCfg, cfg.EOTSManagerAuthToken)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create EOTS manager client: %w", err)
 	}
 
 	logger.Info("successfully connected to a remote EOTS manager", zap.String("address", cfg.EOTSManagerAddress))
+	if cfg.EOTSManagerSessionTTL > 0 {
+		em.EnableSessions(cfg.EOTSManagerSessionTTL)
+	}
+
+	if err := checkEOTSManagerHealth(em, logger); err != nil {
+		em.Close()
+		return nil, err
+	}
 
 	return NewFinalityProviderApp(cfg, cc, em, db, logger)
 }
@@ -222,6 +234,13 @@ func (app *FinalityProviderApp) StartHandlingFinalityProvider(fpPk *bbntypes.BIP
 	return app.fpManager.StartFinalityProvider(fpPk, passphrase)
 }
 
+// SetFinalityProviderPassphrases sets the passphrases of the finality providers
+// keyed by the hex string of their BTC public keys, which are used to start the
+// finality providers without a given passphrase, e.g., by StartHandlingAll
+func (app *FinalityProviderApp) SetFinalityProviderPassphrases(passphrases map[string]string) {
+	app.fpManager.SetPassphrases(passphrases)
+}
+
 func (app *FinalityProviderApp) StartHandlingAll() error {
 	return app.fpManager.StartAll()
 }
@@ -640,6 +659,7 @@ func (app *FinalityProviderApp) metricsUpdateLoop() {
 	for {
 		select {
 		case <-updateTicker.C:
+			app.recordChainEndpointsHealth()
 			fps, err := app.fps.GetAllStoredFinalityProviders()
 			if err != nil {
 				app.logger.Error("failed to get finality-providers from the store", zap.Error(err))
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/babylonchain/finality-provider/finality-provider/store"
+	"github.com/babylonchain/finality-provider/types"
+)
+
+// the sources of the blocks seen by a finality provider
+const (
+	blockSourcePoll          = "poll"
+	blockSourceSubscription  = "subscription"
+	blockSourceBackfill      = "backfill"
+	blockSourceTip           = "tip"
+	blockSourceFastSync      = "fast sync"
+	blockSourceFinalityCheck = "finality check"
+	blockSourceVote          = "vote"
+)
+
+type seenBlock struct {
+	hash   []byte
+	source string
+	voted  bool
+	// conflicts are the other hashes seen at the height
+	conflicts [][]byte
+}
+
+// blockHashTracker remembers the hashes of the recent blocks seen by a
+// finality provider, from the poller, the queries, and its own votes, to
+// detect a height whose block changes, as when the consumer chain forks or a
+// node serves another chain. A nil tracker tracks nothing
+type blockHashTracker struct {
+	mu        sync.Mutex
+	window    uint64
+	maxHeight uint64
+	blocks    map[uint64]*seenBlock
+
+	// onConflict is called once for every new hash seen at a height
+	onConflict func(*store.BlockHashConflict)
+}
+
+func newBlockHashTracker(window uint64, onConflict func(*store.BlockHashConflict)) *blockHashTracker {
+	return &blockHashTracker{
+		window:     window,
+		blocks:     make(map[uint64]*seenBlock),
+		onConflict: onConflict,
+	}
+}
+
+// observe records the hash of the block seen from the given source. It
+// returns the conflict if another hash was seen at the height before
+func (t *blockHashTracker) observe(b *types.BlockInfo, source string) *store.BlockHashConflict {
+	return t.record(b, source, false)
+}
+
+// recordVote records the block the finality provider votes for. It returns
+// the conflict if another hash was seen at the height, in which case the
+// finality provider should not vote
+func (t *blockHashTracker) recordVote(b *types.BlockInfo) *store.BlockHashConflict {
+	return t.record(b, blockSourceVote, true)
+}
+
+func (t *blockHashTracker) record(b *types.BlockInfo, source string, vote bool) *store.BlockHashConflict {
+	if t == nil {
+		return nil
+	}
+
+	conflict, isNew := t.recordLocked(b, source, vote)
+	if isNew && t.onConflict != nil {
+		t.onConflict(conflict)
+	}
+
+	return conflict
+}
+
+// recordLocked returns the conflict at the height of the block if any, and
+// whether it is the first time the hash of the block conflicts
+func (t *blockHashTracker) recordLocked(b *types.BlockInfo, source string, vote bool) (*store.BlockHashConflict, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	seen, ok := t.blocks[b.Height]
+	if !ok {
+		// the block is too old to be tracked
+		if b.Height+t.window <= t.maxHeight {
+			return nil, false
+		}
+
+		t.blocks[b.Height] = &seenBlock{
+			hash:   append([]byte(nil), b.Hash...),
+			source: source,
+			voted:  vote,
+		}
+		if b.Height > t.maxHeight {
+			t.maxHeight = b.Height
+			t.prune()
+		}
+
+		return nil, false
+	}
+
+	if bytes.Equal(seen.hash, b.Hash) {
+		seen.voted = seen.voted || vote
+		if len(seen.conflicts) == 0 {
+			return nil, false
+		}
+
+		return seen.conflict(b.Height, seen.conflicts[0], source), false
+	}
+
+	conflict := seen.conflict(b.Height, b.Hash, source)
+	for _, h := range seen.conflicts {
+		if bytes.Equal(h, b.Hash) {
+			return conflict, false
+		}
+	}
+	seen.conflicts = append(seen.conflicts, append([]byte(nil), b.Hash...))
+
+	return conflict, true
+}
+
+func (s *seenBlock) conflict(height uint64, hash []byte, source string) *store.BlockHashConflict {
+	return &store.BlockHashConflict{
+		Height:       height,
+		FirstHash:    s.hash,
+		FirstSource:  s.source,
+		Voted:        s.voted,
+		SecondHash:   append([]byte(nil), hash...),
+		SecondSource: source,
+		DetectedAt:   time.Now().UTC(),
+	}
+}
+
+// prune forgets the heights which left the window. It should be called with
+// the lock held
+func (t *blockHashTracker) prune() {
+	for height := range t.blocks {
+		if height+t.window <= t.maxHeight {
+			delete(t.blocks, height)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package service
 
 import (
+	"context"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -26,6 +29,12 @@ var (
 const (
 	// TODO: Maybe configurable?
 	maxFailedCycles = 20
+	// resubscribeCycles is the number of polling cycles after which the poller
+	// subscribes to new blocks again once the subscription fails or drops
+	resubscribeCycles = 10
+	// maxBackfillBlocks is the maximum number of blocks queried at once to
+	// fill a gap of the new block subscription
+	maxBackfillBlocks = 100
 )
 
 type skipHeightRequest struct {
@@ -45,6 +54,8 @@ type ChainPoller struct {
 	cc             clientcontroller.ClientController
 	cfg            *cfg.ChainPollerConfig
 	metrics        *metrics.FpMetrics
+	subscriber     clientcontroller.BlockSubscriber
+	blockHashes    *blockHashTracker
 	blockInfoChan  chan *types.BlockInfo
 	skipHeightChan chan *skipHeightRequest
 	nextHeight     uint64
@@ -57,12 +68,21 @@ func NewChainPoller(
 	cc clientcontroller.ClientController,
 	metrics *metrics.FpMetrics,
 ) *ChainPoller {
+	var subscriber clientcontroller.BlockSubscriber
+	if cfg.SubscriptionMode {
+		var ok bool
+		if subscriber, ok = cc.(clientcontroller.BlockSubscriber); !ok {
+			logger.Warn("the consumer chain does not support subscribing to new blocks, falling back to polling")
+		}
+	}
+
 	return &ChainPoller{
 		isStarted:      atomic.NewBool(false),
 		logger:         logger,
 		cfg:            cfg,
 		cc:             cc,
 		metrics:        metrics,
+		subscriber:     subscriber,
 		blockInfoChan:  make(chan *types.BlockInfo, cfg.BufferSize),
 		skipHeightChan: make(chan *skipHeightRequest),
 		quit:           make(chan struct{}),
@@ -158,7 +178,12 @@ func (cp *ChainPoller) blockWithRetry(height uint64) (*types.BlockInfo, error) {
 			return err
 		}
 		return nil
-	}, RtyAtt, RtyDel, RtyErr, retry.OnRetry(func(n uint, err error) {
+	}, RtyAtt, RtyDel, RtyErr, retry.RetryIf(func(err error) bool {
+		// the endpoints disagreeing on the block are reported on every cycle
+		// rather than retried
+		var disagreement *clientcontroller.BlockDisagreementError
+		return !errors.As(err, &disagreement)
+	}), retry.OnRetry(func(n uint, err error) {
 		cp.logger.Debug(
 			"failed to query the consumer chain for the latest block",
 			zap.Uint("attempt", n+1),
@@ -173,6 +198,33 @@ func (cp *ChainPoller) blockWithRetry(height uint64) (*types.BlockInfo, error) {
 	return block, nil
 }
 
+func (cp *ChainPoller) blocksWithRetry(startHeight, endHeight uint64) ([]*types.BlockInfo, error) {
+	var (
+		blocks []*types.BlockInfo
+		err    error
+	)
+	if err := retry.Do(func() error {
+		blocks, err = cp.cc.QueryBlocks(startHeight, endHeight, maxBackfillBlocks)
+		if err != nil {
+			return err
+		}
+		return nil
+	}, RtyAtt, RtyDel, RtyErr, retry.OnRetry(func(n uint, err error) {
+		cp.logger.Debug(
+			"failed to query the consumer chain for the blocks",
+			zap.Uint("attempt", n+1),
+			zap.Uint("max_attempts", RtyAttNum),
+			zap.Uint64("start_height", startHeight),
+			zap.Uint64("end_height", endHeight),
+			zap.Error(err),
+		)
+	})); err != nil {
+		return nil, err
+	}
+
+	return blocks, nil
+}
+
 func (cp *ChainPoller) validateStartHeight(startHeight uint64) error {
 	// Infinite retry to get initial latest height
 	// TODO: Add possible cancellation or timeout for starting node
@@ -230,14 +282,34 @@ func (cp *ChainPoller) pollChain() {
 
 	cp.waitForActivation()
 
-	var failedCycles uint32
+	var (
+		failedCycles uint32
+		// cyclesToSubscribe is the number of polling cycles left before
+		// subscribing to new blocks again
+		cyclesToSubscribe uint32
+	)
 
 	for {
+		if cp.subscriber != nil {
+			if cyclesToSubscribe == 0 {
+				if stopped := cp.followNewBlocks(); stopped {
+					return
+				}
+				cyclesToSubscribe = resubscribeCycles
+			}
+			cyclesToSubscribe--
+		}
+
 		// TODO: Handlig of request cancellation, as otherwise shutdown will be blocked
 		// until request is finished
 		blockToRetrieve := cp.nextHeight
 		block, err := cp.blockWithRetry(blockToRetrieve)
-		if err != nil {
+		var disagreement *clientcontroller.BlockDisagreementError
+		if errors.As(err, &disagreement) {
+			// the block is withheld from voting until the endpoints agree on
+			// it, which is not a failure of the poller
+			cp.reportBlockDisagreement(disagreement)
+		} else if err != nil {
 			failedCycles++
 			cp.logger.Debug(
 				"failed to query the consumer chain for the block",
@@ -248,17 +320,8 @@ func (cp *ChainPoller) pollChain() {
 		} else {
 			// no error and we got the header we wanted to get, bump the state and push
 			// notification about data
-			cp.nextHeight = blockToRetrieve + 1
 			failedCycles = 0
-			cp.metrics.RecordLastPolledHeight(block.Height)
-
-			cp.logger.Info("the poller retrieved the block from the consumer chain",
-				zap.Uint64("height", block.Height))
-
-			// push the data to the channel
-			// Note: if the consumer is too slow -- the buffer is full
-			// the channel will block, and we will stop retrieving data from the node
-			cp.blockInfoChan <- block
+			cp.pushBlock(block, blockSourcePoll)
 		}
 
 		if failedCycles > maxFailedCycles {
@@ -269,35 +332,154 @@ func (cp *ChainPoller) pollChain() {
 		case <-time.After(cp.cfg.PollInterval):
 
 		case req := <-cp.skipHeightChan:
-			// no need to skip heights if the target height is not higher
-			// than the next height to retrieve
-			targetHeight := req.height
-			if targetHeight <= cp.nextHeight {
-				resp := &skipHeightResponse{
-					err: fmt.Errorf(
-						"the target height %d is not higher than the next height %d to retrieve",
-						targetHeight, cp.nextHeight)}
-				req.resp <- resp
-				continue
+			cp.skipHeight(req)
+
+		case <-cp.quit:
+			return
+		}
+	}
+}
+
+// followNewBlocks pushes the blocks received from the new block subscription,
+// backfilling the blocks it misses, until the subscription drops or the poller
+// is stopped. It returns whether the poller is stopped
+func (cp *ChainPoller) followNewBlocks() bool {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	blocks, err := cp.subscriber.SubscribeNewBlocks(ctx)
+	if err != nil {
+		cp.logger.Warn("failed to subscribe to new blocks, falling back to polling", zap.Error(err))
+		return false
+	}
+
+	cp.logger.Info("subscribed to the new blocks of the consumer chain")
+
+	// catch up with the blocks produced before the subscription
+	latestBlock, err := cp.latestBlockWithRetry()
+	if err == nil {
+		cp.blockHashes.observe(latestBlock, blockSourceTip)
+		err = cp.backfill(latestBlock.Height)
+	}
+	if err != nil {
+		cp.logger.Warn("failed to catch up with the consumer chain, falling back to polling", zap.Error(err))
+		return false
+	}
+
+	for {
+		select {
+		case block, ok := <-blocks:
+			if !ok {
+				cp.logger.Warn("the new block subscription dropped, falling back to polling")
+				return false
 			}
 
-			// drain blocks that can be skipped from blockInfoChan
-			cp.clearChanBufferUpToHeight(targetHeight)
+			// the block is already retrieved or skipped, but its hash is
+			// still checked against the one retrieved
+			if block.Height < cp.nextHeight {
+				cp.blockHashes.observe(block, blockSourceSubscription)
+				continue
+			}
 
-			// set the next height to the skip height
-			cp.nextHeight = targetHeight
+			if block.Height > cp.nextHeight {
+				cp.logger.Debug("detected a gap in the new block subscription",
+					zap.Uint64("next_height", cp.nextHeight),
+					zap.Uint64("received_height", block.Height))
+				if err := cp.backfill(block.Height - 1); err != nil {
+					cp.logger.Warn("failed to backfill the missed blocks, falling back to polling", zap.Error(err))
+					return false
+				}
+			}
 
-			cp.logger.Debug("the poller has skipped height(s)",
-				zap.Uint64("next_height", req.height))
+			cp.pushBlock(block, blockSourceSubscription)
 
-			req.resp <- &skipHeightResponse{}
+		case req := <-cp.skipHeightChan:
+			cp.skipHeight(req)
 
 		case <-cp.quit:
-			return
+			return true
 		}
 	}
 }
 
+// backfill retrieves the blocks from the next height up to the given height
+func (cp *ChainPoller) backfill(toHeight uint64) error {
+	for cp.nextHeight <= toHeight {
+		blocks, err := cp.blocksWithRetry(cp.nextHeight, toHeight)
+		if err != nil {
+			return err
+		}
+		if len(blocks) == 0 {
+			return fmt.Errorf("no block is returned from height %d to %d", cp.nextHeight, toHeight)
+		}
+
+		for _, block := range blocks {
+			if block.Height != cp.nextHeight {
+				return fmt.Errorf("expected the block at height %d, got %d", cp.nextHeight, block.Height)
+			}
+			cp.pushBlock(block, blockSourceBackfill)
+		}
+	}
+
+	return nil
+}
+
+// pushBlock records the hash of the block retrieved from the given source,
+// bumps the next height, and pushes the block to the channel
+func (cp *ChainPoller) pushBlock(block *types.BlockInfo, source string) {
+	cp.blockHashes.observe(block, source)
+	cp.nextHeight = block.Height + 1
+	cp.metrics.RecordLastPolledHeight(block.Height)
+
+	cp.logger.Info("the poller retrieved the block from the consumer chain",
+		zap.Uint64("height", block.Height))
+
+	// push the data to the channel
+	// Note: if the consumer is too slow -- the buffer is full
+	// the channel will block, and we will stop retrieving data from the node
+	cp.blockInfoChan <- block
+}
+
+// reportBlockDisagreement reports the endpoints of the consumer chain
+// returning different blocks at a height, one of which may be compromised
+func (cp *ChainPoller) reportBlockDisagreement(disagreement *clientcontroller.BlockDisagreementError) {
+	cp.metrics.IncrementChainBlockDisagreements()
+
+	hashes := make(map[string]string, len(disagreement.Hashes))
+	for endpoint, hash := range disagreement.Hashes {
+		hashes[endpoint] = hex.EncodeToString(hash)
+	}
+	cp.logger.Error("the endpoints of the consumer chain disagree on the block, withholding it from voting",
+		zap.Uint64("height", disagreement.Height),
+		zap.Any("hashes", hashes),
+	)
+}
+
+func (cp *ChainPoller) skipHeight(req *skipHeightRequest) {
+	// no need to skip heights if the target height is not higher
+	// than the next height to retrieve
+	targetHeight := req.height
+	if targetHeight <= cp.nextHeight {
+		resp := &skipHeightResponse{
+			err: fmt.Errorf(
+				"the target height %d is not higher than the next height %d to retrieve",
+				targetHeight, cp.nextHeight)}
+		req.resp <- resp
+		return
+	}
+
+	// drain blocks that can be skipped from blockInfoChan
+	cp.clearChanBufferUpToHeight(targetHeight)
+
+	// set the next height to the skip height
+	cp.nextHeight = targetHeight
+
+	cp.logger.Debug("the poller has skipped height(s)",
+		zap.Uint64("next_height", req.height))
+
+	req.resp <- &skipHeightResponse{}
+}
+
 func (cp *ChainPoller) SkipToHeight(height uint64) error {
 	if !cp.IsRunning() {
 		return fmt.Errorf("the chain poller is stopped")
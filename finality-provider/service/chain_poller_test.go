@@ -1,15 +1,19 @@
 package service_test
 
 import (
+	"context"
+	"fmt"
 	"math/rand"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/babylonchain/babylon/testutil/datagen"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
+	"github.com/babylonchain/finality-provider/clientcontroller"
 	fpcfg "github.com/babylonchain/finality-provider/finality-provider/config"
 	"github.com/babylonchain/finality-provider/finality-provider/service"
 	"github.com/babylonchain/finality-provider/metrics"
@@ -69,6 +73,70 @@ func FuzzChainPoller_Start(f *testing.F) {
 	})
 }
 
+// FuzzChainPoller_BlockDisagreement tests that the poller withholds a block
+// the endpoints of the consumer chain disagree on until they agree
+func FuzzChainPoller_BlockDisagreement(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		startHeight := uint64(r.Int63n(100) + 1)
+
+		ctl := gomock.NewController(t)
+		mockClientController := mocks.NewMockClientController(ctl)
+		mockClientController.EXPECT().Close().Return(nil).AnyTimes()
+		mockClientController.EXPECT().QueryActivatedHeight().Return(uint64(1), nil).AnyTimes()
+
+		mockClientController.EXPECT().QueryBestBlock().Return(&types.BlockInfo{Height: startHeight - 1}, nil).AnyTimes()
+
+		agreed := make(chan struct{})
+		block := &types.BlockInfo{
+			Height: startHeight,
+			Hash:   datagen.GenRandomByteArray(r, 32),
+		}
+		mockClientController.EXPECT().QueryBlock(startHeight).DoAndReturn(func(height uint64) (*types.BlockInfo, error) {
+			select {
+			case <-agreed:
+				return block, nil
+			default:
+				return nil, &clientcontroller.BlockDisagreementError{
+					Height: height,
+					Hashes: map[string][]byte{
+						"node0": block.Hash,
+						"node1": datagen.GenRandomByteArray(r, 32),
+					},
+				}
+			}
+		}).AnyTimes()
+		mockClientController.EXPECT().QueryBlock(gomock.Not(startHeight)).Return(nil, fmt.Errorf("no block yet")).AnyTimes()
+
+		m := metrics.NewFpMetrics()
+		pollerCfg := fpcfg.DefaultChainPollerConfig()
+		pollerCfg.PollInterval = 10 * time.Millisecond
+		poller := service.NewChainPoller(zap.NewNop(), &pollerCfg, mockClientController, m)
+		err := poller.Start(startHeight)
+		require.NoError(t, err)
+		defer func() {
+			err := poller.Stop()
+			require.NoError(t, err)
+		}()
+
+		select {
+		case info := <-poller.GetBlockInfoChan():
+			t.Fatalf("the block %d is not withheld", info.Height)
+		case <-time.After(200 * time.Millisecond):
+		}
+
+		close(agreed)
+		select {
+		case info := <-poller.GetBlockInfoChan():
+			require.Equal(t, block, info)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("Failed to get block info")
+		}
+	})
+}
+
 // FuzzChainPoller_SkipHeight tests the functionality of SkipHeight
 func FuzzChainPoller_SkipHeight(f *testing.F) {
 	testutil.AddRandomSeedsToFuzzer(f, 10)
@@ -151,3 +219,106 @@ func FuzzChainPoller_SkipHeight(f *testing.F) {
 		require.Equal(t, skipHeight+1, poller.NextHeight())
 	})
 }
+
+// mockBlockSubscriber hands the channels of the new block subscriptions of
+// the poller to the test
+type mockBlockSubscriber struct {
+	*mocks.MockClientController
+	subscriptions chan chan *types.BlockInfo
+}
+
+func (m *mockBlockSubscriber) SubscribeNewBlocks(_ context.Context) (<-chan *types.BlockInfo, error) {
+	blocks := make(chan *types.BlockInfo)
+	m.subscriptions <- blocks
+	return blocks, nil
+}
+
+// FuzzChainPoller_Subscription tests the poller receiving blocks from the
+// new block subscription, backfilling the missed blocks, and falling back
+// to polling when the subscription drops
+func FuzzChainPoller_Subscription(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		currentHeight := uint64(r.Int63n(100) + 1)
+		startHeight := currentHeight + 1
+		gapHeight := startHeight + uint64(r.Int63n(10)+2)
+
+		ctl := gomock.NewController(t)
+		mockClientController := mocks.NewMockClientController(ctl)
+		mockClientController.EXPECT().Close().Return(nil).AnyTimes()
+		mockClientController.EXPECT().QueryActivatedHeight().Return(uint64(1), nil).AnyTimes()
+		mockClientController.EXPECT().QueryBestBlock().Return(&types.BlockInfo{Height: currentHeight}, nil).AnyTimes()
+		mockClientController.EXPECT().QueryBlock(gomock.Any()).DoAndReturn(func(height uint64) (*types.BlockInfo, error) {
+			return &types.BlockInfo{Height: height}, nil
+		}).AnyTimes()
+		mockClientController.EXPECT().QueryBlocks(startHeight+1, gapHeight-1, gomock.Any()).DoAndReturn(
+			func(start, end, _ uint64) ([]*types.BlockInfo, error) {
+				var blocks []*types.BlockInfo
+				for h := start; h <= end; h++ {
+					blocks = append(blocks, &types.BlockInfo{Height: h})
+				}
+				return blocks, nil
+			}).Times(1)
+		subscriber := &mockBlockSubscriber{
+			MockClientController: mockClientController,
+			subscriptions:        make(chan chan *types.BlockInfo, 1),
+		}
+
+		m := metrics.NewFpMetrics()
+		pollerCfg := fpcfg.DefaultChainPollerConfig()
+		pollerCfg.PollInterval = 10 * time.Millisecond
+		pollerCfg.SubscriptionMode = true
+		poller := service.NewChainPoller(zap.NewNop(), &pollerCfg, subscriber, m)
+		err := poller.Start(startHeight)
+		require.NoError(t, err)
+		defer func() {
+			err := poller.Stop()
+			require.NoError(t, err)
+		}()
+
+		expectBlock := func(height uint64) {
+			select {
+			case info := <-poller.GetBlockInfoChan():
+				require.Equal(t, height, info.Height)
+			case <-time.After(10 * time.Second):
+				t.Fatalf("Failed to get block info")
+			}
+		}
+
+		sub := <-subscriber.subscriptions
+		sub <- &types.BlockInfo{Height: startHeight}
+		expectBlock(startHeight)
+
+		// the blocks missed by the subscription are backfilled, and the
+		// blocks already retrieved are ignored
+		sub <- &types.BlockInfo{Height: gapHeight}
+		for h := startHeight + 1; h <= gapHeight; h++ {
+			expectBlock(h)
+		}
+		sub <- &types.BlockInfo{Height: gapHeight - 1}
+
+		// the poller polls the blocks once the subscription drops, and
+		// subscribes again after a while
+		close(sub)
+		nextHeight := gapHeight + 1
+		for {
+			select {
+			case sub = <-subscriber.subscriptions:
+			case info := <-poller.GetBlockInfoChan():
+				require.Equal(t, nextHeight, info.Height)
+				nextHeight++
+				continue
+			case <-time.After(10 * time.Second):
+				t.Fatalf("Failed to subscribe again")
+			}
+			break
+		}
+		subscribedHeight := poller.NextHeight()
+		sub <- &types.BlockInfo{Height: subscribedHeight}
+		for ; nextHeight <= subscribedHeight; nextHeight++ {
+			expectBlock(nextHeight)
+		}
+	})
+}
@@ -135,3 +135,14 @@ func (c *FinalityProviderServiceGRpcClient) SignMessageFromChainKey(
 	}
 	return c.client.SignMessageFromChainKey(ctx, req)
 }
+
+// BackupDB asks the daemon to write a snapshot of its database to the backup
+// directory and returns the path of the snapshot
+func (c *FinalityProviderServiceGRpcClient) BackupDB(ctx context.Context) (string, error) {
+	res, err := c.client.BackupDatabase(ctx, &proto.BackupDatabaseRequest{})
+	if err != nil {
+		return "", err
+	}
+
+	return res.Path, nil
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"github.com/babylonchain/finality-provider/clientcontroller"
+	"github.com/babylonchain/finality-provider/finality-provider/proto"
+)
+
+// ChainEndpointsHealth returns the health of the endpoints of the consumer
+// chain, or nil if the client controller does not track it
+func (app *FinalityProviderApp) ChainEndpointsHealth() []*proto.ChainEndpointHealth {
+	reporter, ok := app.cc.(clientcontroller.EndpointHealthReporter)
+	if !ok {
+		return nil
+	}
+
+	endpoints := reporter.EndpointHealth()
+	health := make([]*proto.ChainEndpointHealth, 0, len(endpoints))
+	for _, e := range endpoints {
+		health = append(health, &proto.ChainEndpointHealth{
+			Address:   e.Address,
+			Active:    e.Active,
+			LatencyMs: uint64(e.Latency.Milliseconds()),
+			ErrorRate: e.ErrorRate,
+			TipHeight: e.TipHeight,
+			TipLag:    e.TipLag,
+			LastError: e.LastError,
+			Score:     e.Score,
+		})
+	}
+
+	return health
+}
+
+// recordChainEndpointsHealth records the health of the endpoints of the
+// consumer chain in the metrics
+func (app *FinalityProviderApp) recordChainEndpointsHealth() {
+	reporter, ok := app.cc.(clientcontroller.EndpointHealthReporter)
+	if !ok {
+		return
+	}
+
+	for _, e := range reporter.EndpointHealth() {
+		app.metrics.RecordChainEndpointHealth(e.Address, e.Active, e.Latency, e.ErrorRate, e.TipHeight)
+	}
+}
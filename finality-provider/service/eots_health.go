@@ -0,0 +1,78 @@
+package service
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	eotsproto "github.com/babylonchain/finality-provider/eotsmanager/proto"
+	"github.com/babylonchain/finality-provider/finality-provider/proto"
+)
+
+// eotsHealthReporter is an EOTS manager reporting its health, which is the
+// case of the remote EOTS manager
+type eotsHealthReporter interface {
+	Health() (*eotsproto.HealthResponse, error)
+}
+
+// checkEOTSManagerHealth fails if the database of the EOTS manager is
+// unhealthy and warns about anything blocking signing, which can be expected
+// e.g. for a standby replica. EOTS managers not reporting their health are
+// accepted for compatibility
+func checkEOTSManagerHealth(em eotsHealthReporter, logger *zap.Logger) error {
+	health, err := em.Health()
+	if status.Code(err) == codes.Unimplemented {
+		logger.Warn("the EOTS manager does not report its health, consider upgrading it")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to query the health of the EOTS manager: %w", err)
+	}
+
+	if health.DbError != "" {
+		return fmt.Errorf("the database of the EOTS manager is unhealthy: %s", health.DbError)
+	}
+
+	logger.Info("the EOTS manager is healthy",
+		zap.String("version", health.Version),
+		zap.String("key_backend", health.KeyBackend),
+		zap.Uint32("num_keys", health.NumKeys),
+		zap.Bool("policy_enabled", health.PolicyEnabled),
+		zap.Bool("lease_enabled", health.LeaseEnabled),
+	)
+	if len(health.SigningBlockers) != 0 {
+		logger.Warn("signing by the EOTS manager would be blocked",
+			zap.Strings("blockers", health.SigningBlockers))
+	}
+
+	return nil
+}
+
+// EOTSManagerHealth returns the health reported by the EOTS manager, or nil
+// if the EOTS manager runs in process and has no health to report
+func (app *FinalityProviderApp) EOTSManagerHealth() *proto.EOTSManagerHealth {
+	reporter, ok := app.eotsManager.(eotsHealthReporter)
+	if !ok {
+		return nil
+	}
+
+	health, err := reporter.Health()
+	if err != nil {
+		return &proto.EOTSManagerHealth{Error: err.Error()}
+	}
+
+	return &proto.EOTSManagerHealth{
+		Version:         health.Version,
+		KeyBackend:      health.KeyBackend,
+		NumKeys:         health.NumKeys,
+		DbError:         health.DbError,
+		UptimeSeconds:   health.UptimeSeconds,
+		PolicyEnabled:   health.PolicyEnabled,
+		LeaseEnabled:    health.LeaseEnabled,
+		LeaseHeld:       health.LeaseHeld,
+		SigningBlockers: health.SigningBlockers,
+		Ready:           health.Ready,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	bbntypes "github.com/babylonchain/babylon/types"
+	eotstypes "github.com/babylonchain/finality-provider/eotsmanager/types"
 	"github.com/babylonchain/finality-provider/types"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
@@ -66,3 +67,22 @@ func (fp *FinalityProviderInstance) signFinalitySig(b *types.BlockInfo) (*bbntyp
 
 	return bbntypes.NewSchnorrEOTSSigFromModNScalar(sig), nil
 }
+
+// signFinalitySigs signs the finality signatures over the given blocks in a
+// single call to the EOTS manager
+func (fp *FinalityProviderInstance) signFinalitySigs(blocks []*types.BlockInfo) ([]*btcec.ModNScalar, error) {
+	reqs := make([]*eotstypes.EOTSSignRequest, 0, len(blocks))
+	for _, b := range blocks {
+		reqs = append(reqs, &eotstypes.EOTSSignRequest{
+			Height: b.Height,
+			Msg:    getMsgToSignForVote(b.Height, b.Hash),
+		})
+	}
+
+	sigs, err := fp.em.SignEOTSBatch(fp.btcPk.MustMarshal(), fp.GetChainID(), reqs, fp.passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign EOTS batch: %w", err)
+	}
+
+	return sigs, nil
+}
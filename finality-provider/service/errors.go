@@ -4,4 +4,5 @@ import "errors"
 
 var (
 	ErrFinalityProviderShutDown = errors.New("the finality provider instance is shutting down")
+	ErrVotingHalted             = errors.New("the finality provider halted voting after seeing conflicting blocks")
 )
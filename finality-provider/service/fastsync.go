@@ -49,6 +49,7 @@ func (fp *FinalityProviderInstance) FastSync(startHeight, endHeight uint64) (*Fa
 		// have gaps during sync
 		catchUpBlocks := make([]*types.BlockInfo, 0, len(blocks))
 		for _, b := range blocks {
+			fp.blockHashes.observe(b, blockSourceFastSync)
 			// check whether the block has been processed before
 			if fp.hasProcessed(b) {
 				continue
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strings"
@@ -44,6 +45,11 @@ type FinalityProviderInstance struct {
 	laggingTargetChan chan *types.BlockInfo
 	criticalErrChan   chan<- *CriticalError
 
+	// blockHashes detects the heights whose blocks change, upon which the
+	// finality provider halts voting until it is restarted
+	blockHashes  *blockHashTracker
+	votingHalted *atomic.Bool
+
 	isStarted *atomic.Bool
 	inSync    *atomic.Bool
 	isLagging *atomic.Bool
@@ -76,7 +82,7 @@ func NewFinalityProviderInstance(
 		return nil, fmt.Errorf("the finality-provider %s has not been registered", sfp.KeyName)
 	}
 
-	return &FinalityProviderInstance{
+	fp := &FinalityProviderInstance{
 		btcPk:           bbntypes.NewBIP340PubKeyFromBTCPK(sfp.BtcPk),
 		fpState:         NewFpState(sfp, s),
 		pubRandState:    NewPubRandState(prStore),
@@ -85,12 +91,16 @@ func NewFinalityProviderInstance(
 		isStarted:       atomic.NewBool(false),
 		inSync:          atomic.NewBool(false),
 		isLagging:       atomic.NewBool(false),
+		votingHalted:    atomic.NewBool(false),
 		criticalErrChan: errChan,
 		passphrase:      passphrase,
 		em:              em,
 		cc:              cc,
 		metrics:         metrics,
-	}, nil
+	}
+	fp.blockHashes = newBlockHashTracker(cfg.PollerConfig.BlockHashWindow, fp.onBlockHashConflict)
+
+	return fp, nil
 }
 
 func (fp *FinalityProviderInstance) Start() error {
@@ -100,6 +110,9 @@ func (fp *FinalityProviderInstance) Start() error {
 
 	fp.logger.Info("Starting finality-provider instance", zap.String("pk", fp.GetBtcPkHex()))
 
+	fp.votingHalted.Store(false)
+	fp.metrics.RecordFpVotingHalted(fp.GetBtcPkHex(), false)
+
 	startHeight, err := fp.bootstrap()
 	if err != nil {
 		return fmt.Errorf("failed to bootstrap the finality-provider %s: %w", fp.GetBtcPkHex(), err)
@@ -109,6 +122,7 @@ func (fp *FinalityProviderInstance) Start() error {
 		zap.String("pk", fp.GetBtcPkHex()), zap.Uint64("height", startHeight))
 
 	poller := NewChainPoller(fp.logger, fp.cfg.PollerConfig, fp.cc, fp.metrics)
+	poller.blockHashes = fp.blockHashes
 
 	if err := poller.Start(startHeight + 1); err != nil {
 		return fmt.Errorf("failed to start the poller: %w", err)
@@ -138,7 +152,7 @@ func (fp *FinalityProviderInstance) bootstrap() (uint64, error) {
 
 	if fp.checkLagging(latestBlock) {
 		_, err := fp.tryFastSync(latestBlock)
-		if err != nil && !clientcontroller.IsExpected(err) {
+		if err != nil && !clientcontroller.IsExpected(err) && !errors.Is(err, ErrVotingHalted) {
 			return 0, err
 		}
 	}
@@ -190,6 +204,11 @@ func (fp *FinalityProviderInstance) finalitySigSubmissionLoop() {
 			if fp.hasProcessed(b) {
 				continue
 			}
+			// the block is left unprocessed so that it is voted for once
+			// the finality provider is restarted
+			if fp.isVotingHalted() {
+				continue
+			}
 			// check whether the finality provider has voting power
 			hasVp, err := fp.hasVotingPower(b)
 			if err != nil {
@@ -222,6 +241,9 @@ func (fp *FinalityProviderInstance) finalitySigSubmissionLoop() {
 			// use the copy of the block to avoid the impact to other receivers
 			nextBlock := *b
 			res, err := fp.retrySubmitFinalitySignatureUntilBlockFinalized(&nextBlock)
+			if errors.Is(err, ErrVotingHalted) {
+				continue
+			}
 			if err != nil {
 				fp.metrics.IncrementFpTotalFailedVotes(fp.GetBtcPkHex())
 				if !errors.Is(err, ErrFinalityProviderShutDown) {
@@ -449,6 +471,60 @@ func (fp *FinalityProviderInstance) hasRandomness(b *types.BlockInfo) (bool, err
 	return true, nil
 }
 
+func (fp *FinalityProviderInstance) isVotingHalted() bool {
+	if fp.votingHalted.Load() {
+		fp.logger.Debug(
+			"the finality-provider halted voting after seeing conflicting blocks",
+			zap.String("pk", fp.GetBtcPkHex()),
+		)
+		return true
+	}
+
+	return false
+}
+
+// checkVote records the block to vote for, and returns ErrVotingHalted if
+// the finality provider halted voting or has seen another block at the height
+func (fp *FinalityProviderInstance) checkVote(b *types.BlockInfo) error {
+	if fp.votingHalted.Load() {
+		return ErrVotingHalted
+	}
+	if conflict := fp.blockHashes.recordVote(b); conflict != nil {
+		return fmt.Errorf("%w: another block was seen at height %d", ErrVotingHalted, b.Height)
+	}
+
+	return nil
+}
+
+// onBlockHashConflict halts voting when the finality provider sees two
+// different blocks at a height, and records the conflict for investigation
+func (fp *FinalityProviderInstance) onBlockHashConflict(conflict *store.BlockHashConflict) {
+	conflict.FpBtcPkHex = fp.GetBtcPkHex()
+	fp.votingHalted.Store(true)
+	fp.metrics.IncrementFpTotalBlockHashConflicts(fp.GetBtcPkHex())
+	fp.metrics.RecordFpVotingHalted(fp.GetBtcPkHex(), true)
+
+	fp.logger.Error(
+		"detected conflicting blocks at the same height, halting voting until the finality-provider is restarted",
+		zap.String("pk", fp.GetBtcPkHex()),
+		zap.Uint64("height", conflict.Height),
+		zap.String("first_hash", hex.EncodeToString(conflict.FirstHash)),
+		zap.String("first_source", conflict.FirstSource),
+		zap.Bool("voted", conflict.Voted),
+		zap.String("second_hash", hex.EncodeToString(conflict.SecondHash)),
+		zap.String("second_source", conflict.SecondSource),
+	)
+
+	if err := fp.fpState.addBlockHashConflict(conflict); err != nil {
+		fp.logger.Error(
+			"failed to persist the conflicting blocks",
+			zap.String("pk", fp.GetBtcPkHex()),
+			zap.Uint64("height", conflict.Height),
+			zap.Error(err),
+		)
+	}
+}
+
 func (fp *FinalityProviderInstance) reportCriticalErr(err error) {
 	fp.criticalErrChan <- &CriticalError{
 		err:     err,
@@ -552,7 +628,7 @@ func (fp *FinalityProviderInstance) retrySubmitFinalitySignatureUntilBlockFinali
 				zap.Error(err),
 			)
 
-			if clientcontroller.IsUnrecoverable(err) {
+			if clientcontroller.IsUnrecoverable(err) || errors.Is(err, ErrVotingHalted) {
 				return nil, err
 			}
 
@@ -598,6 +674,7 @@ func (fp *FinalityProviderInstance) checkBlockFinalization(height uint64) (bool,
 	if err != nil {
 		return false, err
 	}
+	fp.blockHashes.observe(b, blockSourceFinalityCheck)
 
 	return b.Finalized, nil
 }
@@ -729,6 +806,10 @@ func (fp *FinalityProviderInstance) CommitPubRand(tipHeight uint64) (*types.TxRe
 
 // SubmitFinalitySignature builds and sends a finality signature over the given block to the consumer chain
 func (fp *FinalityProviderInstance) SubmitFinalitySignature(b *types.BlockInfo) (*types.TxResponse, error) {
+	if err := fp.checkVote(b); err != nil {
+		return nil, err
+	}
+
 	sig, err := fp.signFinalitySig(b)
 	if err != nil {
 		return nil, err
@@ -775,6 +856,11 @@ func (fp *FinalityProviderInstance) SubmitBatchFinalitySignatures(blocks []*type
 	if len(blocks) == 0 {
 		return nil, fmt.Errorf("should not submit batch finality signature with zero block")
 	}
+	for _, b := range blocks {
+		if err := fp.checkVote(b); err != nil {
+			return nil, err
+		}
+	}
 
 	// get public randomness list
 	prList, err := fp.getPubRandList(blocks[0].Height, uint64(len(blocks)))
@@ -789,13 +875,9 @@ func (fp *FinalityProviderInstance) SubmitBatchFinalitySignatures(blocks []*type
 	}
 
 	// sign blocks
-	sigList := make([]*btcec.ModNScalar, 0, len(blocks))
-	for _, b := range blocks {
-		eotsSig, err := fp.signFinalitySig(b)
-		if err != nil {
-			return nil, err
-		}
-		sigList = append(sigList, eotsSig.ToModNScalar())
+	sigList, err := fp.signFinalitySigs(blocks)
+	if err != nil {
+		return nil, err
 	}
 
 	// send finality signature to the consumer chain
@@ -991,6 +1073,7 @@ func (fp *FinalityProviderInstance) getLatestBlockWithRetry() (*types.BlockInfo,
 	})); err != nil {
 		return nil, err
 	}
+	fp.blockHashes.observe(latestBlock, blockSourceTip)
 	fp.metrics.RecordBabylonTipHeight(latestBlock.Height)
 
 	return latestBlock, nil
@@ -40,6 +40,9 @@ type FinalityProviderManager struct {
 
 	// running finality-provider instances map keyed by the hex string of the BTC public key
 	fpis map[string]*FinalityProviderInstance
+	// passphrases of the finality providers keyed by the hex string of the BTC
+	// public key, used when an instance is started without a passphrase
+	passphrases map[string]string
 
 	// needed for initiating finality-provider instances
 	fps          *store.FinalityProviderStore
@@ -229,6 +232,16 @@ func (fpm *FinalityProviderManager) StartFinalityProvider(fpPk *bbntypes.BIP340P
 	return nil
 }
 
+// SetPassphrases sets the passphrases of the finality providers keyed by the
+// hex string of their BTC public keys, which are used to start the instances
+// without a given passphrase
+func (fpm *FinalityProviderManager) SetPassphrases(passphrases map[string]string) {
+	fpm.mu.Lock()
+	defer fpm.mu.Unlock()
+
+	fpm.passphrases = passphrases
+}
+
 func (fpm *FinalityProviderManager) StartAll() error {
 	if !fpm.isStarted.Load() {
 		fpm.isStarted.Store(true)
@@ -392,6 +405,10 @@ func (fpm *FinalityProviderManager) addFinalityProviderInstance(
 		return fmt.Errorf("finality-provider instance already exists")
 	}
 
+	if passphrase == "" {
+		passphrase = fpm.passphrases[pkHex]
+	}
+
 	fpIns, err := NewFinalityProviderInstance(pk, fpm.config, fpm.fps, fpm.pubRandStore, fpm.cc, fpm.em, fpm.metrics, passphrase, fpm.criticalErrChan, fpm.logger)
 	if err != nil {
 		return fmt.Errorf("failed to create finality-provider %s instance: %w", pkHex, err)
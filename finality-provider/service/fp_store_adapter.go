@@ -101,6 +101,10 @@ func (fps *fpState) setLastProcessedAndVotedHeight(height uint64) error {
 	return fps.s.SetFpLastVotedHeight(fps.fp.BtcPk, height)
 }
 
+func (fps *fpState) addBlockHashConflict(conflict *store.BlockHashConflict) error {
+	return fps.s.AddBlockHashConflict(fps.fp.BtcPk, conflict)
+}
+
 func (fp *FinalityProviderInstance) GetStoreFinalityProvider() *store.StoredFinalityProvider {
 	return fp.fpState.getStoreFinalityProvider()
 }
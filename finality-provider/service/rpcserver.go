@@ -11,6 +11,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"google.golang.org/grpc"
 
+	"github.com/babylonchain/finality-provider/dbbackup"
 	"github.com/babylonchain/finality-provider/finality-provider/proto"
 	"github.com/babylonchain/finality-provider/types"
 	"github.com/babylonchain/finality-provider/version"
@@ -24,7 +25,8 @@ type rpcServer struct {
 
 	proto.UnimplementedFinalityProvidersServer
 
-	app *FinalityProviderApp
+	app      *FinalityProviderApp
+	backuper *dbbackup.Backuper
 
 	quit chan struct{}
 	wg   sync.WaitGroup
@@ -33,11 +35,13 @@ type rpcServer struct {
 // newRPCServer creates a new RPC sever from the set of input dependencies.
 func newRPCServer(
 	fpa *FinalityProviderApp,
+	backuper *dbbackup.Backuper,
 ) *rpcServer {
 
 	return &rpcServer{
-		quit: make(chan struct{}),
-		app:  fpa,
+		quit:     make(chan struct{}),
+		app:      fpa,
+		backuper: backuper,
 	}
 }
 
@@ -76,7 +80,9 @@ func (r *rpcServer) RegisterWithGrpcServer(grpcServer *grpc.Server) error {
 func (r *rpcServer) GetInfo(context.Context, *proto.GetInfoRequest) (*proto.GetInfoResponse, error) {
 
 	return &proto.GetInfoResponse{
-		Version: version.Version(),
+		Version:        version.Version(),
+		EotsManager:    r.app.EOTSManagerHealth(),
+		ChainEndpoints: r.app.ChainEndpointsHealth(),
 	}, nil
 }
 
@@ -218,3 +224,19 @@ func (r *rpcServer) SignMessageFromChainKey(ctx context.Context, req *proto.Sign
 
 	return &proto.SignMessageFromChainKeyResponse{Signature: signature}, nil
 }
+
+// BackupDatabase writes a snapshot of the database to the backup directory
+func (r *rpcServer) BackupDatabase(ctx context.Context, req *proto.BackupDatabaseRequest) (
+	*proto.BackupDatabaseResponse, error) {
+
+	if r.backuper == nil {
+		return nil, fmt.Errorf("backups are not supported by the database backend")
+	}
+
+	path, err := r.backuper.Backup()
+	if err != nil {
+		return nil, fmt.Errorf("failed to back up the database: %w", err)
+	}
+
+	return &proto.BackupDatabaseResponse{Path: path}, nil
+}
@@ -12,6 +12,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 
+	"github.com/babylonchain/finality-provider/dbbackup"
 	fpcfg "github.com/babylonchain/finality-provider/finality-provider/config"
 	"github.com/babylonchain/finality-provider/metrics"
 )
@@ -34,10 +35,16 @@ type Server struct {
 
 // NewFinalityproviderServer creates a new server with the given config.
 func NewFinalityProviderServer(cfg *fpcfg.Config, l *zap.Logger, fpa *FinalityProviderApp, db kvdb.Backend, sig signal.Interceptor) *Server {
+	// the snapshots are copies of bolt files
+	var backuper *dbbackup.Backuper
+	if !cfg.DatabaseConfig.IsSqlite() {
+		backuper = dbbackup.NewBackuper(db, cfg.Backup, fpcfg.DBBackupPrefix, l)
+	}
+
 	return &Server{
 		cfg:         cfg,
 		logger:      l,
-		rpcServer:   newRPCServer(fpa),
+		rpcServer:   newRPCServer(fpa, backuper),
 		db:          db,
 		interceptor: sig,
 		quit:        make(chan struct{}, 1),
@@ -70,6 +77,11 @@ func (s *Server) RunUntilShutdown() error {
 		s.logger.Info("Metrics server stopped")
 	}()
 
+	if s.rpcServer.backuper != nil {
+		s.rpcServer.backuper.Start()
+		defer s.rpcServer.backuper.Stop()
+	}
+
 	listenAddr := s.cfg.RpcListener
 	// we create listeners from the RPCListeners defined
 	// in the config.
@@ -79,7 +91,7 @@ func (s *Server) RunUntilShutdown() error {
 	}
 	defer lis.Close()
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(metrics.NewGrpcMetrics().UnaryServerInterceptor))
 	defer grpcServer.Stop()
 
 	if err := s.rpcServer.RegisterWithGrpcServer(grpcServer); err != nil {
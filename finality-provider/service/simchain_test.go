@@ -0,0 +1,126 @@
+package service_test
+
+import (
+	"math/rand"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/babylonchain/finality-provider/eotsmanager"
+	eotscfg "github.com/babylonchain/finality-provider/eotsmanager/config"
+	"github.com/babylonchain/finality-provider/finality-provider/config"
+	"github.com/babylonchain/finality-provider/finality-provider/service"
+	"github.com/babylonchain/finality-provider/finality-provider/store"
+	"github.com/babylonchain/finality-provider/testutil"
+	"github.com/babylonchain/finality-provider/testutil/simchain"
+)
+
+// TestFinalityProviderOnSimulatedChain runs a finality provider against the
+// simulated chain, from its registration to the finalization of the blocks
+// it votes for
+func TestFinalityProviderOnSimulatedChain(t *testing.T) {
+	sc, _, fp := startFinalityProviderOnSimulatedChain(t)
+
+	slashed, err := sc.QueryFinalityProviderSlashed(fp.BtcPk)
+	require.NoError(t, err)
+	require.False(t, slashed)
+}
+
+// TestFinalityProviderHaltsOnForkedBlock tests that a finality provider stops
+// voting once the chain serves another block at a height it voted for
+func TestFinalityProviderHaltsOnForkedBlock(t *testing.T) {
+	sc, app, fp := startFinalityProviderOnSimulatedChain(t)
+
+	forked, err := sc.ForkBlock(6)
+	require.NoError(t, err)
+	var conflicts []*store.BlockHashConflict
+	require.Eventually(t, func() bool {
+		conflicts, err = app.GetFinalityProviderStore().ListBlockHashConflicts(fp.BtcPk)
+		return err == nil && len(conflicts) > 0
+	}, 10*time.Second, 10*time.Millisecond)
+	require.Len(t, conflicts, 1)
+	require.Equal(t, fp.GetBIP340BTCPK().MarshalHex(), conflicts[0].FpBtcPkHex)
+	require.Equal(t, uint64(6), conflicts[0].Height)
+	require.True(t, conflicts[0].Voted)
+	require.Equal(t, forked.Hash, conflicts[0].SecondHash)
+
+	// the blocks after the conflict are not voted for
+	blocks := sc.ProduceBlocks(3)
+	require.Never(t, func() bool {
+		votes, err := sc.QueryVotesAtHeight(blocks[0].Height)
+		return err != nil || len(votes) > 0
+	}, 500*time.Millisecond, 10*time.Millisecond)
+	fpIns, err := app.GetFinalityProviderInstance(fp.GetBIP340BTCPK())
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), fpIns.GetLastProcessedHeight())
+
+	slashed, err := sc.QueryFinalityProviderSlashed(fp.BtcPk)
+	require.NoError(t, err)
+	require.False(t, slashed)
+}
+
+// startFinalityProviderOnSimulatedChain registers and starts a finality
+// provider on the simulated chain, and waits until it finalizes the first
+// blocks as the only one with voting power
+func startFinalityProviderOnSimulatedChain(t *testing.T) (*simchain.SimulatedChain, *service.FinalityProviderApp, *store.StoredFinalityProvider) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	logger := zap.NewNop()
+
+	eotsHomeDir := filepath.Join(t.TempDir(), "eots-home")
+	eotsCfg := eotscfg.DefaultConfigWithHomePath(eotsHomeDir)
+	eotsdb, err := eotsCfg.DatabaseConfig.GetDbBackend()
+	require.NoError(t, err)
+	em, err := eotsmanager.NewLocalEOTSManager(eotsHomeDir, eotsCfg.KeyringBackend, eotsdb, logger)
+	require.NoError(t, err)
+
+	chainCfg := simchain.DefaultConfig()
+	chainCfg.MinPubRand = 10
+	sc := simchain.NewSimulatedChain(chainCfg)
+
+	fpHomeDir := filepath.Join(t.TempDir(), "fp-home")
+	fpCfg := config.DefaultConfigWithHome(fpHomeDir)
+	fpCfg.NumPubRand = 20
+	fpCfg.RandomnessCommitInterval = 10 * time.Millisecond
+	fpCfg.SubmissionRetryInterval = 10 * time.Millisecond
+	fpCfg.FastSyncInterval = 0
+	fpCfg.PollerConfig.PollInterval = 10 * time.Millisecond
+	fpCfg.PollerConfig.AutoChainScanningMode = false
+	fpCfg.PollerConfig.StaticChainScanningStartHeight = 1
+	fpCfg.PollerConfig.SubscriptionMode = true
+	fpdb, err := fpCfg.DatabaseConfig.GetDbBackend()
+	require.NoError(t, err)
+	app, err := service.NewFinalityProviderApp(&fpCfg, sc, em, fpdb, logger)
+	require.NoError(t, err)
+	require.NoError(t, app.Start())
+	t.Cleanup(func() {
+		require.NoError(t, app.Stop())
+		require.NoError(t, fpdb.Close())
+		require.NoError(t, eotsdb.Close())
+	})
+
+	fp := testutil.GenStoredFinalityProvider(r, t, app, passphrase, hdPath)
+	_, err = app.RegisterFinalityProvider(fp.GetBIP340BTCPK().MarshalHex())
+	require.NoError(t, err)
+	// the first block has no voting power and is skipped by the finality
+	// rules, as the finality provider only votes from the next height
+	sc.ProduceBlocks(1)
+	require.NoError(t, sc.SetVotingPower(fp.BtcPk, 100))
+	require.NoError(t, app.StartHandlingFinalityProvider(fp.GetBIP340BTCPK(), passphrase))
+
+	// the finality provider votes for the blocks produced after it commits
+	// its public randomness
+	require.Eventually(t, func() bool {
+		commits, err := sc.QueryLastCommittedPublicRand(fp.BtcPk, 1)
+		return err == nil && len(commits) > 0
+	}, 10*time.Second, 10*time.Millisecond)
+	sc.ProduceBlocks(5)
+	require.Eventually(t, func() bool {
+		finalized, err := sc.QueryLatestFinalizedBlocks(1)
+		return err == nil && len(finalized) == 1 && finalized[0].Height == 6
+	}, 10*time.Second, 10*time.Millisecond)
+
+	return sc, app, fp
+}
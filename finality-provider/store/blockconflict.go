@@ -0,0 +1,93 @@
+package store
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+var (
+	// mapping: pk || height || detection time -> BlockHashConflict
+	blockHashConflictBucketName = []byte("block_hash_conflicts")
+)
+
+// BlockHashConflict records a height at which a finality provider saw two
+// different blocks of the consumer chain
+type BlockHashConflict struct {
+	FpBtcPkHex string `json:"fp_btc_pk_hex"`
+	Height     uint64 `json:"height"`
+	// FirstHash is the hash seen first at the height, and FirstSource where
+	// it was seen
+	FirstHash   []byte `json:"first_hash"`
+	FirstSource string `json:"first_source"`
+	// Voted is whether the finality provider voted for the first hash
+	Voted        bool      `json:"voted"`
+	SecondHash   []byte    `json:"second_hash"`
+	SecondSource string    `json:"second_source"`
+	DetectedAt   time.Time `json:"detected_at"`
+}
+
+// AddBlockHashConflict persists the conflict observed by a finality provider
+func (s *FinalityProviderStore) AddBlockHashConflict(btcPk *btcec.PublicKey, conflict *BlockHashConflict) error {
+	conflictBytes, err := json.Marshal(conflict)
+	if err != nil {
+		return err
+	}
+
+	key := append(schnorr.SerializePubKey(btcPk), sdk.Uint64ToBigEndian(conflict.Height)...)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(conflict.DetectedAt.UnixNano()))...)
+
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(blockHashConflictBucketName)
+		if bucket == nil {
+			return ErrCorruptedFinalityProviderDb
+		}
+
+		return bucket.Put(key, conflictBytes)
+	})
+}
+
+// ListBlockHashConflicts returns the conflicts observed by the finality
+// provider in the ascending order of height, or by all the finality providers
+// if btcPk is nil
+func (s *FinalityProviderStore) ListBlockHashConflicts(btcPk *btcec.PublicKey) ([]*BlockHashConflict, error) {
+	var prefix []byte
+	if btcPk != nil {
+		prefix = schnorr.SerializePubKey(btcPk)
+	}
+
+	var conflicts []*BlockHashConflict
+	err := s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(blockHashConflictBucketName)
+		if bucket == nil {
+			return ErrCorruptedFinalityProviderDb
+		}
+
+		c := bucket.ReadCursor()
+		k, v := c.First()
+		if prefix != nil {
+			k, v = c.Seek(prefix)
+		}
+		for ; k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			var conflict BlockHashConflict
+			if err := json.Unmarshal(v, &conflict); err != nil {
+				return ErrCorruptedFinalityProviderDb
+			}
+			conflicts = append(conflicts, &conflict)
+		}
+
+		return nil
+	}, func() {
+		conflicts = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return conflicts, nil
+}
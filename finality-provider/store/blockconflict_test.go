@@ -0,0 +1,75 @@
+package store_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/finality-provider/finality-provider/config"
+	fpstore "github.com/babylonchain/finality-provider/finality-provider/store"
+	"github.com/babylonchain/finality-provider/testutil"
+)
+
+// FuzzBlockHashConflicts tests saving and listing the block hash conflicts
+// of finality providers
+func FuzzBlockHashConflicts(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		cfg := config.DefaultDBConfigWithHomePath(t.TempDir())
+		fpdb, err := cfg.GetDbBackend()
+		require.NoError(t, err)
+		defer func() {
+			err := fpdb.Close()
+			require.NoError(t, err)
+		}()
+		vs, err := fpstore.NewFinalityProviderStore(fpdb)
+		require.NoError(t, err)
+
+		fp1 := testutil.GenRandomFinalityProvider(r, t)
+		fp2 := testutil.GenRandomFinalityProvider(r, t)
+		conflicts, err := vs.ListBlockHashConflicts(nil)
+		require.NoError(t, err)
+		require.Empty(t, conflicts)
+
+		numConflicts := int(r.Int31n(10) + 1)
+		startHeight := datagen.RandomInt(r, 1000)
+		for i := 0; i < numConflicts; i++ {
+			conflict := &fpstore.BlockHashConflict{
+				FpBtcPkHex:   fp1.GetBIP340BTCPK().MarshalHex(),
+				Height:       startHeight + uint64(i),
+				FirstHash:    datagen.GenRandomByteArray(r, 32),
+				FirstSource:  "poll",
+				Voted:        r.Intn(2) == 0,
+				SecondHash:   datagen.GenRandomByteArray(r, 32),
+				SecondSource: "tip",
+				DetectedAt:   time.Now().UTC(),
+			}
+			err := vs.AddBlockHashConflict(fp1.BtcPk, conflict)
+			require.NoError(t, err)
+		}
+		err = vs.AddBlockHashConflict(fp2.BtcPk, &fpstore.BlockHashConflict{
+			FpBtcPkHex: fp2.GetBIP340BTCPK().MarshalHex(),
+			Height:     startHeight,
+			DetectedAt: time.Now().UTC(),
+		})
+		require.NoError(t, err)
+
+		conflicts, err = vs.ListBlockHashConflicts(fp1.BtcPk)
+		require.NoError(t, err)
+		require.Len(t, conflicts, numConflicts)
+		for i, conflict := range conflicts {
+			require.Equal(t, fp1.GetBIP340BTCPK().MarshalHex(), conflict.FpBtcPkHex)
+			require.Equal(t, startHeight+uint64(i), conflict.Height)
+			require.Len(t, conflict.FirstHash, 32)
+		}
+
+		conflicts, err = vs.ListBlockHashConflicts(nil)
+		require.NoError(t, err)
+		require.Len(t, conflicts, numConflicts+1)
+	})
+}
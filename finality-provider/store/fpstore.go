@@ -27,6 +27,9 @@ type FinalityProviderStore struct {
 // NewFinalityProviderStore returns a new store backed by db
 func NewFinalityProviderStore(db kvdb.Backend) (*FinalityProviderStore, error) {
 	store := &FinalityProviderStore{db}
+	if _, err := MigrateDB(db, false); err != nil {
+		return nil, err
+	}
 	if err := store.initBuckets(); err != nil {
 		return nil, err
 	}
@@ -36,7 +39,11 @@ func NewFinalityProviderStore(db kvdb.Backend) (*FinalityProviderStore, error) {
 
 func (s *FinalityProviderStore) initBuckets() error {
 	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
-		_, err := tx.CreateTopLevelBucket(finalityProviderBucketName)
+		if _, err := tx.CreateTopLevelBucket(finalityProviderBucketName); err != nil {
+			return err
+		}
+
+		_, err := tx.CreateTopLevelBucket(blockHashConflictBucketName)
 		return err
 	})
 }
@@ -0,0 +1,23 @@
+package store
+
+import (
+	"github.com/lightningnetwork/lnd/kvdb"
+
+	"github.com/babylonchain/finality-provider/migration"
+)
+
+// migrations upgrade the finality provider databases written by previous versions.
+// New migrations are appended, and released ones are never changed
+var migrations = migration.NewRegistry(
+	migration.Migration{
+		Version:     1,
+		Description: "record the schema version of the unversioned stores",
+		Migrate:     func(kvdb.RwTx) error { return nil },
+	},
+)
+
+// MigrateDB applies the pending migrations to db, or only reports them if
+// dryRun is set
+func MigrateDB(db kvdb.Backend, dryRun bool) (*migration.Result, error) {
+	return migrations.Migrate(db, dryRun)
+}
@@ -1,6 +1,7 @@
 package store
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -20,6 +21,9 @@ type PubRandProofStore struct {
 // NewPubRandProofStore returns a new store backed by db
 func NewPubRandProofStore(db kvdb.Backend) (*PubRandProofStore, error) {
 	store := &PubRandProofStore{db}
+	if _, err := MigrateDB(db, false); err != nil {
+		return nil, err
+	}
 	if err := store.initBuckets(); err != nil {
 		return nil, err
 	}
@@ -133,4 +137,56 @@ func (s *PubRandProofStore) GetPubRandProofList(pubRandList []*btcec.FieldVal) (
 	return proofBytesList, nil
 }
 
+// CopyPubRandProofs copies the public randomness proofs of the database from
+// into the database to, e.g., so that restoring a snapshot does not forget the
+// proofs of the randomness committed since it was taken. The number of proofs
+// which were missing or different in to is returned
+func CopyPubRandProofs(from, to kvdb.Backend) (int, error) {
+	var keys, values [][]byte
+	err := from.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(pubRandProofBucketName)
+		if bucket == nil {
+			return ErrCorruptedPubRandProofDb
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			keys = append(keys, bytes.Clone(k))
+			values = append(values, bytes.Clone(v))
+			return nil
+		})
+	}, func() {
+		keys, values = nil, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	copied := 0
+	err = kvdb.Update(to, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(pubRandProofBucketName)
+		if bucket == nil {
+			return ErrCorruptedPubRandProofDb
+		}
+
+		for i, key := range keys {
+			if bytes.Equal(bucket.Get(key), values[i]) {
+				continue
+			}
+			if err := bucket.Put(key, values[i]); err != nil {
+				return err
+			}
+			copied++
+		}
+
+		return nil
+	}, func() {
+		copied = 0
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return copied, nil
+}
+
 // TODO: delete function?
@@ -0,0 +1,68 @@
+package store
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/lightningnetwork/lnd/kvdb"
+	pm "google.golang.org/protobuf/proto"
+
+	"github.com/babylonchain/finality-provider/finality-provider/proto"
+)
+
+// ValidateDB checks that db holds the buckets of the finality provider and
+// public randomness proof stores and that all their entries are well-formed,
+// e.g., before restoring db from a snapshot
+func ValidateDB(db kvdb.Backend) error {
+	return db.View(func(tx kvdb.RTx) error {
+		fpBucket := tx.ReadBucket(finalityProviderBucketName)
+		if fpBucket == nil {
+			return fmt.Errorf("%w: missing bucket %s", ErrCorruptedFinalityProviderDb, finalityProviderBucketName)
+		}
+		pubRandBucket := tx.ReadBucket(pubRandProofBucketName)
+		if pubRandBucket == nil {
+			return fmt.Errorf("%w: missing bucket %s", ErrCorruptedPubRandProofDb, pubRandProofBucketName)
+		}
+
+		err := fpBucket.ForEach(func(k, v []byte) error {
+			var fp proto.FinalityProvider
+			if err := pm.Unmarshal(v, &fp); err != nil {
+				return fmt.Errorf("invalid finality provider %x: %w", k, err)
+			}
+			if !bytes.Equal(fp.BtcPk, k) {
+				return fmt.Errorf("finality provider %x stored under the key %x", fp.BtcPk, k)
+			}
+			if fp.Pop == nil {
+				return fmt.Errorf("finality provider %x without proof of possession", k)
+			}
+			if _, err := protoFpToStoredFinalityProvider(&fp); err != nil {
+				return fmt.Errorf("invalid finality provider %x: %w", k, err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrCorruptedFinalityProviderDb, err)
+		}
+
+		err = pubRandBucket.ForEach(func(k, v []byte) error {
+			if len(k) != 32 {
+				return fmt.Errorf("invalid public randomness length %d", len(k))
+			}
+			var proof cmtcrypto.Proof
+			if err := proof.Unmarshal(v); err != nil {
+				return fmt.Errorf("invalid proof of the public randomness %x: %w", k, err)
+			}
+			if _, err := merkle.ProofFromProto(&proof); err != nil {
+				return fmt.Errorf("invalid proof of the public randomness %x: %w", k, err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrCorruptedPubRandProofDb, err)
+		}
+
+		return nil
+	}, func() {})
+}
@@ -0,0 +1,98 @@
+package store_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/finality-provider/finality-provider/config"
+	fpstore "github.com/babylonchain/finality-provider/finality-provider/store"
+	"github.com/babylonchain/finality-provider/testutil"
+	"github.com/babylonchain/finality-provider/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FuzzValidateDB tests that a database written by the stores is valid and
+// that malformed entries are detected
+func FuzzValidateDB(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		cfg := config.DefaultDBConfigWithHomePath(t.TempDir())
+		fpdb, err := cfg.GetDbBackend()
+		require.NoError(t, err)
+		defer fpdb.Close()
+
+		// the buckets are not created yet
+		require.ErrorIs(t, fpstore.ValidateDB(fpdb), fpstore.ErrCorruptedFinalityProviderDb)
+
+		vs, err := fpstore.NewFinalityProviderStore(fpdb)
+		require.NoError(t, err)
+		_, err = fpstore.NewPubRandProofStore(fpdb)
+		require.NoError(t, err)
+
+		fp := testutil.GenRandomFinalityProvider(r, t)
+		fpAddr, err := sdk.AccAddressFromBech32(fp.FPAddr)
+		require.NoError(t, err)
+		err = vs.CreateFinalityProvider(fpAddr, fp.BtcPk, fp.Description, fp.Commission, fp.KeyName, fp.ChainID, fp.Pop.BtcSig)
+		require.NoError(t, err)
+		require.NoError(t, fpstore.ValidateDB(fpdb))
+
+		// store an entry which is not a finality provider
+		err = kvdb.Batch(fpdb, func(tx kvdb.RwTx) error {
+			return tx.ReadWriteBucket([]byte("finalityProviders")).Put(testutil.GenRandomByteArray(r, 33), []byte{0xff})
+		})
+		require.NoError(t, err)
+		require.ErrorIs(t, fpstore.ValidateDB(fpdb), fpstore.ErrCorruptedFinalityProviderDb)
+	})
+}
+
+// FuzzCopyPubRandProofs tests that the public randomness proofs of a database
+// are copied into another, e.g., a restored snapshot
+func FuzzCopyPubRandProofs(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		fromBackend, err := config.DefaultDBConfigWithHomePath(t.TempDir()).GetDbBackend()
+		require.NoError(t, err)
+		defer fromBackend.Close()
+		toBackend, err := config.DefaultDBConfigWithHomePath(t.TempDir()).GetDbBackend()
+		require.NoError(t, err)
+		defer toBackend.Close()
+
+		from, err := fpstore.NewPubRandProofStore(fromBackend)
+		require.NoError(t, err)
+		to, err := fpstore.NewPubRandProofStore(toBackend)
+		require.NoError(t, err)
+
+		// the destination only has the proofs of the first commit
+		numPubRand := uint64(r.Intn(10) + 2)
+		pubRandList := make([]*btcec.FieldVal, 0, numPubRand)
+		for i := uint64(0); i < numPubRand; i++ {
+			pubRand := new(btcec.FieldVal)
+			pubRand.SetByteSlice(testutil.GenRandomByteArray(r, 32))
+			pubRandList = append(pubRandList, pubRand)
+		}
+		_, proofList := types.GetPubRandCommitAndProofs(pubRandList)
+		split := r.Intn(int(numPubRand)-1) + 1
+		require.NoError(t, from.AddPubRandProofList(pubRandList, proofList))
+		require.NoError(t, to.AddPubRandProofList(pubRandList[:split], proofList[:split]))
+
+		copied, err := fpstore.CopyPubRandProofs(fromBackend, toBackend)
+		require.NoError(t, err)
+		require.Equal(t, int(numPubRand)-split, copied)
+		proofs, err := to.GetPubRandProofList(pubRandList)
+		require.NoError(t, err)
+		require.Len(t, proofs, int(numPubRand))
+
+		// copying again changes nothing
+		copied, err = fpstore.CopyPubRandProofs(fromBackend, toBackend)
+		require.NoError(t, err)
+		require.Zero(t, copied)
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/stretchr/testify/require"
 
+	eotstypes "github.com/babylonchain/finality-provider/eotsmanager/types"
 	"github.com/babylonchain/finality-provider/finality-provider/proto"
 	"github.com/babylonchain/finality-provider/finality-provider/service"
 	"github.com/babylonchain/finality-provider/types"
@@ -56,8 +57,9 @@ func TestFinalityProviderLifeCycle(t *testing.T) {
 }
 
 // TestDoubleSigning tests the attack scenario where the finality-provider
-// sends a finality vote over a conflicting block
-// in this case, the BTC private key should be extracted by Babylon
+// is asked to send a finality vote over a conflicting block
+// in this case, the EOTS manager should refuse to sign the conflicting block
+// so that the BTC private key cannot be extracted by Babylon
 func TestDoubleSigning(t *testing.T) {
 	tm, fpInsList := StartManagerWithFinalityProvider(t, 1)
 	defer tm.Stop(t)
@@ -89,30 +91,24 @@ func TestDoubleSigning(t *testing.T) {
 	finalizedBlocks := tm.WaitForNFinalizedBlocks(t, 1)
 
 	// attack: manually submit a finality vote over a conflicting block
-	// to trigger the extraction of finality-provider's private key
+	// which would lead to the extraction of finality-provider's private key
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 	b := &types.BlockInfo{
 		Height: finalizedBlocks[0].Height,
 		Hash:   datagen.GenRandomByteArray(r, 32),
 	}
 	_, extractedKey, err := fpIns.TestSubmitFinalitySignatureAndExtractPrivKey(b)
-	require.NoError(t, err)
-	require.NotNil(t, extractedKey)
-	localKey := tm.GetFpPrivKey(t, fpIns.GetBtcPkBIP340().MustMarshal())
-	require.True(t, localKey.Key.Equals(&extractedKey.Key) || localKey.Key.Negate().Equals(&extractedKey.Key))
-
-	t.Logf("the equivocation attack is successful")
+	require.ErrorIs(t, err, eotstypes.ErrDoubleSign)
+	require.Nil(t, extractedKey)
 
-	tm.WaitForFpShutDown(t, fpIns.GetBtcPkBIP340())
+	t.Logf("the equivocation attack is refused")
 
-	// try to start all the finality providers and the slashed one should not be restarted
-	err = tm.Fpa.StartHandlingAll()
-	require.NoError(t, err)
+	// the finality provider is not slashed and keeps running
 	fps, err := tm.Fpa.ListAllFinalityProvidersInfo()
 	require.NoError(t, err)
 	require.Equal(t, 1, len(fps))
-	require.Equal(t, proto.FinalityProviderStatus_name[4], fps[0].Status)
-	require.Equal(t, false, fps[0].IsRunning)
+	require.Equal(t, proto.FinalityProviderStatus_name[2], fps[0].Status)
+	require.Equal(t, true, fps[0].IsRunning)
 }
 
 // TestMultipleFinalityProviders tests starting with multiple finality providers
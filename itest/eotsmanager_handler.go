@@ -28,7 +28,8 @@ func NewEOTSServerHandler(t *testing.T, cfg *config.Config, eotsHomeDir string)
 	eotsManager, err := eotsmanager.NewLocalEOTSManager(eotsHomeDir, cfg.KeyringBackend, dbBackend, logger)
 	require.NoError(t, err)
 
-	eotsServer := service.NewEOTSManagerServer(cfg, logger, eotsManager, dbBackend, shutdownInterceptor)
+	eotsServer, err := service.NewEOTSManagerServer(cfg, logger, eotsManager, dbBackend, shutdownInterceptor)
+	require.NoError(t, err)
 
 	return &EOTSServerHandler{
 		t:           t,
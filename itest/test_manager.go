@@ -35,6 +35,7 @@ import (
 	fpcfg "github.com/babylonchain/finality-provider/finality-provider/config"
 	"github.com/babylonchain/finality-provider/finality-provider/service"
 	"github.com/babylonchain/finality-provider/types"
+	"github.com/babylonchain/finality-provider/util"
 )
 
 var (
@@ -104,8 +105,14 @@ func StartManager(t *testing.T) *TestManager {
 	eotsHomeDir := filepath.Join(testDir, "eots-home")
 	eotsCfg := eotsconfig.DefaultConfigWithHomePath(eotsHomeDir)
 	eh := NewEOTSServerHandler(t, eotsCfg, eotsHomeDir)
+	// generate the TLS certificate before starting the server so that it can be pinned
+	err = util.GenerateSelfSignedCert(eotsCfg.TLS.CertPath, eotsCfg.TLS.KeyPath, nil)
+	require.NoError(t, err)
 	eh.Start()
-	eotsCli, err := client.NewEOTSManagerGRpcClient(cfg.EOTSManagerAddress)
+	cfg.EOTSManagerTLS.CertPath = eotsCfg.TLS.CertPath
+	eotsTLSCfg, err := cfg.EOTSManagerTLS.ToTLSConfig()
+	require.NoError(t, err)
+	eotsCli, err := client.NewEOTSManagerGRpcClient(cfg.EOTSManagerAddress, eotsTLSCfg, cfg.EOTSManagerAuthToken)
 	require.NoError(t, err)
 
 	// 4. prepare finality-provider
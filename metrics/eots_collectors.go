@@ -13,6 +13,7 @@ type EotsMetrics struct {
 	EotsFpTotalEotsSignCounter            *prometheus.CounterVec
 	EotsFpLastEotsSignHeight              *prometheus.GaugeVec
 	EotsFpTotalSchnorrSignCounter         *prometheus.CounterVec
+	EotsFpPolicyViolationsCounter         *prometheus.CounterVec
 }
 
 var eotsMetricsRegisterOnce sync.Once
@@ -61,6 +62,13 @@ func NewEotsMetrics() *EotsMetrics {
 				},
 				[]string{"fp_btc_pk_hex"},
 			),
+			EotsFpPolicyViolationsCounter: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Name: "eots_fp_policy_violations_counter",
+					Help: "Total number of calls rejected by the signing policy",
+				},
+				[]string{"fp_btc_pk_hex", "rule"},
+			),
 		}
 
 		// Register the EOTS metrics with Prometheus
@@ -70,6 +78,7 @@ func NewEotsMetrics() *EotsMetrics {
 		prometheus.MustRegister(eotsMetricsInstance.EotsFpTotalEotsSignCounter)
 		prometheus.MustRegister(eotsMetricsInstance.EotsFpLastEotsSignHeight)
 		prometheus.MustRegister(eotsMetricsInstance.EotsFpTotalSchnorrSignCounter)
+		prometheus.MustRegister(eotsMetricsInstance.EotsFpPolicyViolationsCounter)
 	})
 
 	return eotsMetricsInstance
@@ -104,3 +113,9 @@ func (em *EotsMetrics) SetEotsFpLastEotsSignHeight(fpBtcPkHex string, height flo
 func (em *EotsMetrics) IncrementEotsFpTotalSchnorrSignCounter(fpBtcPkHex string) {
 	em.EotsFpTotalSchnorrSignCounter.WithLabelValues(fpBtcPkHex).Inc()
 }
+
+// IncrementEotsFpPolicyViolationsCounter increments the counter of the calls
+// rejected by the given rule of the signing policy
+func (em *EotsMetrics) IncrementEotsFpPolicyViolationsCounter(fpBtcPkHex, rule string) {
+	em.EotsFpPolicyViolationsCounter.WithLabelValues(fpBtcPkHex, rule).Inc()
+}
@@ -17,6 +17,12 @@ type FpMetrics struct {
 	babylonTipHeight     prometheus.Gauge
 	lastPolledHeight     prometheus.Gauge
 	pollerStartingHeight prometheus.Gauge
+	// consumer chain endpoint metrics
+	chainEndpointActive     *prometheus.GaugeVec
+	chainEndpointLatency    *prometheus.GaugeVec
+	chainEndpointErrorRate  *prometheus.GaugeVec
+	chainEndpointTipHeight  *prometheus.GaugeVec
+	chainBlockDisagreements prometheus.Counter
 	// single finality provider metrics
 	fpStatus                        *prometheus.GaugeVec
 	fpSecondsSinceLastVote          *prometheus.GaugeVec
@@ -29,6 +35,8 @@ type FpMetrics struct {
 	fpTotalCommittedRandomness      *prometheus.GaugeVec
 	fpTotalFailedVotes              *prometheus.CounterVec
 	fpTotalFailedRandomness         *prometheus.CounterVec
+	fpTotalBlockHashConflicts       *prometheus.CounterVec
+	fpVotingHalted                  *prometheus.GaugeVec
 	// time keeper
 	mu                     sync.Mutex
 	previousVoteByFp       map[string]*time.Time
@@ -65,6 +73,26 @@ func NewFpMetrics() *FpMetrics {
 				Name: "poller_starting_height",
 				Help: "The initial block height when the poller started operation",
 			}),
+			chainEndpointActive: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+				Name: "chain_endpoint_active",
+				Help: "Whether the requests to the consumer chain are routed to an endpoint",
+			}, []string{"endpoint"}),
+			chainEndpointLatency: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+				Name: "chain_endpoint_latency_seconds",
+				Help: "The moving average of the latency of the queries to an endpoint of the consumer chain",
+			}, []string{"endpoint"}),
+			chainEndpointErrorRate: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+				Name: "chain_endpoint_error_rate",
+				Help: "The moving average of the ratio of failed requests to an endpoint of the consumer chain",
+			}, []string{"endpoint"}),
+			chainEndpointTipHeight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+				Name: "chain_endpoint_tip_height",
+				Help: "The height of the latest block of an endpoint of the consumer chain",
+			}, []string{"endpoint"}),
+			chainBlockDisagreements: prometheus.NewCounter(prometheus.CounterOpts{
+				Name: "chain_total_block_disagreements",
+				Help: "The total number of times the endpoints of the consumer chain returned different blocks at a height",
+			}),
 			fpSecondsSinceLastVote: prometheus.NewGaugeVec(
 				prometheus.GaugeOpts{
 					Name: "fp_seconds_since_last_vote",
@@ -135,6 +163,20 @@ func NewFpMetrics() *FpMetrics {
 				},
 				[]string{"fp_btc_pk_hex"},
 			),
+			fpTotalBlockHashConflicts: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Name: "fp_total_block_hash_conflicts",
+					Help: "The total number of heights at which a finality provider saw two different block hashes.",
+				},
+				[]string{"fp_btc_pk_hex"},
+			),
+			fpVotingHalted: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Name: "fp_voting_halted",
+					Help: "Whether a finality provider stopped voting after seeing two different block hashes at a height.",
+				},
+				[]string{"fp_btc_pk_hex"},
+			),
 			mu: sync.Mutex{},
 		}
 
@@ -144,6 +186,11 @@ func NewFpMetrics() *FpMetrics {
 		prometheus.MustRegister(fpMetricsInstance.babylonTipHeight)
 		prometheus.MustRegister(fpMetricsInstance.lastPolledHeight)
 		prometheus.MustRegister(fpMetricsInstance.pollerStartingHeight)
+		prometheus.MustRegister(fpMetricsInstance.chainEndpointActive)
+		prometheus.MustRegister(fpMetricsInstance.chainEndpointLatency)
+		prometheus.MustRegister(fpMetricsInstance.chainEndpointErrorRate)
+		prometheus.MustRegister(fpMetricsInstance.chainEndpointTipHeight)
+		prometheus.MustRegister(fpMetricsInstance.chainBlockDisagreements)
 		prometheus.MustRegister(fpMetricsInstance.fpSecondsSinceLastVote)
 		prometheus.MustRegister(fpMetricsInstance.fpSecondsSinceLastRandomness)
 		prometheus.MustRegister(fpMetricsInstance.fpLastVotedHeight)
@@ -154,6 +201,8 @@ func NewFpMetrics() *FpMetrics {
 		prometheus.MustRegister(fpMetricsInstance.fpLastCommittedRandomnessHeight)
 		prometheus.MustRegister(fpMetricsInstance.fpTotalFailedVotes)
 		prometheus.MustRegister(fpMetricsInstance.fpTotalFailedRandomness)
+		prometheus.MustRegister(fpMetricsInstance.fpTotalBlockHashConflicts)
+		prometheus.MustRegister(fpMetricsInstance.fpVotingHalted)
 	})
 	return fpMetricsInstance
 }
@@ -188,6 +237,23 @@ func (fm *FpMetrics) RecordPollerStartingHeight(height uint64) {
 	fm.pollerStartingHeight.Set(float64(height))
 }
 
+// RecordChainEndpointHealth records the health of an endpoint of the consumer chain
+func (fm *FpMetrics) RecordChainEndpointHealth(endpoint string, active bool, latency time.Duration, errorRate float64, tipHeight uint64) {
+	var activeValue float64
+	if active {
+		activeValue = 1
+	}
+	fm.chainEndpointActive.WithLabelValues(endpoint).Set(activeValue)
+	fm.chainEndpointLatency.WithLabelValues(endpoint).Set(latency.Seconds())
+	fm.chainEndpointErrorRate.WithLabelValues(endpoint).Set(errorRate)
+	fm.chainEndpointTipHeight.WithLabelValues(endpoint).Set(float64(tipHeight))
+}
+
+// IncrementChainBlockDisagreements increments the total number of block disagreements between the endpoints of the consumer chain
+func (fm *FpMetrics) IncrementChainBlockDisagreements() {
+	fm.chainBlockDisagreements.Inc()
+}
+
 // RecordFpSecondsSinceLastVote records the seconds since the last finality sig vote by a finality provider
 func (fm *FpMetrics) RecordFpSecondsSinceLastVote(fpBtcPkHex string, seconds float64) {
 	fm.fpSecondsSinceLastVote.WithLabelValues(fpBtcPkHex).Set(seconds)
@@ -243,6 +309,20 @@ func (fm *FpMetrics) IncrementFpTotalFailedRandomness(fpBtcPkHex string) {
 	fm.fpTotalFailedRandomness.WithLabelValues(fpBtcPkHex).Inc()
 }
 
+// IncrementFpTotalBlockHashConflicts increments the total number of block hash conflicts seen by a finality provider
+func (fm *FpMetrics) IncrementFpTotalBlockHashConflicts(fpBtcPkHex string) {
+	fm.fpTotalBlockHashConflicts.WithLabelValues(fpBtcPkHex).Inc()
+}
+
+// RecordFpVotingHalted records whether a finality provider halted voting
+func (fm *FpMetrics) RecordFpVotingHalted(fpBtcPkHex string, halted bool) {
+	var v float64
+	if halted {
+		v = 1
+	}
+	fm.fpVotingHalted.WithLabelValues(fpBtcPkHex).Set(v)
+}
+
 // RecordFpVoteTime records the time of a finality sig vote by a finality provider
 func (fm *FpMetrics) RecordFpVoteTime(fpBtcPkHex string) {
 	fm.mu.Lock()
@@ -0,0 +1,108 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// GrpcMetrics are the metrics of the gRPC calls served and made by a daemon,
+// labeled by the service, the method and the status code of the calls
+type GrpcMetrics struct {
+	ServerHandledCounter  *prometheus.CounterVec
+	ServerHandlingSeconds *prometheus.HistogramVec
+	ClientHandledCounter  *prometheus.CounterVec
+	ClientHandlingSeconds *prometheus.HistogramVec
+}
+
+var grpcMetricsRegisterOnce sync.Once
+
+var grpcMetricsInstance *GrpcMetrics
+
+func NewGrpcMetrics() *GrpcMetrics {
+	grpcMetricsRegisterOnce.Do(func() {
+		grpcMetricsInstance = &GrpcMetrics{
+			ServerHandledCounter: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Name: "grpc_server_handled_total",
+					Help: "Total number of RPCs completed on the server, regardless of success or failure",
+				},
+				[]string{"grpc_service", "grpc_method", "grpc_code"},
+			),
+			ServerHandlingSeconds: prometheus.NewHistogramVec(
+				prometheus.HistogramOpts{
+					Name:    "grpc_server_handling_seconds",
+					Help:    "Latency of the RPCs handled by the server",
+					Buckets: prometheus.DefBuckets,
+				},
+				[]string{"grpc_service", "grpc_method"},
+			),
+			ClientHandledCounter: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Name: "grpc_client_handled_total",
+					Help: "Total number of RPCs completed by the client, regardless of success or failure",
+				},
+				[]string{"grpc_service", "grpc_method", "grpc_code"},
+			),
+			ClientHandlingSeconds: prometheus.NewHistogramVec(
+				prometheus.HistogramOpts{
+					Name:    "grpc_client_handling_seconds",
+					Help:    "Latency of the RPCs made by the client until the response is received",
+					Buckets: prometheus.DefBuckets,
+				},
+				[]string{"grpc_service", "grpc_method"},
+			),
+		}
+
+		// Register the gRPC metrics with Prometheus
+		prometheus.MustRegister(grpcMetricsInstance.ServerHandledCounter)
+		prometheus.MustRegister(grpcMetricsInstance.ServerHandlingSeconds)
+		prometheus.MustRegister(grpcMetricsInstance.ClientHandledCounter)
+		prometheus.MustRegister(grpcMetricsInstance.ClientHandlingSeconds)
+	})
+
+	return grpcMetricsInstance
+}
+
+// UnaryServerInterceptor records the status code and the latency of every
+// unary call served
+func (gm *GrpcMetrics) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+
+	service, method := splitFullMethod(info.FullMethod)
+	gm.ServerHandlingSeconds.WithLabelValues(service, method).Observe(time.Since(start).Seconds())
+	gm.ServerHandledCounter.WithLabelValues(service, method, status.Code(err).String()).Inc()
+
+	return resp, err
+}
+
+// UnaryClientInterceptor records the status code and the latency of every
+// unary call made, as seen by the client
+func (gm *GrpcMetrics) UnaryClientInterceptor(ctx context.Context, fullMethod string, req, reply interface{},
+	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	start := time.Now()
+	err := invoker(ctx, fullMethod, req, reply, cc, opts...)
+
+	service, method := splitFullMethod(fullMethod)
+	gm.ClientHandlingSeconds.WithLabelValues(service, method).Observe(time.Since(start).Seconds())
+	gm.ClientHandledCounter.WithLabelValues(service, method, status.Code(err).String()).Inc()
+
+	return err
+}
+
+// splitFullMethod splits a full method name of the form /service/method
+func splitFullMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+
+	return "unknown", fullMethod
+}
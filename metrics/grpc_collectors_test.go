@@ -0,0 +1,47 @@
+package metrics_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/babylonchain/finality-provider/metrics"
+)
+
+// TestGrpcInterceptors tests that the interceptors count the calls by method
+// and status code and observe their latency
+func TestGrpcInterceptors(t *testing.T) {
+	gm := metrics.NewGrpcMetrics()
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/proto.EOTSManager/SignEOTS"}
+	okHandler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	failHandler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.AlreadyExists, "double sign")
+	}
+
+	resp, err := gm.UnaryServerInterceptor(context.Background(), nil, info, okHandler)
+	require.NoError(t, err)
+	require.Equal(t, "ok", resp)
+	_, err = gm.UnaryServerInterceptor(context.Background(), nil, info, failHandler)
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	require.Equal(t, float64(1), testutil.ToFloat64(
+		gm.ServerHandledCounter.WithLabelValues("proto.EOTSManager", "SignEOTS", codes.OK.String())))
+	require.Equal(t, float64(1), testutil.ToFloat64(
+		gm.ServerHandledCounter.WithLabelValues("proto.EOTSManager", "SignEOTS", codes.AlreadyExists.String())))
+	require.Equal(t, 1, testutil.CollectAndCount(gm.ServerHandlingSeconds, "grpc_server_handling_seconds"))
+
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		return status.Error(codes.Unavailable, "standby")
+	}
+	err = gm.UnaryClientInterceptor(context.Background(), "/proto.EOTSManager/SignEOTSBatch", nil, nil, nil, invoker)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.Equal(t, float64(1), testutil.ToFloat64(
+		gm.ClientHandledCounter.WithLabelValues("proto.EOTSManager", "SignEOTSBatch", codes.Unavailable.String())))
+	require.Equal(t, 1, testutil.CollectAndCount(gm.ClientHandlingSeconds, "grpc_client_handling_seconds"))
+}
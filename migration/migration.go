@@ -0,0 +1,195 @@
+// Package migration versions the schema of the kvdb stores of a daemon and
+// upgrades the databases written by previous versions when they are opened.
+package migration
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+var (
+	// metaBucketName is the bucket holding the metadata of the database
+	metaBucketName = []byte("meta")
+	// schemaVersionKey maps to the big-endian uint32 schema version
+	schemaVersionKey = []byte("schemaVersion")
+)
+
+var (
+	// ErrNewerSchema The database was written by a newer version of the daemon
+	ErrNewerSchema = errors.New("the database schema is newer than the supported one")
+
+	// errDryRun rolls back the transaction of a dry run
+	errDryRun = errors.New("dry run")
+)
+
+// Migration upgrades the database from the previous schema version to Version
+type Migration struct {
+	Version     uint32
+	Description string
+	Migrate     func(tx kvdb.RwTx) error
+}
+
+// Result describes the migrations applied to a database, or the ones that
+// would be applied in a dry run
+type Result struct {
+	FromVersion uint32
+	ToVersion   uint32
+	Applied     []Migration
+	DryRun      bool
+}
+
+// Registry holds the migrations of the stores sharing a database, in order
+type Registry struct {
+	migrations []Migration
+}
+
+// NewRegistry creates a registry of the given migrations. It panics if their
+// versions are not 1, 2, 3, ... in order, as migrations are never reordered
+// once released
+func NewRegistry(migrations ...Migration) *Registry {
+	for i, m := range migrations {
+		if m.Version != uint32(i+1) {
+			panic(fmt.Sprintf("migration %d has version %d", i+1, m.Version))
+		}
+		if m.Migrate == nil {
+			panic(fmt.Sprintf("migration %d has no migrate function", m.Version))
+		}
+	}
+
+	return &Registry{migrations: migrations}
+}
+
+// LatestVersion returns the schema version written by this version of the
+// daemon
+func (r *Registry) LatestVersion() uint32 {
+	return uint32(len(r.migrations))
+}
+
+// Migrations returns the migrations of the registry in order
+func (r *Registry) Migrations() []Migration {
+	return r.migrations
+}
+
+// Migrate applies the pending migrations to db, each in its own transaction
+// along with the new schema version. A database without a version is at
+// version 0 if it has buckets, and is stamped with the latest version
+// otherwise as it was just created. If dryRun is set, the migrations are
+// applied in a single transaction which is rolled back
+func (r *Registry) Migrate(db kvdb.Backend, dryRun bool) (*Result, error) {
+	version, empty, err := readVersion(db)
+	if err != nil {
+		return nil, err
+	}
+
+	latest := r.LatestVersion()
+	if version > latest {
+		return nil, fmt.Errorf("%w: %d > %d", ErrNewerSchema, version, latest)
+	}
+	if empty {
+		version = latest
+	}
+
+	res := &Result{
+		FromVersion: version,
+		ToVersion:   latest,
+		Applied:     r.migrations[version:],
+		DryRun:      dryRun,
+	}
+
+	if dryRun {
+		err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+			for _, m := range res.Applied {
+				if err := applyMigration(tx, m); err != nil {
+					return err
+				}
+			}
+			return errDryRun
+		}, func() {})
+		if !errors.Is(err, errDryRun) {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	if empty {
+		err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+			return putVersion(tx, latest)
+		}, func() {})
+		if err != nil {
+			return nil, fmt.Errorf("failed to write the schema version: %w", err)
+		}
+		return res, nil
+	}
+
+	for _, m := range res.Applied {
+		err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+			return applyMigration(tx, m)
+		}, func() {})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// Version returns the schema version of db, which is 0 if it has none
+func Version(db kvdb.Backend) (uint32, error) {
+	version, _, err := readVersion(db)
+	return version, err
+}
+
+func applyMigration(tx kvdb.RwTx, m Migration) error {
+	if err := m.Migrate(tx); err != nil {
+		return fmt.Errorf("failed to migrate to the schema version %d (%s): %w", m.Version, m.Description, err)
+	}
+
+	return putVersion(tx, m.Version)
+}
+
+// readVersion returns the schema version of db, and whether db has no
+// buckets at all
+func readVersion(db kvdb.Backend) (uint32, bool, error) {
+	var (
+		version uint32
+		empty   bool
+	)
+	err := db.View(func(tx kvdb.RTx) error {
+		meta := tx.ReadBucket(metaBucketName)
+		if meta == nil {
+			empty = true
+			return tx.ForEachBucket(func(_ []byte) error {
+				empty = false
+				return nil
+			})
+		}
+
+		v := meta.Get(schemaVersionKey)
+		if len(v) != 4 {
+			return fmt.Errorf("invalid schema version of %d bytes", len(v))
+		}
+		version = binary.BigEndian.Uint32(v)
+
+		return nil
+	}, func() {
+		version = 0
+		empty = false
+	})
+
+	return version, empty, err
+}
+
+func putVersion(tx kvdb.RwTx, version uint32) error {
+	meta, err := tx.CreateTopLevelBucket(metaBucketName)
+	if err != nil {
+		return err
+	}
+
+	v := make([]byte, 4)
+	binary.BigEndian.PutUint32(v, version)
+
+	return meta.Put(schemaVersionKey, v)
+}
@@ -0,0 +1,149 @@
+package migration_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/finality-provider/migration"
+)
+
+var (
+	legacyBucketName = []byte("legacy")
+	renamedKey       = []byte("renamed")
+)
+
+func openTestDB(t *testing.T) kvdb.Backend {
+	db, err := kvdb.GetBoltBackend(&kvdb.BoltBackendConfig{
+		DBPath:     t.TempDir(),
+		DBFileName: "test.db",
+		DBTimeout:  kvdb.DefaultDBTimeout,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+// testRegistry returns a registry whose second migration renames the key
+// "old" of the legacy bucket
+func testRegistry() *migration.Registry {
+	return migration.NewRegistry(
+		migration.Migration{
+			Version:     1,
+			Description: "record the schema version",
+			Migrate:     func(kvdb.RwTx) error { return nil },
+		},
+		migration.Migration{
+			Version:     2,
+			Description: "rename the key",
+			Migrate: func(tx kvdb.RwTx) error {
+				bucket := tx.ReadWriteBucket(legacyBucketName)
+				if bucket == nil {
+					return fmt.Errorf("missing bucket")
+				}
+				v := bucket.Get([]byte("old"))
+				if err := bucket.Delete([]byte("old")); err != nil {
+					return err
+				}
+				return bucket.Put(renamedKey, v)
+			},
+		},
+	)
+}
+
+func getValue(t *testing.T, db kvdb.Backend, key []byte) []byte {
+	var v []byte
+	err := db.View(func(tx kvdb.RTx) error {
+		v = tx.ReadBucket(legacyBucketName).Get(key)
+		return nil
+	}, func() { v = nil })
+	require.NoError(t, err)
+
+	return v
+}
+
+func TestMigrateLegacyDB(t *testing.T) {
+	db := openTestDB(t)
+	err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+		bucket, err := tx.CreateTopLevelBucket(legacyBucketName)
+		if err != nil {
+			return err
+		}
+		return bucket.Put([]byte("old"), []byte("value"))
+	}, func() {})
+	require.NoError(t, err)
+
+	r := testRegistry()
+
+	// a dry run reports the migrations without applying them
+	res, err := r.Migrate(db, true)
+	require.NoError(t, err)
+	require.True(t, res.DryRun)
+	require.Equal(t, uint32(0), res.FromVersion)
+	require.Equal(t, uint32(2), res.ToVersion)
+	require.Len(t, res.Applied, 2)
+	version, err := migration.Version(db)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), version)
+	require.Equal(t, []byte("value"), getValue(t, db, []byte("old")))
+
+	res, err = r.Migrate(db, false)
+	require.NoError(t, err)
+	require.Len(t, res.Applied, 2)
+	version, err = migration.Version(db)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), version)
+	require.Nil(t, getValue(t, db, []byte("old")))
+	require.Equal(t, []byte("value"), getValue(t, db, renamedKey))
+
+	// migrating again is a no-op
+	res, err = r.Migrate(db, false)
+	require.NoError(t, err)
+	require.Empty(t, res.Applied)
+}
+
+func TestMigrateNewDB(t *testing.T) {
+	db := openTestDB(t)
+
+	// a new database is at the latest version without migrating
+	res, err := testRegistry().Migrate(db, false)
+	require.NoError(t, err)
+	require.Empty(t, res.Applied)
+	version, err := migration.Version(db)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), version)
+
+	// an older daemon refuses the database
+	_, err = migration.NewRegistry(testRegistry().Migrations()[:1]...).Migrate(db, false)
+	require.ErrorIs(t, err, migration.ErrNewerSchema)
+}
+
+func TestMigrateFailure(t *testing.T) {
+	db := openTestDB(t)
+	err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+		_, err := tx.CreateTopLevelBucket([]byte("other"))
+		return err
+	}, func() {})
+	require.NoError(t, err)
+
+	// the second migration fails as the legacy bucket is missing, and only
+	// the first one is applied
+	_, err = testRegistry().Migrate(db, false)
+	require.ErrorContains(t, err, "missing bucket")
+	version, err := migration.Version(db)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), version)
+}
+
+func TestNewRegistryOrder(t *testing.T) {
+	noop := func(kvdb.RwTx) error { return nil }
+	require.Panics(t, func() {
+		migration.NewRegistry(
+			migration.Migration{Version: 2, Migrate: noop},
+			migration.Migration{Version: 1, Migrate: noop},
+		)
+	})
+}
@@ -0,0 +1,154 @@
+package sqlitedb
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+
+	"github.com/babylonchain/finality-provider/dbbackup"
+)
+
+// ErrRecordCountMismatch The converted database does not hold as many
+// records as the source one
+var ErrRecordCountMismatch = errors.New("the number of records of the converted database does not match")
+
+// Convert copies all the buckets and key-value pairs of src to dst, which
+// should be empty, in a single transaction of dst. It then counts the records
+// of each top-level bucket in both databases, and returns the counts if they
+// match. Bucket sequences are not copied as the stores do not use them
+func Convert(src, dst kvdb.Backend) (map[string]uint64, error) {
+	dstCounts, err := CountRecords(dst)
+	if err != nil {
+		return nil, err
+	}
+	if len(dstCounts) != 0 {
+		return nil, fmt.Errorf("the destination database is not empty")
+	}
+
+	err = src.View(func(srcTx kvdb.RTx) error {
+		return kvdb.Update(dst, func(dstTx kvdb.RwTx) error {
+			return srcTx.ForEachBucket(func(name []byte) error {
+				dstBucket, err := dstTx.CreateTopLevelBucket(name)
+				if err != nil {
+					return fmt.Errorf("failed to create the bucket %s: %w", name, err)
+				}
+				return copyBucket(srcTx.ReadBucket(name), dstBucket)
+			})
+		}, func() {})
+	}, func() {})
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy the database: %w", err)
+	}
+
+	srcCounts, err := CountRecords(src)
+	if err != nil {
+		return nil, err
+	}
+	if dstCounts, err = CountRecords(dst); err != nil {
+		return nil, err
+	}
+
+	if len(srcCounts) != len(dstCounts) {
+		return nil, fmt.Errorf("%w: %d buckets instead of %d", ErrRecordCountMismatch, len(dstCounts), len(srcCounts))
+	}
+	for name, n := range srcCounts {
+		if dstCounts[name] != n {
+			return nil, fmt.Errorf("%w: %d records in the bucket %s instead of %d",
+				ErrRecordCountMismatch, dstCounts[name], name, n)
+		}
+	}
+
+	return srcCounts, nil
+}
+
+// CountRecords returns the number of records of each top-level bucket of db,
+// including the nested buckets and their records
+func CountRecords(db kvdb.Backend) (map[string]uint64, error) {
+	counts := make(map[string]uint64)
+	err := db.View(func(tx kvdb.RTx) error {
+		return tx.ForEachBucket(func(name []byte) error {
+			n, err := countBucket(tx.ReadBucket(name))
+			if err != nil {
+				return err
+			}
+			counts[string(name)] = n
+			return nil
+		})
+	}, func() {
+		counts = make(map[string]uint64)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count the records: %w", err)
+	}
+
+	return counts, nil
+}
+
+func copyBucket(src kvdb.RBucket, dst kvdb.RwBucket) error {
+	return src.ForEach(func(k, v []byte) error {
+		// nested buckets have no value
+		if v == nil {
+			nested, err := dst.CreateBucket(k)
+			if err != nil {
+				return fmt.Errorf("failed to create the nested bucket %x: %w", k, err)
+			}
+			return copyBucket(src.NestedReadBucket(k), nested)
+		}
+
+		return dst.Put(k, v)
+	})
+}
+
+func countBucket(b kvdb.RBucket) (uint64, error) {
+	var n uint64
+	err := b.ForEach(func(k, v []byte) error {
+		n++
+		if v != nil {
+			return nil
+		}
+
+		nested, err := countBucket(b.NestedReadBucket(k))
+		n += nested
+		return err
+	})
+
+	return n, err
+}
+
+// ConvertBolt copies the bolt database file boltFileName in boltPath to the
+// new SQLite database defined by cfg, and returns the number of records of
+// each top-level bucket. The SQLite files are removed if the conversion
+// fails so that it can be retried
+func ConvertBolt(boltPath, boltFileName string, cfg *Config) (map[string]uint64, error) {
+	dstFile := filepath.Join(cfg.DBPath, cfg.DBFileName)
+	if _, err := os.Stat(dstFile); err == nil {
+		return nil, fmt.Errorf("the SQLite database %s already exists", dstFile)
+	}
+
+	src, err := dbbackup.OpenExisting(boltPath, boltFileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the bolt database: %w", err)
+	}
+	defer src.Close()
+
+	dst, err := Open(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := Convert(src, dst)
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		for _, suffix := range []string{"", "-wal", "-shm"} {
+			os.Remove(dstFile + suffix)
+		}
+		return nil, err
+	}
+
+	return counts, nil
+}
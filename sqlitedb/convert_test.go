@@ -0,0 +1,118 @@
+package sqlitedb_test
+
+import (
+	"math/rand"
+	"path/filepath"
+	"testing"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/config"
+	"github.com/babylonchain/finality-provider/eotsmanager/store"
+	"github.com/babylonchain/finality-provider/sqlitedb"
+	"github.com/babylonchain/finality-provider/testutil"
+)
+
+func openBoltDB(t *testing.T, dbPath string) kvdb.Backend {
+	db, err := kvdb.GetBoltBackend(&kvdb.BoltBackendConfig{
+		DBPath:     dbPath,
+		DBFileName: "test.db",
+		DBTimeout:  kvdb.DefaultDBTimeout,
+	})
+	require.NoError(t, err)
+
+	return db
+}
+
+// fillDB writes a top-level bucket with n records and a nested bucket with
+// n more records
+func fillDB(t *testing.T, r *rand.Rand, db kvdb.Backend, n int) {
+	err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+		bucket, err := tx.CreateTopLevelBucket([]byte("top"))
+		if err != nil {
+			return err
+		}
+		nested, err := bucket.CreateBucket([]byte("nested"))
+		if err != nil {
+			return err
+		}
+		for i := 0; i < n; i++ {
+			if err := bucket.Put(testutil.GenRandomByteArray(r, 16), testutil.GenRandomByteArray(r, 32)); err != nil {
+				return err
+			}
+			if err := nested.Put(testutil.GenRandomByteArray(r, 16), testutil.GenRandomByteArray(r, 32)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, func() {})
+	require.NoError(t, err)
+}
+
+func FuzzConvert(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		n := r.Intn(50) + 1
+
+		src := openBoltDB(t, t.TempDir())
+		defer src.Close()
+		fillDB(t, r, src, n)
+		dst := openBoltDB(t, t.TempDir())
+		defer dst.Close()
+
+		counts, err := sqlitedb.Convert(src, dst)
+		require.NoError(t, err)
+		// the records of the bucket, the nested bucket and its records
+		require.Equal(t, map[string]uint64{"top": uint64(2*n + 1)}, counts)
+
+		dstCounts, err := sqlitedb.CountRecords(dst)
+		require.NoError(t, err)
+		require.Equal(t, counts, dstCounts)
+
+		// the destination is not empty anymore
+		_, err = sqlitedb.Convert(src, dst)
+		require.Error(t, err)
+	})
+}
+
+func TestConvertBoltToSqlite(t *testing.T) {
+	if !sqlitedb.Available {
+		t.Skip("the binary is built without the kvdb_sqlite tag")
+	}
+	r := rand.New(rand.NewSource(10))
+
+	dbCfg := config.DefaultDBConfigWithHomePath(t.TempDir())
+	boltDB, err := dbCfg.GetDbBackend()
+	require.NoError(t, err)
+	eotsStore, err := store.NewEOTSStore(boltDB)
+	require.NoError(t, err)
+	_, btcPk, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+	require.NoError(t, eotsStore.AddEOTSKeyName(btcPk, "key"))
+	require.NoError(t, boltDB.Close())
+
+	counts, err := sqlitedb.ConvertBolt(dbCfg.DBPath, dbCfg.DBFileName, dbCfg.DBConfigToSqliteConfig())
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), counts["fpKeyNames"])
+	require.FileExists(t, filepath.Join(dbCfg.DBPath, dbCfg.SqliteFileName))
+
+	// the converted database cannot be overwritten
+	_, err = sqlitedb.ConvertBolt(dbCfg.DBPath, dbCfg.DBFileName, dbCfg.DBConfigToSqliteConfig())
+	require.Error(t, err)
+
+	// the stores work on the SQLite database
+	dbCfg.Backend = config.SqliteBackend
+	sqliteDB, err := dbCfg.GetDbBackend()
+	require.NoError(t, err)
+	defer sqliteDB.Close()
+	eotsStore, err = store.NewEOTSStore(sqliteDB)
+	require.NoError(t, err)
+	keyName, err := eotsStore.GetEOTSKeyName(schnorr.SerializePubKey(btcPk))
+	require.NoError(t, err)
+	require.Equal(t, "key", keyName)
+	require.NoError(t, store.ValidateDB(sqliteDB))
+}
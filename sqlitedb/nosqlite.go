@@ -0,0 +1,14 @@
+//go:build !kvdb_sqlite || (windows && (arm || 386)) || (linux && (ppc64 || mips || mipsle || mips64))
+
+package sqlitedb
+
+import "github.com/lightningnetwork/lnd/kvdb"
+
+// Available is true if the binary supports the sqlite backend
+const Available = false
+
+// Open fails with ErrNotAvailable as the binary does not support the sqlite
+// backend
+func Open(_ *Config) (kvdb.Backend, error) {
+	return nil, ErrNotAvailable
+}
@@ -0,0 +1,36 @@
+//go:build kvdb_sqlite && !(windows && (arm || 386)) && !(linux && (ppc64 || mips || mipsle || mips64))
+
+package sqlitedb
+
+import (
+	"context"
+	"os"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/kvdb/sqlbase"
+	"github.com/lightningnetwork/lnd/kvdb/sqlite"
+)
+
+// Available is true if the binary supports the sqlite backend
+const Available = true
+
+// Open opens the SQLite database file defined by cfg, creating it if it does
+// not exist. The connection pool is shared by all the databases of the
+// process, so its size is set by the first call
+func Open(cfg *Config) (kvdb.Backend, error) {
+	if err := os.MkdirAll(cfg.DBPath, 0700); err != nil {
+		return nil, err
+	}
+
+	sqlbase.Init(cfg.MaxConnections)
+
+	return kvdb.Open(
+		kvdb.SqliteBackendName, context.Background(),
+		&sqlite.Config{
+			Timeout:        cfg.Timeout,
+			BusyTimeout:    cfg.BusyTimeout,
+			MaxConnections: cfg.MaxConnections,
+		},
+		cfg.DBPath, cfg.DBFileName, cfg.TablePrefix,
+	)
+}
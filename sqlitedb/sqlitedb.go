@@ -0,0 +1,25 @@
+// Package sqlitedb opens kvdb databases backed by SQLite and converts bolt
+// databases to them. The SQLite driver of kvdb is only compiled in binaries
+// built with the kvdb_sqlite tag.
+package sqlitedb
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrNotAvailable The binary was built without the kvdb_sqlite tag, or the
+// platform is not supported by the SQLite driver
+var ErrNotAvailable = errors.New("the sqlite backend is not available in this binary, which should be built with the kvdb_sqlite tag")
+
+// Config defines an SQLite database file and how it is queried
+type Config struct {
+	DBPath     string
+	DBFileName string
+	// TablePrefix is prepended to the name of the table holding the
+	// key-value pairs
+	TablePrefix    string
+	Timeout        time.Duration
+	BusyTimeout    time.Duration
+	MaxConnections int
+}
@@ -5,10 +5,12 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	math "cosmossdk.io/math"
 	types "github.com/babylonchain/babylon/x/finality/types"
+	clientcontroller "github.com/babylonchain/finality-provider/clientcontroller"
 	types0 "github.com/babylonchain/finality-provider/types"
 	btcec "github.com/btcsuite/btcd/btcec/v2"
 	schnorr "github.com/btcsuite/btcd/btcec/v2/schnorr"
@@ -231,3 +233,78 @@ func (mr *MockClientControllerMockRecorder) SubmitFinalitySig(fpPk, block, pubRa
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitFinalitySig", reflect.TypeOf((*MockClientController)(nil).SubmitFinalitySig), fpPk, block, pubRand, proof, sig)
 }
+
+// MockBlockSubscriber is a mock of BlockSubscriber interface.
+type MockBlockSubscriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlockSubscriberMockRecorder
+}
+
+// MockBlockSubscriberMockRecorder is the mock recorder for MockBlockSubscriber.
+type MockBlockSubscriberMockRecorder struct {
+	mock *MockBlockSubscriber
+}
+
+// NewMockBlockSubscriber creates a new mock instance.
+func NewMockBlockSubscriber(ctrl *gomock.Controller) *MockBlockSubscriber {
+	mock := &MockBlockSubscriber{ctrl: ctrl}
+	mock.recorder = &MockBlockSubscriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlockSubscriber) EXPECT() *MockBlockSubscriberMockRecorder {
+	return m.recorder
+}
+
+// SubscribeNewBlocks mocks base method.
+func (m *MockBlockSubscriber) SubscribeNewBlocks(ctx context.Context) (<-chan *types0.BlockInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeNewBlocks", ctx)
+	ret0, _ := ret[0].(<-chan *types0.BlockInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeNewBlocks indicates an expected call of SubscribeNewBlocks.
+func (mr *MockBlockSubscriberMockRecorder) SubscribeNewBlocks(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeNewBlocks", reflect.TypeOf((*MockBlockSubscriber)(nil).SubscribeNewBlocks), ctx)
+}
+
+// MockEndpointHealthReporter is a mock of EndpointHealthReporter interface.
+type MockEndpointHealthReporter struct {
+	ctrl     *gomock.Controller
+	recorder *MockEndpointHealthReporterMockRecorder
+}
+
+// MockEndpointHealthReporterMockRecorder is the mock recorder for MockEndpointHealthReporter.
+type MockEndpointHealthReporterMockRecorder struct {
+	mock *MockEndpointHealthReporter
+}
+
+// NewMockEndpointHealthReporter creates a new mock instance.
+func NewMockEndpointHealthReporter(ctrl *gomock.Controller) *MockEndpointHealthReporter {
+	mock := &MockEndpointHealthReporter{ctrl: ctrl}
+	mock.recorder = &MockEndpointHealthReporterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEndpointHealthReporter) EXPECT() *MockEndpointHealthReporterMockRecorder {
+	return m.recorder
+}
+
+// EndpointHealth mocks base method.
+func (m *MockEndpointHealthReporter) EndpointHealth() []*clientcontroller.EndpointHealth {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndpointHealth")
+	ret0, _ := ret[0].([]*clientcontroller.EndpointHealth)
+	return ret0
+}
+
+// EndpointHealth indicates an expected call of EndpointHealth.
+func (mr *MockEndpointHealthReporterMockRecorder) EndpointHealth() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndpointHealth", reflect.TypeOf((*MockEndpointHealthReporter)(nil).EndpointHealth))
+}
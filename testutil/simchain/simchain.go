@@ -0,0 +1,734 @@
+// Package simchain provides an in-memory consumer chain implementing
+// clientcontroller.ClientController, so that finality providers can be run
+// against a chain enforcing the rules of Babylon in unit tests, without a
+// babylond binary.
+//
+// Blocks are produced on a virtual clock which only moves when the test
+// advances it. The chain tracks the registered finality providers, their
+// public randomness commitments, their votes, and the voting power table of
+// every height. A block is finalized once the finality providers voting for it
+// hold more than 2/3 of its voting power and all the blocks below it are
+// finalized. A finality provider voting for two different blocks at the same
+// height is slashed, and its secret key is extracted from the two votes. New
+// blocks are also pushed to the subscribers, as CometBFT NewBlock events are.
+package simchain
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/babylonchain/babylon/crypto/eots"
+	bbntypes "github.com/babylonchain/babylon/types"
+	btcstakingtypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	finalitytypes "github.com/babylonchain/babylon/x/finality/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/finality-provider/clientcontroller"
+	"github.com/babylonchain/finality-provider/eotsmanager/forensics"
+	"github.com/babylonchain/finality-provider/types"
+)
+
+var _ clientcontroller.ClientController = &SimulatedChain{}
+
+const (
+	defaultBlockTime  = 5 * time.Second
+	defaultMinPubRand = 100
+)
+
+// Config is the configuration of a simulated chain
+type Config struct {
+	// GenesisTime is the virtual time of the chain before its first block
+	GenesisTime time.Time
+	// BlockTime is the virtual time between two blocks
+	BlockTime time.Duration
+	// MinPubRand is the minimum number of public randomness of a commitment
+	MinPubRand uint64
+}
+
+// DefaultConfig returns the config of a chain producing a block every 5
+// seconds of virtual time and accepting commitments of at least 100 public
+// randomness, as Babylon does by default
+func DefaultConfig() Config {
+	return Config{
+		GenesisTime: time.Unix(0, 0).UTC(),
+		BlockTime:   defaultBlockTime,
+		MinPubRand:  defaultMinPubRand,
+	}
+}
+
+// Evidence is the proof of an equivocation of a finality provider, along with
+// the secret key extracted from it
+type Evidence struct {
+	FpPk          *btcec.PublicKey
+	Height        uint64
+	PubRand       *btcec.FieldVal
+	CanonicalHash []byte
+	CanonicalSig  *btcec.ModNScalar
+	ForkHash      []byte
+	ForkSig       *btcec.ModNScalar
+	ExtractedSK   *btcec.PrivateKey
+}
+
+type block struct {
+	height    uint64
+	hash      []byte
+	time      time.Time
+	finalized bool
+	// powerTable is the voting power of the finality providers at the block
+	// keyed by the hex of their BIP-340 public keys
+	powerTable map[string]uint64
+	// votes are the public keys of the finality providers which voted for
+	// the block
+	votes map[string]bool
+}
+
+func (b *block) info() *types.BlockInfo {
+	return &types.BlockInfo{
+		Height:    b.height,
+		Hash:      b.hash,
+		Finalized: b.finalized,
+	}
+}
+
+type pubRandCommit struct {
+	startHeight uint64
+	numPubRand  uint64
+	commitment  []byte
+}
+
+func (c *pubRandCommit) endHeight() uint64 {
+	return c.startHeight + c.numPubRand - 1
+}
+
+// vote is a verified finality signature
+type vote struct {
+	height  uint64
+	hash    []byte
+	pubRand *btcec.FieldVal
+	sig     *btcec.ModNScalar
+}
+
+type finalityProvider struct {
+	pk          *btcec.PublicKey
+	commission  math.LegacyDec
+	description []byte
+	power       uint64
+	slashed     bool
+	commits     []*pubRandCommit
+	// votes are the canonical votes by height and forkVotes the votes for
+	// other blocks, which are kept until the equivocation is complete
+	votes     map[uint64]*vote
+	forkVotes map[uint64]*vote
+}
+
+// SimulatedChain is an in-memory consumer chain. It is safe for concurrent
+// use by finality providers and the test driving it
+type SimulatedChain struct {
+	cfg Config
+
+	mu        sync.Mutex
+	now       time.Time
+	elapsed   time.Duration
+	blocks    []*block
+	fps       map[string]*finalityProvider
+	evidences map[string]*Evidence
+	txCount   uint64
+
+	subscriptions []*subscription
+	eventsLost    bool
+}
+
+// NewSimulatedChain creates a chain without any block or finality provider
+func NewSimulatedChain(cfg Config) *SimulatedChain {
+	if cfg.BlockTime <= 0 {
+		cfg.BlockTime = defaultBlockTime
+	}
+
+	return &SimulatedChain{
+		cfg:       cfg,
+		now:       cfg.GenesisTime,
+		fps:       make(map[string]*finalityProvider),
+		evidences: make(map[string]*Evidence),
+	}
+}
+
+// Now returns the virtual time of the chain
+func (sc *SimulatedChain) Now() time.Time {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	return sc.now
+}
+
+// AdvanceTime moves the virtual clock forward by d, producing a block every
+// block time, and returns the new blocks
+func (sc *SimulatedChain) AdvanceTime(d time.Duration) []*types.BlockInfo {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	sc.elapsed += d
+	var produced []*types.BlockInfo
+	for sc.elapsed >= sc.cfg.BlockTime {
+		sc.elapsed -= sc.cfg.BlockTime
+		produced = append(produced, sc.produceBlock().info())
+	}
+	sc.now = sc.now.Add(d)
+
+	return produced
+}
+
+// ProduceBlocks moves the virtual clock forward by n block times and returns
+// the n new blocks
+func (sc *SimulatedChain) ProduceBlocks(n int) []*types.BlockInfo {
+	return sc.AdvanceTime(time.Duration(n) * sc.cfg.BlockTime)
+}
+
+// produceBlock appends a block with the voting power table of the current
+// finality providers. It should be called with the lock held
+func (sc *SimulatedChain) produceBlock() *block {
+	height := uint64(len(sc.blocks)) + 1
+	prevTime, prevHash := sc.cfg.GenesisTime, []byte(nil)
+	if len(sc.blocks) > 0 {
+		prev := sc.blocks[len(sc.blocks)-1]
+		prevTime, prevHash = prev.time, prev.hash
+	}
+	blockTime := prevTime.Add(sc.cfg.BlockTime)
+	hasher := sha256.New()
+	hasher.Write(sdk.Uint64ToBigEndian(height))
+	hasher.Write(prevHash)
+	hasher.Write(sdk.Uint64ToBigEndian(uint64(blockTime.UnixNano())))
+
+	powerTable := make(map[string]uint64)
+	for key, fp := range sc.fps {
+		if !fp.slashed && fp.power > 0 {
+			powerTable[key] = fp.power
+		}
+	}
+
+	b := &block{
+		height:     height,
+		hash:       hasher.Sum(nil),
+		time:       blockTime,
+		powerTable: powerTable,
+		votes:      make(map[string]bool),
+	}
+	sc.blocks = append(sc.blocks, b)
+	sc.publishBlock(b.info())
+
+	return b
+}
+
+// SetVotingPower sets the voting power of a registered finality provider,
+// which applies from the next block on, as delegations do
+func (sc *SimulatedChain) SetVotingPower(fpPk *btcec.PublicKey, power uint64) error {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	fp, err := sc.getFinalityProvider(fpPk)
+	if err != nil {
+		return err
+	}
+	fp.power = power
+
+	return nil
+}
+
+// ForkBlock replaces the block at the given height with a block of another
+// hash, as a node serving a fork of the chain would, and returns it. The votes
+// for the replaced block become votes for a fork, so that voting for the new
+// block is an equivocation
+func (sc *SimulatedChain) ForkBlock(height uint64) (*types.BlockInfo, error) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	b, err := sc.getBlock(height)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(append([]byte("fork"), b.hash...))
+	b.hash = hash[:]
+	b.votes = make(map[string]bool)
+	for _, fp := range sc.fps {
+		v, exists := fp.votes[height]
+		if !exists {
+			continue
+		}
+		delete(fp.votes, height)
+		if _, exists := fp.forkVotes[height]; !exists {
+			fp.forkVotes[height] = v
+		}
+	}
+
+	return b.info(), nil
+}
+
+// Evidence returns the evidence of the equivocation of a slashed finality
+// provider, or nil if it has not equivocated
+func (sc *SimulatedChain) Evidence(fpPk *btcec.PublicKey) *Evidence {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	return sc.evidences[pkHex(fpPk)]
+}
+
+// QueryVotesAtHeight returns the public keys of the finality providers which
+// voted for the block at the given height
+func (sc *SimulatedChain) QueryVotesAtHeight(height uint64) ([]bbntypes.BIP340PubKey, error) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	b, err := sc.getBlock(height)
+	if err != nil {
+		return nil, err
+	}
+
+	pks := make([]bbntypes.BIP340PubKey, 0, len(b.votes))
+	for key := range b.votes {
+		pk, err := bbntypes.NewBIP340PubKeyFromHex(key)
+		if err != nil {
+			return nil, err
+		}
+		pks = append(pks, *pk)
+	}
+	sort.Slice(pks, func(i, j int) bool { return bytes.Compare(pks[i], pks[j]) < 0 })
+
+	return pks, nil
+}
+
+func (sc *SimulatedChain) RegisterFinalityProvider(
+	fpPk *btcec.PublicKey,
+	pop []byte,
+	commission *math.LegacyDec,
+	description []byte,
+) (*types.TxResponse, error) {
+	var bbnPop btcstakingtypes.ProofOfPossessionBTC
+	if err := bbnPop.Unmarshal(pop); err != nil {
+		return nil, fmt.Errorf("invalid proof-of-possession: %w", err)
+	}
+	if err := bbnPop.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid proof-of-possession: %w", err)
+	}
+	if commission == nil {
+		return nil, fmt.Errorf("empty commission")
+	}
+
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	key := pkHex(fpPk)
+	if _, exists := sc.fps[key]; exists {
+		return nil, fmt.Errorf("the finality provider %s is already registered", key)
+	}
+	sc.fps[key] = &finalityProvider{
+		pk:          fpPk,
+		commission:  *commission,
+		description: description,
+		votes:       make(map[uint64]*vote),
+		forkVotes:   make(map[uint64]*vote),
+	}
+
+	return sc.newTxResponse(), nil
+}
+
+func (sc *SimulatedChain) CommitPubRandList(
+	fpPk *btcec.PublicKey,
+	startHeight uint64,
+	numPubRand uint64,
+	commitment []byte,
+	sig *schnorr.Signature,
+) (*types.TxResponse, error) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	fp, err := sc.getFinalityProvider(fpPk)
+	if err != nil {
+		return nil, err
+	}
+	if fp.slashed {
+		return nil, btcstakingtypes.ErrFpAlreadySlashed
+	}
+	if numPubRand < sc.cfg.MinPubRand {
+		return nil, finalitytypes.ErrTooFewPubRand.Wrapf("%d < %d", numPubRand, sc.cfg.MinPubRand)
+	}
+	if len(commitment) != tmhash.Size {
+		return nil, finalitytypes.ErrInvalidPubRand.Wrapf("the commitment should be %d bytes", tmhash.Size)
+	}
+	if n := len(fp.commits); n > 0 && startHeight <= fp.commits[n-1].endHeight() {
+		return nil, finalitytypes.ErrInvalidPubRand.Wrapf("the start height %d overlaps with the last commitment ending at %d",
+			startHeight, fp.commits[n-1].endHeight())
+	}
+
+	hash := pubRandCommitHash(startHeight, numPubRand, commitment)
+	if sig == nil || !sig.Verify(hash, fpPk) {
+		return nil, finalitytypes.ErrInvalidPubRand.Wrap("invalid signature over the commitment")
+	}
+
+	fp.commits = append(fp.commits, &pubRandCommit{
+		startHeight: startHeight,
+		numPubRand:  numPubRand,
+		commitment:  commitment,
+	})
+
+	return sc.newTxResponse(), nil
+}
+
+func (sc *SimulatedChain) SubmitFinalitySig(
+	fpPk *btcec.PublicKey,
+	block *types.BlockInfo,
+	pubRand *btcec.FieldVal,
+	proof []byte,
+	sig *btcec.ModNScalar,
+) (*types.TxResponse, error) {
+	return sc.SubmitBatchFinalitySigs(fpPk, []*types.BlockInfo{block}, []*btcec.FieldVal{pubRand}, [][]byte{proof}, []*btcec.ModNScalar{sig})
+}
+
+// SubmitBatchFinalitySigs verifies all the finality signatures before adding
+// any of them, as the messages of a transaction are applied atomically
+func (sc *SimulatedChain) SubmitBatchFinalitySigs(
+	fpPk *btcec.PublicKey,
+	blocks []*types.BlockInfo,
+	pubRandList []*btcec.FieldVal,
+	proofList [][]byte,
+	sigs []*btcec.ModNScalar,
+) (*types.TxResponse, error) {
+	if len(blocks) != len(sigs) || len(blocks) != len(pubRandList) || len(blocks) != len(proofList) {
+		return nil, fmt.Errorf("the number of blocks %v should match the number of finality signatures %v", len(blocks), len(sigs))
+	}
+
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	fp, err := sc.getFinalityProvider(fpPk)
+	if err != nil {
+		return nil, err
+	}
+	if fp.slashed {
+		return nil, btcstakingtypes.ErrFpAlreadySlashed
+	}
+
+	votes := make([]*vote, 0, len(blocks))
+	for i, b := range blocks {
+		v, err := sc.verifyFinalitySig(fp, b, pubRandList[i], proofList[i], sigs[i])
+		if err != nil {
+			return nil, err
+		}
+		votes = append(votes, v)
+	}
+
+	for _, v := range votes {
+		sc.addVote(fp, v)
+	}
+	sc.finalizeBlocks()
+
+	return sc.newTxResponse(), nil
+}
+
+// verifyFinalitySig checks that the finality provider has voting power at the
+// height, that the public randomness is committed, and that the signature is
+// valid. It should be called with the lock held
+func (sc *SimulatedChain) verifyFinalitySig(
+	fp *finalityProvider,
+	blockInfo *types.BlockInfo,
+	pubRand *btcec.FieldVal,
+	proof []byte,
+	sig *btcec.ModNScalar,
+) (*vote, error) {
+	if blockInfo == nil || pubRand == nil || sig == nil {
+		return nil, finalitytypes.ErrInvalidFinalitySig.Wrap("incomplete finality signature")
+	}
+	b, err := sc.getBlock(blockInfo.Height)
+	if err != nil {
+		return nil, err
+	}
+	if b.powerTable[pkHex(fp.pk)] == 0 {
+		return nil, finalitytypes.ErrInvalidFinalitySig.Wrapf("the finality provider has no voting power at height %d", b.height)
+	}
+
+	var commit *pubRandCommit
+	for _, c := range fp.commits {
+		if c.startHeight <= b.height && b.height <= c.endHeight() {
+			commit = c
+			break
+		}
+	}
+	if commit == nil {
+		return nil, finalitytypes.ErrPubRandNotFound.Wrapf("no public randomness is committed for height %d", b.height)
+	}
+
+	var cmtProof cmtcrypto.Proof
+	if err := cmtProof.Unmarshal(proof); err != nil {
+		return nil, finalitytypes.ErrInvalidFinalitySig.Wrapf("invalid proof: %v", err)
+	}
+	merkleProof, err := merkle.ProofFromProto(&cmtProof)
+	if err != nil {
+		return nil, finalitytypes.ErrInvalidFinalitySig.Wrapf("invalid proof: %v", err)
+	}
+	if uint64(merkleProof.Index) != b.height-commit.startHeight || uint64(merkleProof.Total) != commit.numPubRand {
+		return nil, finalitytypes.ErrInvalidFinalitySig.Wrapf("the proof is not for height %d", b.height)
+	}
+	if err := merkleProof.Verify(commit.commitment, bbntypes.NewSchnorrPubRandFromFieldVal(pubRand).MustMarshal()); err != nil {
+		return nil, finalitytypes.ErrInvalidFinalitySig.Wrapf("the public randomness is not committed: %v", err)
+	}
+
+	msg := forensics.FinalityVoteMsg(b.height, blockInfo.Hash)
+	if err := eots.Verify(fp.pk, pubRand, msg, sig); err != nil {
+		return nil, finalitytypes.ErrInvalidFinalitySig.Wrapf("invalid EOTS signature: %v", err)
+	}
+
+	return &vote{
+		height:  b.height,
+		hash:    blockInfo.Hash,
+		pubRand: pubRand,
+		sig:     sig,
+	}, nil
+}
+
+// addVote records a verified vote, and slashes the finality provider if it
+// completes an equivocation. It should be called with the lock held
+func (sc *SimulatedChain) addVote(fp *finalityProvider, v *vote) {
+	b := sc.blocks[v.height-1]
+	if !bytes.Equal(v.hash, b.hash) {
+		if _, exists := fp.forkVotes[v.height]; !exists {
+			fp.forkVotes[v.height] = v
+		}
+	} else if _, exists := fp.votes[v.height]; !exists {
+		// a vote submitted again is ignored
+		fp.votes[v.height] = v
+		b.votes[pkHex(fp.pk)] = true
+	}
+
+	canonical, fork := fp.votes[v.height], fp.forkVotes[v.height]
+	if canonical == nil || fork == nil || fp.slashed {
+		return
+	}
+
+	evidence := &Evidence{
+		FpPk:          fp.pk,
+		Height:        v.height,
+		PubRand:       canonical.pubRand,
+		CanonicalHash: canonical.hash,
+		CanonicalSig:  canonical.sig,
+		ForkHash:      fork.hash,
+		ForkSig:       fork.sig,
+	}
+	// the votes with different randomness do not leak the key, but are
+	// slashed all the same
+	sk, err := (&forensics.Evidence{
+		PubKey:  fp.pk,
+		PubRand: canonical.pubRand,
+		Msg1:    forensics.FinalityVoteMsg(v.height, canonical.hash),
+		Sig1:    canonical.sig,
+		Msg2:    forensics.FinalityVoteMsg(v.height, fork.hash),
+		Sig2:    fork.sig,
+	}).ExtractSK()
+	if err == nil {
+		evidence.ExtractedSK = sk
+	}
+
+	fp.slashed = true
+	sc.evidences[pkHex(fp.pk)] = evidence
+}
+
+// finalizeBlocks finalizes the blocks in order from the lowest non-finalized
+// one, stopping at the first block without a quorum. It should be called with
+// the lock held
+func (sc *SimulatedChain) finalizeBlocks() {
+	for _, b := range sc.blocks {
+		if b.finalized {
+			continue
+		}
+		if len(b.powerTable) == 0 {
+			// the chain is not activated yet at this height
+			continue
+		}
+
+		var total, voted uint64
+		for key, power := range b.powerTable {
+			total += power
+			if b.votes[key] {
+				voted += power
+			}
+		}
+		if voted*3 <= total*2 {
+			return
+		}
+		b.finalized = true
+	}
+}
+
+func (sc *SimulatedChain) QueryFinalityProviderVotingPower(fpPk *btcec.PublicKey, blockHeight uint64) (uint64, error) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	b, err := sc.getBlock(blockHeight)
+	if err != nil {
+		return 0, fmt.Errorf("the voting power table at height %d is not available: %w", blockHeight, err)
+	}
+
+	return b.powerTable[pkHex(fpPk)], nil
+}
+
+func (sc *SimulatedChain) QueryFinalityProviderSlashed(fpPk *btcec.PublicKey) (bool, error) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	fp, err := sc.getFinalityProvider(fpPk)
+	if err != nil {
+		return false, err
+	}
+
+	return fp.slashed, nil
+}
+
+func (sc *SimulatedChain) QueryLatestFinalizedBlocks(count uint64) ([]*types.BlockInfo, error) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	var blocks []*types.BlockInfo
+	for i := len(sc.blocks) - 1; i >= 0 && uint64(len(blocks)) < count; i-- {
+		if sc.blocks[i].finalized {
+			blocks = append(blocks, sc.blocks[i].info())
+		}
+	}
+
+	return blocks, nil
+}
+
+func (sc *SimulatedChain) QueryLastCommittedPublicRand(fpPk *btcec.PublicKey, count uint64) (map[uint64]*finalitytypes.PubRandCommitResponse, error) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	res := make(map[uint64]*finalitytypes.PubRandCommitResponse)
+	fp, exists := sc.fps[pkHex(fpPk)]
+	if !exists {
+		return res, nil
+	}
+
+	for i := len(fp.commits) - 1; i >= 0 && uint64(len(res)) < count; i-- {
+		c := fp.commits[i]
+		res[c.startHeight] = &finalitytypes.PubRandCommitResponse{
+			NumPubRand: c.numPubRand,
+			Commitment: c.commitment,
+		}
+	}
+
+	return res, nil
+}
+
+func (sc *SimulatedChain) QueryBlock(height uint64) (*types.BlockInfo, error) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	b, err := sc.getBlock(height)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.info(), nil
+}
+
+func (sc *SimulatedChain) QueryBlocks(startHeight, endHeight, limit uint64) ([]*types.BlockInfo, error) {
+	if endHeight < startHeight {
+		return nil, fmt.Errorf("the startHeight %v should not be higher than the endHeight %v", startHeight, endHeight)
+	}
+
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	var blocks []*types.BlockInfo
+	for h := startHeight; h <= endHeight && h <= uint64(len(sc.blocks)) && uint64(len(blocks)) < limit; h++ {
+		if h == 0 {
+			continue
+		}
+		blocks = append(blocks, sc.blocks[h-1].info())
+	}
+
+	return blocks, nil
+}
+
+func (sc *SimulatedChain) QueryBestBlock() (*types.BlockInfo, error) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	if len(sc.blocks) == 0 {
+		return nil, fmt.Errorf("the chain has no block yet")
+	}
+
+	return sc.blocks[len(sc.blocks)-1].info(), nil
+}
+
+// QueryActivatedHeight returns the height of the first block in which a
+// finality provider has voting power
+func (sc *SimulatedChain) QueryActivatedHeight() (uint64, error) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	for _, b := range sc.blocks {
+		if len(b.powerTable) > 0 {
+			return b.height, nil
+		}
+	}
+
+	return 0, fmt.Errorf("the chain is not activated yet")
+}
+
+func (sc *SimulatedChain) Close() error {
+	return nil
+}
+
+// getBlock returns the block at the given height. It should be called with
+// the lock held
+func (sc *SimulatedChain) getBlock(height uint64) (*block, error) {
+	if height == 0 || height > uint64(len(sc.blocks)) {
+		return nil, fmt.Errorf("the block at height %d is not found", height)
+	}
+
+	return sc.blocks[height-1], nil
+}
+
+// getFinalityProvider returns the registered finality provider. It should be
+// called with the lock held
+func (sc *SimulatedChain) getFinalityProvider(fpPk *btcec.PublicKey) (*finalityProvider, error) {
+	fp, exists := sc.fps[pkHex(fpPk)]
+	if !exists {
+		return nil, btcstakingtypes.ErrFpNotFound.Wrapf("%s", pkHex(fpPk))
+	}
+
+	return fp, nil
+}
+
+// newTxResponse returns the response of a transaction with a unique hash. It
+// should be called with the lock held
+func (sc *SimulatedChain) newTxResponse() *types.TxResponse {
+	sc.txCount++
+	hash := sha256.Sum256(sdk.Uint64ToBigEndian(sc.txCount))
+
+	return &types.TxResponse{TxHash: hex.EncodeToString(hash[:])}
+}
+
+// pubRandCommitHash returns the hash signed by a finality provider committing
+// public randomness
+func pubRandCommitHash(startHeight uint64, numPubRand uint64, commitment []byte) []byte {
+	hasher := tmhash.New()
+	hasher.Write(sdk.Uint64ToBigEndian(startHeight))
+	hasher.Write(sdk.Uint64ToBigEndian(numPubRand))
+	hasher.Write(commitment)
+
+	return hasher.Sum(nil)
+}
+
+func pkHex(pk *btcec.PublicKey) string {
+	return bbntypes.NewBIP340PubKeyFromBTCPK(pk).MarshalHex()
+}
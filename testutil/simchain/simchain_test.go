@@ -0,0 +1,189 @@
+package simchain_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/babylonchain/babylon/crypto/eots"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/forensics"
+	"github.com/babylonchain/finality-provider/eotsmanager/randgenerator"
+	"github.com/babylonchain/finality-provider/testutil/simchain"
+	"github.com/babylonchain/finality-provider/types"
+)
+
+const testNumPubRand = 100
+
+var testChainID = []byte("sim-chain")
+
+// testFp is a finality provider voting directly on the simulated chain
+type testFp struct {
+	sk          *btcec.PrivateKey
+	startHeight uint64
+	proofs      [][]byte
+}
+
+func newTestFp(t *testing.T, sc *simchain.SimulatedChain, power uint64) *testFp {
+	sk, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	fpAddr, err := sdk.AccAddressFromBech32(datagen.GenRandomAccount().Address)
+	require.NoError(t, err)
+	popBTC, err := bstypes.NewPoPBTC(fpAddr, sk)
+	require.NoError(t, err)
+	pop, err := popBTC.Marshal()
+	require.NoError(t, err)
+	commission := sdkmath.LegacyZeroDec()
+	_, err = sc.RegisterFinalityProvider(sk.PubKey(), pop, &commission, nil)
+	require.NoError(t, err)
+	require.NoError(t, sc.SetVotingPower(sk.PubKey(), power))
+
+	return &testFp{sk: sk}
+}
+
+// commit commits the public randomness from the given height
+func (fp *testFp) commit(t *testing.T, sc *simchain.SimulatedChain, startHeight uint64) error {
+	prList := make([]*btcec.FieldVal, 0, testNumPubRand)
+	for i := uint64(0); i < testNumPubRand; i++ {
+		_, pr := randgenerator.GenerateRandomness(fp.sk.Serialize(), testChainID, startHeight+i)
+		prList = append(prList, pr)
+	}
+	commitment, proofList := types.GetPubRandCommitAndProofs(prList)
+
+	proofs := make([][]byte, 0, len(proofList))
+	for _, proof := range proofList {
+		proofBytes, err := proof.ToProto().Marshal()
+		require.NoError(t, err)
+		proofs = append(proofs, proofBytes)
+	}
+
+	hasher := tmhash.New()
+	hasher.Write(sdk.Uint64ToBigEndian(startHeight))
+	hasher.Write(sdk.Uint64ToBigEndian(testNumPubRand))
+	hasher.Write(commitment)
+	sig, err := schnorr.Sign(fp.sk, hasher.Sum(nil))
+	require.NoError(t, err)
+
+	if _, err := sc.CommitPubRandList(fp.sk.PubKey(), startHeight, testNumPubRand, commitment, sig); err != nil {
+		return err
+	}
+	fp.startHeight = startHeight
+	fp.proofs = proofs
+
+	return nil
+}
+
+// vote signs the block and submits the finality signature
+func (fp *testFp) vote(t *testing.T, sc *simchain.SimulatedChain, b *types.BlockInfo) error {
+	privRand, pubRand := randgenerator.GenerateRandomness(fp.sk.Serialize(), testChainID, b.Height)
+	sig, err := eots.Sign(fp.sk, privRand, forensics.FinalityVoteMsg(b.Height, b.Hash))
+	require.NoError(t, err)
+
+	var proof []byte
+	if i := b.Height - fp.startHeight; b.Height >= fp.startHeight && i < uint64(len(fp.proofs)) {
+		proof = fp.proofs[i]
+	}
+
+	_, err = sc.SubmitFinalitySig(fp.sk.PubKey(), b, pubRand, proof, sig)
+	return err
+}
+
+func TestFinalization(t *testing.T) {
+	sc := simchain.NewSimulatedChain(simchain.DefaultConfig())
+	fps := []*testFp{newTestFp(t, sc, 30), newTestFp(t, sc, 30), newTestFp(t, sc, 40)}
+	for _, fp := range fps {
+		require.NoError(t, fp.commit(t, sc, 1))
+	}
+
+	blocks := sc.ProduceBlocks(3)
+	require.Len(t, blocks, 3)
+	require.Equal(t, time.Unix(15, 0).UTC(), sc.Now())
+	activatedHeight, err := sc.QueryActivatedHeight()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), activatedHeight)
+
+	// 60 of 100 is not a quorum
+	require.NoError(t, fps[0].vote(t, sc, blocks[0]))
+	require.NoError(t, fps[1].vote(t, sc, blocks[0]))
+	b, err := sc.QueryBlock(1)
+	require.NoError(t, err)
+	require.False(t, b.Finalized)
+
+	// a block is not finalized before the blocks below it
+	for _, fp := range fps {
+		require.NoError(t, fp.vote(t, sc, blocks[1]))
+	}
+	finalized, err := sc.QueryLatestFinalizedBlocks(1)
+	require.NoError(t, err)
+	require.Empty(t, finalized)
+
+	// 70 of 100 finalizes both blocks
+	require.NoError(t, fps[2].vote(t, sc, blocks[0]))
+	finalized, err = sc.QueryLatestFinalizedBlocks(10)
+	require.NoError(t, err)
+	require.Len(t, finalized, 2)
+	require.Equal(t, uint64(2), finalized[0].Height)
+	votes, err := sc.QueryVotesAtHeight(1)
+	require.NoError(t, err)
+	require.Len(t, votes, 3)
+
+	// commitments cannot overlap
+	require.Error(t, fps[0].commit(t, sc, 50))
+
+	// votes with a wrong inclusion proof are rejected
+	fps[0].proofs[2], fps[0].proofs[3] = fps[0].proofs[3], fps[0].proofs[2]
+	require.Error(t, fps[0].vote(t, sc, blocks[2]))
+
+	// votes without public randomness or voting power are rejected
+	fp := newTestFp(t, sc, 10)
+	require.Error(t, fp.vote(t, sc, blocks[2]))
+	require.NoError(t, fp.commit(t, sc, 1))
+	require.Error(t, fp.vote(t, sc, blocks[2]))
+	power, err := sc.QueryFinalityProviderVotingPower(fp.sk.PubKey(), 3)
+	require.NoError(t, err)
+	require.Zero(t, power)
+	power, err = sc.QueryFinalityProviderVotingPower(fp.sk.PubKey(), sc.ProduceBlocks(1)[0].Height)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), power)
+}
+
+func TestEquivocation(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	sc := simchain.NewSimulatedChain(simchain.DefaultConfig())
+	fp := newTestFp(t, sc, 100)
+	require.NoError(t, fp.commit(t, sc, 1))
+	blocks := sc.ProduceBlocks(2)
+
+	// voting for a fork block alone does not slash
+	forkBlock := &types.BlockInfo{Height: 1, Hash: make([]byte, 32)}
+	r.Read(forkBlock.Hash)
+	require.NoError(t, fp.vote(t, sc, forkBlock))
+	slashed, err := sc.QueryFinalityProviderSlashed(fp.sk.PubKey())
+	require.NoError(t, err)
+	require.False(t, slashed)
+
+	require.NoError(t, fp.vote(t, sc, blocks[0]))
+	slashed, err = sc.QueryFinalityProviderSlashed(fp.sk.PubKey())
+	require.NoError(t, err)
+	require.True(t, slashed)
+
+	evidence := sc.Evidence(fp.sk.PubKey())
+	require.NotNil(t, evidence)
+	require.Equal(t, uint64(1), evidence.Height)
+	require.Equal(t, schnorr.SerializePubKey(fp.sk.PubKey()), schnorr.SerializePubKey(evidence.ExtractedSK.PubKey()))
+
+	// a slashed finality provider cannot vote and loses its voting power
+	require.Error(t, fp.vote(t, sc, blocks[1]))
+	power, err := sc.QueryFinalityProviderVotingPower(fp.sk.PubKey(), sc.ProduceBlocks(1)[0].Height)
+	require.NoError(t, err)
+	require.Zero(t, power)
+}
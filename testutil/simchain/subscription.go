@@ -0,0 +1,86 @@
+package simchain
+
+import (
+	"context"
+
+	"github.com/babylonchain/finality-provider/clientcontroller"
+	"github.com/babylonchain/finality-provider/types"
+)
+
+var _ clientcontroller.BlockSubscriber = &SimulatedChain{}
+
+// subscriptionBuffer is the number of blocks buffered for a subscriber, which
+// is dropped once its buffer is full, as CometBFT does with slow subscribers
+const subscriptionBuffer = 100
+
+type subscription struct {
+	blocks chan *types.BlockInfo
+}
+
+// SubscribeNewBlocks returns a channel receiving the blocks produced from now
+// on, which is closed when the context is done or the subscription drops
+func (sc *SimulatedChain) SubscribeNewBlocks(ctx context.Context) (<-chan *types.BlockInfo, error) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	sub := &subscription{blocks: make(chan *types.BlockInfo, subscriptionBuffer)}
+	sc.subscriptions = append(sc.subscriptions, sub)
+
+	go func() {
+		<-ctx.Done()
+
+		sc.mu.Lock()
+		defer sc.mu.Unlock()
+		sc.removeSubscription(sub)
+	}()
+
+	return sub.blocks, nil
+}
+
+// DropSubscriptions closes the channels of all the subscribers, as when the
+// websocket connection to a node is lost
+func (sc *SimulatedChain) DropSubscriptions() {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	for len(sc.subscriptions) > 0 {
+		sc.removeSubscription(sc.subscriptions[0])
+	}
+}
+
+// SetEventsLost sets whether the blocks produced are not sent to the
+// subscribers, as when the events are lost while a websocket reconnects
+func (sc *SimulatedChain) SetEventsLost(lost bool) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	sc.eventsLost = lost
+}
+
+// publishBlock sends the block to the subscribers. It should be called with
+// the lock held
+func (sc *SimulatedChain) publishBlock(b *types.BlockInfo) {
+	if sc.eventsLost {
+		return
+	}
+
+	for _, sub := range append([]*subscription(nil), sc.subscriptions...) {
+		select {
+		case sub.blocks <- b:
+		default:
+			sc.removeSubscription(sub)
+		}
+	}
+}
+
+// removeSubscription closes the channel of the subscriber unless it is
+// already removed. It should be called with the lock held
+func (sc *SimulatedChain) removeSubscription(sub *subscription) {
+	for i, s := range sc.subscriptions {
+		if s == sub {
+			sc.subscriptions = append(sc.subscriptions[:i], sc.subscriptions[i+1:]...)
+			close(sub.blocks)
+			return
+		}
+	}
+}
@@ -22,9 +22,9 @@ func PrepareMockedClientController(t *testing.T, r *rand.Rand, startHeight, curr
 	ctl := gomock.NewController(t)
 	mockClientController := mocks.NewMockClientController(ctl)
 
-	for i := startHeight + 1; i <= currentHeight; i++ {
+	for i := startHeight + 1; i < currentHeight; i++ {
 		resBlock := &types.BlockInfo{
-			Height: currentHeight,
+			Height: i,
 			Hash:   GenRandomByteArray(r, 32),
 		}
 		mockClientController.EXPECT().QueryBlock(i).Return(resBlock, nil).AnyTimes()
@@ -34,6 +34,7 @@ func PrepareMockedClientController(t *testing.T, r *rand.Rand, startHeight, curr
 		Height: currentHeight,
 		Hash:   GenRandomByteArray(r, 32),
 	}
+	mockClientController.EXPECT().QueryBlock(currentHeight).Return(currentBlockRes, nil).AnyTimes()
 
 	mockClientController.EXPECT().Close().Return(nil).AnyTimes()
 	mockClientController.EXPECT().QueryBestBlock().Return(currentBlockRes, nil).AnyTimes()
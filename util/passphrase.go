@@ -0,0 +1,137 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// PassphraseSource describes where a command reads a passphrase from. At most
+// one of the flag, the file and the prompt can be given, and the environment
+// variable is only read if none of them is
+type PassphraseSource struct {
+	// Value is the value of the passphrase flag, and IsSet whether the flag
+	// was given. Value is returned if no other source is given
+	Value string
+	IsSet bool
+	// File is the path of a file holding the passphrase
+	File string
+	// EnvVar is the name of an environment variable holding the passphrase
+	EnvVar string
+	// Prompt reads the passphrase from the terminal without echoing it
+	Prompt bool
+	// Confirm asks for the passphrase twice when prompting, as when it
+	// encrypts a new key
+	Confirm bool
+}
+
+// ReadPassphrase returns the passphrase from the given sources
+func ReadPassphrase(src PassphraseSource) (string, error) {
+	given := 0
+	for _, set := range []bool{src.IsSet, src.File != "", src.Prompt} {
+		if set {
+			given++
+		}
+	}
+	if given > 1 {
+		return "", fmt.Errorf("the passphrase, the passphrase file, and the passphrase prompt are mutually exclusive")
+	}
+
+	switch {
+	case src.IsSet:
+		return src.Value, nil
+	case src.File != "":
+		return readPassphraseFile(src.File)
+	case src.Prompt:
+		return promptPassphrase(src.Confirm)
+	}
+
+	if src.EnvVar != "" {
+		if passphrase, ok := os.LookupEnv(src.EnvVar); ok {
+			return passphrase, nil
+		}
+	}
+
+	return src.Value, nil
+}
+
+// ReadSecretsFile reads the passphrases of the keys from the JSON object at
+// the given path, which maps the keys to their passphrases. The file should
+// only be accessible by its owner
+func ReadSecretsFile(path string) (map[string]string, error) {
+	b, err := readPrivateFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var secrets map[string]string
+	if err := json.Unmarshal(b, &secrets); err != nil {
+		return nil, fmt.Errorf("invalid secrets file %s: %w", path, err)
+	}
+
+	return secrets, nil
+}
+
+// readPassphraseFile reads the passphrase from the first line of the file
+func readPassphraseFile(path string) (string, error) {
+	b, err := readPrivateFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	passphrase, _, _ := strings.Cut(string(b), "\n")
+
+	return strings.TrimSuffix(passphrase, "\r"), nil
+}
+
+func readPrivateFile(path string) ([]byte, error) {
+	path = CleanAndExpandPath(path)
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if perm := info.Mode().Perm(); perm&0077 != 0 {
+		return nil, fmt.Errorf("the file %s is accessible by other users (mode %#o), it should be 0600", path, perm)
+	}
+
+	return os.ReadFile(path)
+}
+
+func promptPassphrase(confirm bool) (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return "", fmt.Errorf("cannot prompt for the passphrase as the standard input is not a terminal")
+	}
+
+	passphrase, err := readTerminal(fd, "Enter passphrase: ")
+	if err != nil {
+		return "", err
+	}
+	if !confirm {
+		return passphrase, nil
+	}
+
+	repeated, err := readTerminal(fd, "Repeat passphrase: ")
+	if err != nil {
+		return "", err
+	}
+	if passphrase != repeated {
+		return "", fmt.Errorf("the passphrases do not match")
+	}
+
+	return passphrase, nil
+}
+
+func readTerminal(fd int, prompt string) (string, error) {
+	fmt.Fprint(os.Stderr, prompt)
+	b, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the passphrase: %w", err)
+	}
+
+	return string(b), nil
+}
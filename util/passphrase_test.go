@@ -0,0 +1,56 @@
+package util_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/finality-provider/util"
+)
+
+func TestReadPassphrase(t *testing.T) {
+	dir := t.TempDir()
+	passphraseFile := filepath.Join(dir, "passphrase")
+	require.NoError(t, os.WriteFile(passphraseFile, []byte("from-file\r\nignored\n"), 0600))
+	t.Setenv("TEST_PASSPHRASE", "from-env")
+
+	// the flag takes precedence over the environment
+	passphrase, err := util.ReadPassphrase(util.PassphraseSource{Value: "from-flag", IsSet: true, EnvVar: "TEST_PASSPHRASE"})
+	require.NoError(t, err)
+	require.Equal(t, "from-flag", passphrase)
+
+	passphrase, err = util.ReadPassphrase(util.PassphraseSource{File: passphraseFile, EnvVar: "TEST_PASSPHRASE"})
+	require.NoError(t, err)
+	require.Equal(t, "from-file", passphrase)
+
+	passphrase, err = util.ReadPassphrase(util.PassphraseSource{EnvVar: "TEST_PASSPHRASE"})
+	require.NoError(t, err)
+	require.Equal(t, "from-env", passphrase)
+
+	// the default value of the flag if no source is given
+	passphrase, err = util.ReadPassphrase(util.PassphraseSource{EnvVar: "UNSET_TEST_PASSPHRASE"})
+	require.NoError(t, err)
+	require.Empty(t, passphrase)
+
+	_, err = util.ReadPassphrase(util.PassphraseSource{Value: "from-flag", IsSet: true, File: passphraseFile})
+	require.ErrorContains(t, err, "mutually exclusive")
+
+	require.NoError(t, os.Chmod(passphraseFile, 0644))
+	_, err = util.ReadPassphrase(util.PassphraseSource{File: passphraseFile})
+	require.ErrorContains(t, err, "other users")
+}
+
+func TestReadSecretsFile(t *testing.T) {
+	secretsFile := filepath.Join(t.TempDir(), "secrets.json")
+	require.NoError(t, os.WriteFile(secretsFile, []byte(`{"key1": "pass1", "key2": "pass2"}`), 0600))
+
+	secrets, err := util.ReadSecretsFile(secretsFile)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"key1": "pass1", "key2": "pass2"}, secrets)
+
+	require.NoError(t, os.WriteFile(secretsFile, []byte(`key1=pass1`), 0600))
+	_, err = util.ReadSecretsFile(secretsFile)
+	require.ErrorContains(t, err, "invalid secrets file")
+}
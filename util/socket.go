@@ -0,0 +1,140 @@
+package util
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// UnixSocketScheme prefixes RPC addresses which are Unix domain sockets,
+	// as in unix:///path/to.sock
+	UnixSocketScheme = "unix://"
+
+	// unixSocketMode allows only the owner of the daemon to connect
+	unixSocketMode os.FileMode = 0600
+
+	staleSocketDialTimeout = time.Second
+)
+
+// UnixSocketPath returns the path of the socket if the given RPC address is
+// a Unix domain socket
+func UnixSocketPath(addr string) (string, bool) {
+	if !strings.HasPrefix(addr, UnixSocketScheme) {
+		return "", false
+	}
+
+	return strings.TrimPrefix(addr, UnixSocketScheme), true
+}
+
+// ValidateUnixSocketAddr checks that the path of a Unix domain socket RPC
+// address is absolute. Other addresses are left to the caller
+func ValidateUnixSocketAddr(addr string) error {
+	path, ok := UnixSocketPath(addr)
+	if !ok {
+		return nil
+	}
+	if !filepath.IsAbs(path) {
+		return fmt.Errorf("the socket path %s should be absolute, e.g., unix:///path/to.sock", path)
+	}
+
+	return nil
+}
+
+// ValidateRPCListener checks that the given RPC listener is either a TCP
+// address or a Unix domain socket with an absolute path
+func ValidateRPCListener(addr string) error {
+	if _, ok := UnixSocketPath(addr); ok {
+		return ValidateUnixSocketAddr(addr)
+	}
+
+	_, err := net.ResolveTCPAddr("tcp", addr)
+	return err
+}
+
+// ListenRPC listens on the given RPC listener. A Unix domain socket is only
+// created in a directory which other users cannot write to, replacing a stale
+// socket left by a previous run, and is only accessible by its owner
+func ListenRPC(addr string) (net.Listener, error) {
+	path, ok := UnixSocketPath(addr)
+	if !ok {
+		return net.Listen("tcp", addr)
+	}
+
+	if err := checkSocketDir(filepath.Dir(path)); err != nil {
+		return nil, err
+	}
+	if err := removeStaleSocket(path); err != nil {
+		return nil, err
+	}
+
+	lis, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, unixSocketMode); err != nil {
+		lis.Close()
+		return nil, fmt.Errorf("failed to set the permissions of the socket %s: %w", path, err)
+	}
+
+	return lis, nil
+}
+
+// CheckUnixSocket checks that the file at the given path is a Unix domain
+// socket which other users cannot access, so that a client does not send its
+// requests to a socket the daemon did not create
+func CheckUnixSocket(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if info.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("%s is not a socket", path)
+	}
+	if perm := info.Mode().Perm(); perm&0077 != 0 {
+		return fmt.Errorf("the socket %s is accessible by other users (mode %#o)", path, perm)
+	}
+
+	return nil
+}
+
+func checkSocketDir(dir string) error {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return fmt.Errorf("invalid socket directory: %w", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", dir)
+	}
+	if perm := info.Mode().Perm(); perm&0022 != 0 {
+		return fmt.Errorf("the socket directory %s is writable by other users (mode %#o)", dir, perm)
+	}
+
+	return nil
+}
+
+// removeStaleSocket removes the socket at the given path unless a daemon is
+// still listening on it
+func removeStaleSocket(path string) error {
+	info, err := os.Lstat(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("%s exists and is not a socket", path)
+	}
+
+	conn, err := net.DialTimeout("unix", path, staleSocketDialTimeout)
+	if err == nil {
+		conn.Close()
+		return fmt.Errorf("the socket %s is in use", path)
+	}
+
+	return os.Remove(path)
+}
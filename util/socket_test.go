@@ -0,0 +1,65 @@
+package util_test
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/finality-provider/util"
+)
+
+func TestListenUnixSocket(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "rpc.sock")
+	addr := util.UnixSocketScheme + path
+
+	lis, err := util.ListenRPC(addr)
+	require.NoError(t, err)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	require.NoError(t, util.CheckUnixSocket(path))
+
+	// the socket of a running daemon is not replaced
+	_, err = util.ListenRPC(addr)
+	require.ErrorContains(t, err, "in use")
+
+	// a stale socket is replaced
+	lis.(*net.UnixListener).SetUnlinkOnClose(false)
+	require.NoError(t, lis.Close())
+	lis, err = util.ListenRPC(addr)
+	require.NoError(t, err)
+	require.NoError(t, lis.Close())
+	require.NoFileExists(t, path)
+
+	// a socket accessible by other users is refused by clients
+	other, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	defer other.Close()
+	require.NoError(t, os.Chmod(path, 0666))
+	require.ErrorContains(t, util.CheckUnixSocket(path), "other users")
+
+	// regular files are neither replaced nor dialed
+	filePath := filepath.Join(dir, "file")
+	require.NoError(t, os.WriteFile(filePath, nil, 0600))
+	_, err = util.ListenRPC(util.UnixSocketScheme + filePath)
+	require.ErrorContains(t, err, "not a socket")
+	require.ErrorContains(t, util.CheckUnixSocket(filePath), "not a socket")
+
+	// the directory should not be writable by other users
+	sharedDir := filepath.Join(dir, "shared")
+	require.NoError(t, os.Mkdir(sharedDir, 0700))
+	require.NoError(t, os.Chmod(sharedDir, 0777))
+	_, err = util.ListenRPC(util.UnixSocketScheme + filepath.Join(sharedDir, "rpc.sock"))
+	require.ErrorContains(t, err, "writable by other users")
+}
+
+func TestValidateRPCListener(t *testing.T) {
+	require.NoError(t, util.ValidateRPCListener("127.0.0.1:12582"))
+	require.NoError(t, util.ValidateRPCListener("unix:///var/run/eotsd.sock"))
+	require.Error(t, util.ValidateRPCListener("unix://eotsd.sock"))
+	require.Error(t, util.ValidateRPCListener("127.0.0.1"))
+}
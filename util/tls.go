@@ -0,0 +1,230 @@
+package util
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"time"
+)
+
+const (
+	tlsCertOrganization = "finality-provider autogenerated cert"
+	// tlsCertValidity is the validity period of the self-signed certificates,
+	// which is about 14 months as in lnd
+	tlsCertValidity = 14 * 30 * 24 * time.Hour
+
+	// TLSCertRenewalWindow is how long before its expiry a certificate is
+	// due for renewal, which has to be done by the operator as the clients
+	// pinning the certificate need the new one
+	TLSCertRenewalWindow = 30 * 24 * time.Hour
+)
+
+// GenerateSelfSignedCert generates an ECDSA key and a self-signed certificate
+// valid for localhost, the host name, and the given extra hosts, and writes
+// them to the given paths in PEM format. The certificate can also be used as
+// its own CA so that it can be trusted directly by peers
+func GenerateSelfSignedCert(certPath, keyPath string, extraHosts []string) error {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return fmt.Errorf("failed to generate TLS key: %w", err)
+	}
+
+	serialNumberLimit := new(big.Int).Lsh(big.NewInt(1), 128)
+	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
+	if err != nil {
+		return fmt.Errorf("failed to generate serial number: %w", err)
+	}
+
+	host, err := os.Hostname()
+	if err != nil {
+		host = "localhost"
+	}
+
+	now := time.Now()
+	template := x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			Organization: []string{tlsCertOrganization},
+			CommonName:   host,
+		},
+		NotBefore: now.Add(-time.Hour),
+		NotAfter:  now.Add(tlsCertValidity),
+
+		KeyUsage: x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature |
+			x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+
+		DNSNames:    []string{"localhost"},
+		IPAddresses: []net.IP{net.ParseIP("127.0.0.1"), net.ParseIP("::1")},
+	}
+	if host != "localhost" {
+		template.DNSNames = append(template.DNSNames, host)
+	}
+	for _, h := range extraHosts {
+		if ip := net.ParseIP(h); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, h)
+		}
+	}
+
+	derBytes, err := x509.CreateCertificate(rand.Reader, &template, &template, &priv.PublicKey, priv)
+	if err != nil {
+		return fmt.Errorf("failed to create TLS certificate: %w", err)
+	}
+
+	keyBytes, err := x509.MarshalECPrivateKey(priv)
+	if err != nil {
+		return fmt.Errorf("failed to encode TLS key: %w", err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: derBytes})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes})
+
+	// both files are fully written before any of them is renamed into place
+	// so that a failure never leaves a certificate paired with a foreign key
+	certTmp, err := writeTempFile(certPath, certPEM, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to write TLS certificate to %s: %w", certPath, err)
+	}
+	defer os.Remove(certTmp)
+
+	keyTmp, err := writeTempFile(keyPath, keyPEM, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to write TLS key to %s: %w", keyPath, err)
+	}
+	defer os.Remove(keyTmp)
+
+	if err := os.Rename(keyTmp, keyPath); err != nil {
+		return fmt.Errorf("failed to write TLS key to %s: %w", keyPath, err)
+	}
+	if err := os.Rename(certTmp, certPath); err != nil {
+		return fmt.Errorf("failed to write TLS certificate to %s: %w", certPath, err)
+	}
+
+	return nil
+}
+
+// NewServerTLSConfig loads the certificate and key of a TLS server. If the
+// path of the client CA is not empty, clients are required to present a
+// certificate signed by the CA, i.e., mutual TLS is enforced
+func NewServerTLSConfig(certPath, keyPath, clientCAPath string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS key pair: %w", err)
+	}
+
+	tlsCfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if clientCAPath != "" {
+		pool, err := loadCertPool(clientCAPath)
+		if err != nil {
+			return nil, err
+		}
+		tlsCfg.ClientCAs = pool
+		tlsCfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return tlsCfg, nil
+}
+
+// NewPinnedClientTLSConfig creates the TLS config of a client which only accepts
+// the exact server certificate stored at the given path. Host names are not
+// checked as the certificate is pinned. If the paths of the client certificate
+// and key are not empty, the client presents them to the server for mutual TLS
+func NewPinnedClientTLSConfig(serverCertPath, clientCertPath, clientKeyPath string) (*tls.Config, error) {
+	pinnedCert, err := loadCert(serverCertPath)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsCfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// the default verification is replaced by pinning below
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 {
+				return fmt.Errorf("no certificate presented by the server")
+			}
+			if !bytes.Equal(rawCerts[0], pinnedCert.Raw) {
+				return fmt.Errorf("the server certificate does not match the pinned certificate %s", serverCertPath)
+			}
+			if time.Now().After(pinnedCert.NotAfter) {
+				return fmt.Errorf("the pinned server certificate expired at %v", pinnedCert.NotAfter)
+			}
+			return nil
+		},
+	}
+
+	if clientCertPath != "" {
+		cert, err := tls.LoadX509KeyPair(clientCertPath, clientKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client TLS key pair: %w", err)
+		}
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsCfg, nil
+}
+
+// CertExpiry returns when the certificate at the given path expires, and
+// whether it was generated by GenerateSelfSignedCert, i.e., whether it can be
+// regenerated without involving a CA
+func CertExpiry(certPath string) (time.Time, bool, error) {
+	cert, err := loadCert(certPath)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	autogenerated := len(cert.Subject.Organization) == 1 &&
+		cert.Subject.Organization[0] == tlsCertOrganization
+
+	return cert.NotAfter, autogenerated, nil
+}
+
+func loadCert(certPath string) (*x509.Certificate, error) {
+	certPEM, err := os.ReadFile(certPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read TLS certificate %s: %w", certPath, err)
+	}
+
+	block, _ := pem.Decode(certPEM)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("invalid PEM certificate in %s", certPath)
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse TLS certificate %s: %w", certPath, err)
+	}
+
+	return cert, nil
+}
+
+func loadCertPool(certPath string) (*x509.CertPool, error) {
+	certPEM, err := os.ReadFile(certPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA certificate %s: %w", certPath, err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(certPEM) {
+		return nil, fmt.Errorf("no valid CA certificate found in %s", certPath)
+	}
+
+	return pool, nil
+}
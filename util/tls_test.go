@@ -0,0 +1,133 @@
+package util_test
+
+import (
+	"crypto/tls"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/finality-provider/util"
+)
+
+// TestPinnedTLS tests that the client only accepts the pinned server
+// certificate and that the server enforces client certificates under mutual TLS
+func TestPinnedTLS(t *testing.T) {
+	dir := t.TempDir()
+	serverCert, serverKey := filepath.Join(dir, "server.cert"), filepath.Join(dir, "server.key")
+	otherCert, otherKey := filepath.Join(dir, "other.cert"), filepath.Join(dir, "other.key")
+	clientCert, clientKey := filepath.Join(dir, "client.cert"), filepath.Join(dir, "client.key")
+	require.NoError(t, util.GenerateSelfSignedCert(serverCert, serverKey, nil))
+	require.NoError(t, util.GenerateSelfSignedCert(otherCert, otherKey, nil))
+	require.NoError(t, util.GenerateSelfSignedCert(clientCert, clientKey, nil))
+
+	// the client certificate is trusted as its own CA
+	serverTLSCfg, err := util.NewServerTLSConfig(serverCert, serverKey, clientCert)
+	require.NoError(t, err)
+	addr := startTLSServer(t, serverTLSCfg)
+
+	// pinned certificate with a trusted client certificate
+	clientTLSCfg, err := util.NewPinnedClientTLSConfig(serverCert, clientCert, clientKey)
+	require.NoError(t, err)
+	require.NoError(t, dialTLS(addr, clientTLSCfg))
+
+	// pinning a different certificate
+	clientTLSCfg, err = util.NewPinnedClientTLSConfig(otherCert, clientCert, clientKey)
+	require.NoError(t, err)
+	require.Error(t, dialTLS(addr, clientTLSCfg))
+
+	// untrusted client certificate
+	clientTLSCfg, err = util.NewPinnedClientTLSConfig(serverCert, otherCert, otherKey)
+	require.NoError(t, err)
+	require.Error(t, dialTLS(addr, clientTLSCfg))
+
+	// no client certificate
+	clientTLSCfg, err = util.NewPinnedClientTLSConfig(serverCert, "", "")
+	require.NoError(t, err)
+	require.Error(t, dialTLS(addr, clientTLSCfg))
+}
+
+// TestCertExpiry tests that the expiry of a generated certificate is read back
+// and that the certificate is recognized as generated
+func TestCertExpiry(t *testing.T) {
+	dir := t.TempDir()
+	certPath, keyPath := filepath.Join(dir, "server.cert"), filepath.Join(dir, "server.key")
+	require.NoError(t, util.GenerateSelfSignedCert(certPath, keyPath, nil))
+
+	expiry, autogenerated, err := util.CertExpiry(certPath)
+	require.NoError(t, err)
+	require.True(t, autogenerated)
+	require.Greater(t, time.Until(expiry), util.TLSCertRenewalWindow)
+
+	_, _, err = util.CertExpiry(filepath.Join(dir, "missing.cert"))
+	require.Error(t, err)
+}
+
+// TestRegenerateCert tests that regenerating a certificate replaces both the
+// certificate and the key without leaving temporary files behind
+func TestRegenerateCert(t *testing.T) {
+	dir := t.TempDir()
+	certPath, keyPath := filepath.Join(dir, "server.cert"), filepath.Join(dir, "server.key")
+	require.NoError(t, util.GenerateSelfSignedCert(certPath, keyPath, nil))
+	oldCert, err := os.ReadFile(certPath)
+	require.NoError(t, err)
+
+	require.NoError(t, util.GenerateSelfSignedCert(certPath, keyPath, nil))
+	newCert, err := os.ReadFile(certPath)
+	require.NoError(t, err)
+	require.NotEqual(t, oldCert, newCert)
+
+	_, err = tls.LoadX509KeyPair(certPath, keyPath)
+	require.NoError(t, err)
+
+	keyInfo, err := os.Stat(keyPath)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), keyInfo.Mode().Perm())
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+}
+
+func startTLSServer(t *testing.T, cfg *tls.Config) string {
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { lis.Close() })
+
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			go func(c net.Conn) {
+				defer c.Close()
+				// echo a single byte once the handshake is done
+				buf := make([]byte, 1)
+				if _, err := c.Read(buf); err == nil {
+					_, _ = c.Write(buf)
+				}
+			}(conn)
+		}
+	}()
+
+	return lis.Addr().String()
+}
+
+func dialTLS(addr string, cfg *tls.Config) error {
+	conn, err := tls.Dial("tcp", addr, cfg)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// under TLS 1.3 a rejected client certificate is only reported on read
+	if _, err := conn.Write([]byte{1}); err != nil {
+		return err
+	}
+	_, err = conn.Read(make([]byte, 1))
+	return err
+}